@@ -32,7 +32,9 @@ const (
 	Protocol_UDP       Protocol = 1
 	Protocol_HTTP      Protocol = 2
 	Protocol_WEBSOCKET Protocol = 3
-	Protocol_QUIC      Protocol = 4
+	// QUIC serves HTTP/3 routes on UDP. Binds of QUIC routes must have tls
+	// certificates.
+	Protocol_QUIC Protocol = 4
 )
 
 // Enum value maps for Protocol.
@@ -123,7 +125,7 @@ func (x JoinRequest_Suffrage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JoinRequest_Suffrage.Descriptor instead.
 func (JoinRequest_Suffrage) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{2, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type Raft_KeyValue_Action int32
@@ -178,7 +180,63 @@ func (x Raft_KeyValue_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Raft_KeyValue_Action.Descriptor instead.
 func (Raft_KeyValue_Action) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{4, 0, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{5, 0, 0}
+}
+
+type Discovery_Type int32
+
+const (
+	// The address is used as it is.
+	Discovery_STATIC Discovery_Type = 0
+	// A and AAAA records of the host of the address. The port of the address
+	// is used for all endpoints and each endpoint has the weight of the
+	// address.
+	Discovery_DNS Discovery_Type = 1
+	// SRV records of the host of the address eg _http._tcp.example.com. Ports
+	// and weights of the records are used. Only records with the lowest
+	// priority are used.
+	Discovery_SRV Discovery_Type = 2
+)
+
+// Enum value maps for Discovery_Type.
+var (
+	Discovery_Type_name = map[int32]string{
+		0: "STATIC",
+		1: "DNS",
+		2: "SRV",
+	}
+	Discovery_Type_value = map[string]int32{
+		"STATIC": 0,
+		"DNS":    1,
+		"SRV":    2,
+	}
+)
+
+func (x Discovery_Type) Enum() *Discovery_Type {
+	p := new(Discovery_Type)
+	*p = x
+	return p
+}
+
+func (x Discovery_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Discovery_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[3].Descriptor()
+}
+
+func (Discovery_Type) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[3]
+}
+
+func (x Discovery_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Discovery_Type.Descriptor instead.
+func (Discovery_Type) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type Middleware_Version int32
@@ -213,11 +271,11 @@ func (x Middleware_Version) String() string {
 }
 
 func (Middleware_Version) Descriptor() protoreflect.EnumDescriptor {
-	return file_tcp_proto_enumTypes[3].Descriptor()
+	return file_tcp_proto_enumTypes[4].Descriptor()
 }
 
 func (Middleware_Version) Type() protoreflect.EnumType {
-	return &file_tcp_proto_enumTypes[3]
+	return &file_tcp_proto_enumTypes[4]
 }
 
 func (x Middleware_Version) Number() protoreflect.EnumNumber {
@@ -226,7 +284,164 @@ func (x Middleware_Version) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Middleware_Version.Descriptor instead.
 func (Middleware_Version) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Middleware_Compress_Encoding int32
+
+const (
+	Middleware_Compress_GZIP   Middleware_Compress_Encoding = 0
+	Middleware_Compress_BROTLI Middleware_Compress_Encoding = 1
+	Middleware_Compress_ZSTD   Middleware_Compress_Encoding = 2
+)
+
+// Enum value maps for Middleware_Compress_Encoding.
+var (
+	Middleware_Compress_Encoding_name = map[int32]string{
+		0: "GZIP",
+		1: "BROTLI",
+		2: "ZSTD",
+	}
+	Middleware_Compress_Encoding_value = map[string]int32{
+		"GZIP":   0,
+		"BROTLI": 1,
+		"ZSTD":   2,
+	}
+)
+
+func (x Middleware_Compress_Encoding) Enum() *Middleware_Compress_Encoding {
+	p := new(Middleware_Compress_Encoding)
+	*p = x
+	return p
+}
+
+func (x Middleware_Compress_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Middleware_Compress_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[5].Descriptor()
+}
+
+func (Middleware_Compress_Encoding) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[5]
+}
+
+func (x Middleware_Compress_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Middleware_Compress_Encoding.Descriptor instead.
+func (Middleware_Compress_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 4, 0}
+}
+
+type Middleware_Headers_Forwarded int32
+
+const (
+	// KEEP passes X-Forwarded-* headers of requests on, the client address
+	// is appended to X-Forwarded-For.
+	Middleware_Headers_KEEP Middleware_Headers_Forwarded = 0
+	// REPLACE drops X-Forwarded-* and Forwarded headers sent by clients and
+	// sets X-Forwarded-For, X-Forwarded-Host and X-Forwarded-Proto.
+	Middleware_Headers_REPLACE Middleware_Headers_Forwarded = 1
+	// REMOVE drops X-Forwarded-* and Forwarded headers without adding any.
+	Middleware_Headers_REMOVE Middleware_Headers_Forwarded = 2
+)
+
+// Enum value maps for Middleware_Headers_Forwarded.
+var (
+	Middleware_Headers_Forwarded_name = map[int32]string{
+		0: "KEEP",
+		1: "REPLACE",
+		2: "REMOVE",
+	}
+	Middleware_Headers_Forwarded_value = map[string]int32{
+		"KEEP":    0,
+		"REPLACE": 1,
+		"REMOVE":  2,
+	}
+)
+
+func (x Middleware_Headers_Forwarded) Enum() *Middleware_Headers_Forwarded {
+	p := new(Middleware_Headers_Forwarded)
+	*p = x
+	return p
+}
+
+func (x Middleware_Headers_Forwarded) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Middleware_Headers_Forwarded) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[6].Descriptor()
+}
+
+func (Middleware_Headers_Forwarded) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[6]
+}
+
+func (x Middleware_Headers_Forwarded) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Middleware_Headers_Forwarded.Descriptor instead.
+func (Middleware_Headers_Forwarded) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 5, 0}
+}
+
+type Fallback_Action int32
+
+const (
+	// Close the connection.
+	Fallback_CLOSE Fallback_Action = 0
+	// Reject the connection in a way the client understands. TLS clients are
+	// sent an unrecognized_name alert, plaintext HTTP clients are sent a
+	// response with http_status and other connections are closed.
+	Fallback_REJECT Fallback_Action = 1
+	// Forward the connection to load_balance.
+	Fallback_FORWARD Fallback_Action = 2
+)
+
+// Enum value maps for Fallback_Action.
+var (
+	Fallback_Action_name = map[int32]string{
+		0: "CLOSE",
+		1: "REJECT",
+		2: "FORWARD",
+	}
+	Fallback_Action_value = map[string]int32{
+		"CLOSE":   0,
+		"REJECT":  1,
+		"FORWARD": 2,
+	}
+)
+
+func (x Fallback_Action) Enum() *Fallback_Action {
+	p := new(Fallback_Action)
+	*p = x
+	return p
+}
+
+func (x Fallback_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Fallback_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[7].Descriptor()
+}
+
+func (Fallback_Action) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[7]
+}
+
+func (x Fallback_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Fallback_Action.Descriptor instead.
+func (Fallback_Action) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type Route_LoadBalanceAlgo int32
@@ -262,11 +477,11 @@ func (x Route_LoadBalanceAlgo) String() string {
 }
 
 func (Route_LoadBalanceAlgo) Descriptor() protoreflect.EnumDescriptor {
-	return file_tcp_proto_enumTypes[4].Descriptor()
+	return file_tcp_proto_enumTypes[8].Descriptor()
 }
 
 func (Route_LoadBalanceAlgo) Type() protoreflect.EnumType {
-	return &file_tcp_proto_enumTypes[4]
+	return &file_tcp_proto_enumTypes[8]
 }
 
 func (x Route_LoadBalanceAlgo) Number() protoreflect.EnumNumber {
@@ -275,7 +490,163 @@ func (x Route_LoadBalanceAlgo) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Route_LoadBalanceAlgo.Descriptor instead.
 func (Route_LoadBalanceAlgo) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{12, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type HTTPUpstream_Protocol int32
+
+const (
+	// HTTP/1.1, or HTTP/2 when https upstreams negotiate it with ALPN.
+	HTTPUpstream_AUTO HTTPUpstream_Protocol = 0
+	// Only HTTP/1.1.
+	HTTPUpstream_HTTP1 HTTPUpstream_Protocol = 1
+	// HTTP/2 over TLS, upstream addresses must use https.
+	HTTPUpstream_H2 HTTPUpstream_Protocol = 2
+	// HTTP/2 over cleartext with prior knowledge, upstream addresses must use
+	// http.
+	HTTPUpstream_H2C HTTPUpstream_Protocol = 3
+)
+
+// Enum value maps for HTTPUpstream_Protocol.
+var (
+	HTTPUpstream_Protocol_name = map[int32]string{
+		0: "AUTO",
+		1: "HTTP1",
+		2: "H2",
+		3: "H2C",
+	}
+	HTTPUpstream_Protocol_value = map[string]int32{
+		"AUTO":  0,
+		"HTTP1": 1,
+		"H2":    2,
+		"H2C":   3,
+	}
+)
+
+func (x HTTPUpstream_Protocol) Enum() *HTTPUpstream_Protocol {
+	p := new(HTTPUpstream_Protocol)
+	*p = x
+	return p
+}
+
+func (x HTTPUpstream_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HTTPUpstream_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[9].Descriptor()
+}
+
+func (HTTPUpstream_Protocol) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[9]
+}
+
+func (x HTTPUpstream_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HTTPUpstream_Protocol.Descriptor instead.
+func (HTTPUpstream_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type Affinity_Key int32
+
+const (
+	// Clients are identified by their ip address.
+	Affinity_SOURCE_IP Affinity_Key = 0
+	// Clients are identified by the TLS server name they ask for. Connections
+	// without a server name are balanced normally.
+	Affinity_SNI Affinity_Key = 1
+)
+
+// Enum value maps for Affinity_Key.
+var (
+	Affinity_Key_name = map[int32]string{
+		0: "SOURCE_IP",
+		1: "SNI",
+	}
+	Affinity_Key_value = map[string]int32{
+		"SOURCE_IP": 0,
+		"SNI":       1,
+	}
+)
+
+func (x Affinity_Key) Enum() *Affinity_Key {
+	p := new(Affinity_Key)
+	*p = x
+	return p
+}
+
+func (x Affinity_Key) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Affinity_Key) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[10].Descriptor()
+}
+
+func (Affinity_Key) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[10]
+}
+
+func (x Affinity_Key) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Affinity_Key.Descriptor instead.
+func (Affinity_Key) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type ForwardProxy_Mode int32
+
+const (
+	// ANY detects the protocol from the first byte sent by the client.
+	ForwardProxy_ANY          ForwardProxy_Mode = 0
+	ForwardProxy_SOCKS5       ForwardProxy_Mode = 1
+	ForwardProxy_HTTP_CONNECT ForwardProxy_Mode = 2
+)
+
+// Enum value maps for ForwardProxy_Mode.
+var (
+	ForwardProxy_Mode_name = map[int32]string{
+		0: "ANY",
+		1: "SOCKS5",
+		2: "HTTP_CONNECT",
+	}
+	ForwardProxy_Mode_value = map[string]int32{
+		"ANY":          0,
+		"SOCKS5":       1,
+		"HTTP_CONNECT": 2,
+	}
+)
+
+func (x ForwardProxy_Mode) Enum() *ForwardProxy_Mode {
+	p := new(ForwardProxy_Mode)
+	*p = x
+	return p
+}
+
+func (x ForwardProxy_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForwardProxy_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[11].Descriptor()
+}
+
+func (ForwardProxy_Mode) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[11]
+}
+
+func (x ForwardProxy_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForwardProxy_Mode.Descriptor instead.
+func (ForwardProxy_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type Rule_HTTP_Method int32
@@ -329,11 +700,11 @@ func (x Rule_HTTP_Method) String() string {
 }
 
 func (Rule_HTTP_Method) Descriptor() protoreflect.EnumDescriptor {
-	return file_tcp_proto_enumTypes[5].Descriptor()
+	return file_tcp_proto_enumTypes[12].Descriptor()
 }
 
 func (Rule_HTTP_Method) Type() protoreflect.EnumType {
-	return &file_tcp_proto_enumTypes[5]
+	return &file_tcp_proto_enumTypes[12]
 }
 
 func (x Rule_HTTP_Method) Number() protoreflect.EnumNumber {
@@ -342,7 +713,7 @@ func (x Rule_HTTP_Method) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Rule_HTTP_Method.Descriptor instead.
 func (Rule_HTTP_Method) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 0}
 }
 
 type Rule_HTTP_KeyValue_Type int32
@@ -375,11 +746,11 @@ func (x Rule_HTTP_KeyValue_Type) String() string {
 }
 
 func (Rule_HTTP_KeyValue_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_tcp_proto_enumTypes[6].Descriptor()
+	return file_tcp_proto_enumTypes[13].Descriptor()
 }
 
 func (Rule_HTTP_KeyValue_Type) Type() protoreflect.EnumType {
-	return &file_tcp_proto_enumTypes[6]
+	return &file_tcp_proto_enumTypes[13]
 }
 
 func (x Rule_HTTP_KeyValue_Type) Number() protoreflect.EnumNumber {
@@ -388,7 +759,7 @@ func (x Rule_HTTP_KeyValue_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Rule_HTTP_KeyValue_Type.Descriptor instead.
 func (Rule_HTTP_KeyValue_Type) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 1, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 1, 0}
 }
 
 type Rule_HTTP_Path_Type int32
@@ -424,11 +795,11 @@ func (x Rule_HTTP_Path_Type) String() string {
 }
 
 func (Rule_HTTP_Path_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_tcp_proto_enumTypes[7].Descriptor()
+	return file_tcp_proto_enumTypes[14].Descriptor()
 }
 
 func (Rule_HTTP_Path_Type) Type() protoreflect.EnumType {
-	return &file_tcp_proto_enumTypes[7]
+	return &file_tcp_proto_enumTypes[14]
 }
 
 func (x Rule_HTTP_Path_Type) Number() protoreflect.EnumNumber {
@@ -437,72 +808,274 @@ func (x Rule_HTTP_Path_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Rule_HTTP_Path_Type.Descriptor instead.
 func (Rule_HTTP_Path_Type) EnumDescriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 3, 0}
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 3, 0}
 }
 
-type ConfigRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+// CacheStatus is how the cache of a route answered a request.
+type AccessEntry_CacheStatus int32
 
-func (x *ConfigRequest) Reset() {
-	*x = ConfigRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+const (
+	// The route has no cache.
+	AccessEntry_NONE AccessEntry_CacheStatus = 0
+	// The response was not cached and was fetched from upstream.
+	AccessEntry_MISS AccessEntry_CacheStatus = 1
+	// A fresh cached response was served.
+	AccessEntry_HIT AccessEntry_CacheStatus = 2
+	// A stale cached response was served, while it was revalidated or because
+	// the upstream failed.
+	AccessEntry_STALE AccessEntry_CacheStatus = 3
+	// A stale cached response was served after the upstream confirmed it did
+	// not change.
+	AccessEntry_REVALIDATED AccessEntry_CacheStatus = 4
+	// The request could not be answered from the cache.
+	AccessEntry_BYPASS AccessEntry_CacheStatus = 5
+)
+
+// Enum value maps for AccessEntry_CacheStatus.
+var (
+	AccessEntry_CacheStatus_name = map[int32]string{
+		0: "NONE",
+		1: "MISS",
+		2: "HIT",
+		3: "STALE",
+		4: "REVALIDATED",
+		5: "BYPASS",
+	}
+	AccessEntry_CacheStatus_value = map[string]int32{
+		"NONE":        0,
+		"MISS":        1,
+		"HIT":         2,
+		"STALE":       3,
+		"REVALIDATED": 4,
+		"BYPASS":      5,
 	}
-}
+)
 
-func (x *ConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AccessEntry_CacheStatus) Enum() *AccessEntry_CacheStatus {
+	p := new(AccessEntry_CacheStatus)
+	*p = x
+	return p
 }
 
-func (*ConfigRequest) ProtoMessage() {}
-
-func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x AccessEntry_CacheStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
-func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{0}
+func (AccessEntry_CacheStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[15].Descriptor()
 }
 
-type DeleteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+func (AccessEntry_CacheStatus) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[15]
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x AccessEntry_CacheStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeleteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AccessEntry_CacheStatus.Descriptor instead.
+func (AccessEntry_CacheStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+// Failure is why the request could not be proxied.
+type AccessEntry_ReverseProxy_Failure int32
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[1]
+const (
+	AccessEntry_ReverseProxy_NONE AccessEntry_ReverseProxy_Failure = 0
+	// Connecting to the upstream failed.
+	AccessEntry_ReverseProxy_CONNECT_FAILED AccessEntry_ReverseProxy_Failure = 1
+	// Connecting to the upstream took longer than the route timeout.
+	AccessEntry_ReverseProxy_CONNECT_TIMEOUT AccessEntry_ReverseProxy_Failure = 2
+	// Response headers took longer than the response_header_timeout.
+	AccessEntry_ReverseProxy_RESPONSE_HEADER_TIMEOUT AccessEntry_ReverseProxy_Failure = 3
+	// The request took longer than the request_timeout.
+	AccessEntry_ReverseProxy_REQUEST_TIMEOUT AccessEntry_ReverseProxy_Failure = 4
+	// Any other error talking to the upstream.
+	AccessEntry_ReverseProxy_UPSTREAM_ERROR AccessEntry_ReverseProxy_Failure = 5
+)
+
+// Enum value maps for AccessEntry_ReverseProxy_Failure.
+var (
+	AccessEntry_ReverseProxy_Failure_name = map[int32]string{
+		0: "NONE",
+		1: "CONNECT_FAILED",
+		2: "CONNECT_TIMEOUT",
+		3: "RESPONSE_HEADER_TIMEOUT",
+		4: "REQUEST_TIMEOUT",
+		5: "UPSTREAM_ERROR",
+	}
+	AccessEntry_ReverseProxy_Failure_value = map[string]int32{
+		"NONE":                    0,
+		"CONNECT_FAILED":          1,
+		"CONNECT_TIMEOUT":         2,
+		"RESPONSE_HEADER_TIMEOUT": 3,
+		"REQUEST_TIMEOUT":         4,
+		"UPSTREAM_ERROR":          5,
+	}
+)
+
+func (x AccessEntry_ReverseProxy_Failure) Enum() *AccessEntry_ReverseProxy_Failure {
+	p := new(AccessEntry_ReverseProxy_Failure)
+	*p = x
+	return p
+}
+
+func (x AccessEntry_ReverseProxy_Failure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessEntry_ReverseProxy_Failure) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[16].Descriptor()
+}
+
+func (AccessEntry_ReverseProxy_Failure) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[16]
+}
+
+func (x AccessEntry_ReverseProxy_Failure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessEntry_ReverseProxy_Failure.Descriptor instead.
+func (AccessEntry_ReverseProxy_Failure) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 2, 0}
+}
+
+type AccessEntry_Session_Termination int32
+
+const (
+	AccessEntry_Session_UNKNOWN AccessEntry_Session_Termination = 0
+	// The client closed the connection first.
+	AccessEntry_Session_DOWNSTREAM_CLOSE AccessEntry_Session_Termination = 1
+	// The upstream closed the connection first.
+	AccessEntry_Session_UPSTREAM_CLOSE AccessEntry_Session_Termination = 2
+	// No route matched the connection.
+	AccessEntry_Session_NO_ROUTE AccessEntry_Session_Termination = 3
+	// Connecting to the upstream failed.
+	AccessEntry_Session_UPSTREAM_CONNECT_FAILED AccessEntry_Session_Termination = 4
+	// Copying data between the client and the upstream failed.
+	AccessEntry_Session_CONNECTION_ERROR AccessEntry_Session_Termination = 5
+	// The connection was refused by a filter or forward proxy rules.
+	AccessEntry_Session_REJECTED AccessEntry_Session_Termination = 6
+	// The client didn't send enough data to match a route in time.
+	AccessEntry_Session_MATCH_TIMEOUT AccessEntry_Session_Termination = 7
+)
+
+// Enum value maps for AccessEntry_Session_Termination.
+var (
+	AccessEntry_Session_Termination_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DOWNSTREAM_CLOSE",
+		2: "UPSTREAM_CLOSE",
+		3: "NO_ROUTE",
+		4: "UPSTREAM_CONNECT_FAILED",
+		5: "CONNECTION_ERROR",
+		6: "REJECTED",
+		7: "MATCH_TIMEOUT",
+	}
+	AccessEntry_Session_Termination_value = map[string]int32{
+		"UNKNOWN":                 0,
+		"DOWNSTREAM_CLOSE":        1,
+		"UPSTREAM_CLOSE":          2,
+		"NO_ROUTE":                3,
+		"UPSTREAM_CONNECT_FAILED": 4,
+		"CONNECTION_ERROR":        5,
+		"REJECTED":                6,
+		"MATCH_TIMEOUT":           7,
+	}
+)
+
+func (x AccessEntry_Session_Termination) Enum() *AccessEntry_Session_Termination {
+	p := new(AccessEntry_Session_Termination)
+	*p = x
+	return p
+}
+
+func (x AccessEntry_Session_Termination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessEntry_Session_Termination) Descriptor() protoreflect.EnumDescriptor {
+	return file_tcp_proto_enumTypes[17].Descriptor()
+}
+
+func (AccessEntry_Session_Termination) Type() protoreflect.EnumType {
+	return &file_tcp_proto_enumTypes[17]
+}
+
+func (x AccessEntry_Session_Termination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessEntry_Session_Termination.Descriptor instead.
+func (AccessEntry_Session_Termination) EnumDescriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 6, 0}
+}
+
+type ConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigRequest) Reset() {
+	*x = ConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRequest) ProtoMessage() {}
+
+func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConfigRequest) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{0}
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,6 +1098,64 @@ func (x *DeleteRequest) GetRoutes() []string {
 	return nil
 }
 
+// PurgeRequest removes cached responses of routes.
+type PurgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of routes to purge, all routes when empty.
+	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// URLs of responses to purge, all responses of routes when empty.
+	Urls []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *PurgeRequest) Reset() {
+	*x = PurgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRequest) ProtoMessage() {}
+
+func (x *PurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRequest) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PurgeRequest) GetRoutes() []string {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *PurgeRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
 type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -538,7 +1169,7 @@ type JoinRequest struct {
 func (x *JoinRequest) Reset() {
 	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[2]
+		mi := &file_tcp_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +1182,7 @@ func (x *JoinRequest) String() string {
 func (*JoinRequest) ProtoMessage() {}
 
 func (x *JoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[2]
+	mi := &file_tcp_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1195,7 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
 func (*JoinRequest) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{2}
+	return file_tcp_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *JoinRequest) GetNodeId() string {
@@ -597,7 +1228,7 @@ type JoinResponse struct {
 func (x *JoinResponse) Reset() {
 	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[3]
+		mi := &file_tcp_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +1241,7 @@ func (x *JoinResponse) String() string {
 func (*JoinResponse) ProtoMessage() {}
 
 func (x *JoinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[3]
+	mi := &file_tcp_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +1254,7 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
 func (*JoinResponse) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{3}
+	return file_tcp_proto_rawDescGZIP(), []int{4}
 }
 
 type Raft struct {
@@ -635,7 +1266,7 @@ type Raft struct {
 func (x *Raft) Reset() {
 	*x = Raft{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[4]
+		mi := &file_tcp_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -648,7 +1279,7 @@ func (x *Raft) String() string {
 func (*Raft) ProtoMessage() {}
 
 func (x *Raft) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[4]
+	mi := &file_tcp_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +1292,7 @@ func (x *Raft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Raft.ProtoReflect.Descriptor instead.
 func (*Raft) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{4}
+	return file_tcp_proto_rawDescGZIP(), []int{5}
 }
 
 type Store struct {
@@ -673,7 +1304,7 @@ type Store struct {
 func (x *Store) Reset() {
 	*x = Store{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[5]
+		mi := &file_tcp_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -686,7 +1317,7 @@ func (x *Store) String() string {
 func (*Store) ProtoMessage() {}
 
 func (x *Store) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[5]
+	mi := &file_tcp_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +1330,7 @@ func (x *Store) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Store.ProtoReflect.Descriptor instead.
 func (*Store) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{5}
+	return file_tcp_proto_rawDescGZIP(), []int{6}
 }
 
 type Response struct {
@@ -716,7 +1347,7 @@ type Response struct {
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[6]
+		mi := &file_tcp_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -729,7 +1360,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[6]
+	mi := &file_tcp_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +1373,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{6}
+	return file_tcp_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *Response) GetResult() isResponse_Result {
@@ -794,7 +1425,7 @@ type Config struct {
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[7]
+		mi := &file_tcp_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +1438,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[7]
+	mi := &file_tcp_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +1451,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{7}
+	return file_tcp_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Config) GetId() string {
@@ -845,12 +1476,15 @@ type WeightedAddr struct {
 	Addr         *Address          `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Weight       int32             `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
 	MetricLabels map[string]string `protobuf:"bytes,4,rep,name=metric_labels,json=metricLabels,proto3" json:"metric_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// When set addr is resolved with DNS into multiple endpoints that are kept
+	// up to date.
+	Discovery *Discovery `protobuf:"bytes,5,opt,name=discovery,proto3" json:"discovery,omitempty"`
 }
 
 func (x *WeightedAddr) Reset() {
 	*x = WeightedAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[8]
+		mi := &file_tcp_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -863,7 +1497,7 @@ func (x *WeightedAddr) String() string {
 func (*WeightedAddr) ProtoMessage() {}
 
 func (x *WeightedAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[8]
+	mi := &file_tcp_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1510,7 @@ func (x *WeightedAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeightedAddr.ProtoReflect.Descriptor instead.
 func (*WeightedAddr) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{8}
+	return file_tcp_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WeightedAddr) GetAddr() *Address {
@@ -900,32 +1534,48 @@ func (x *WeightedAddr) GetMetricLabels() map[string]string {
 	return nil
 }
 
-type Address struct {
+func (x *WeightedAddr) GetDiscovery() *Discovery {
+	if x != nil {
+		return x.Discovery
+	}
+	return nil
+}
+
+// Discovery expands an address into endpoints using DNS. Records are resolved
+// again when their TTL expires and the load balancer is updated with the new
+// endpoints.
+type Discovery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type Discovery_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Discovery_Type" json:"type,omitempty"`
+	// host:port of the DNS server. Defaults to the first nameserver in
+	// /etc/resolv.conf
+	Resolver string `protobuf:"bytes,2,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	// Records are not resolved more often than min_ttl. Defaults to 5s
+	MinTtl *duration.Duration `protobuf:"bytes,3,opt,name=min_ttl,json=minTtl,proto3" json:"min_ttl,omitempty"`
+	// Records are resolved at least every max_ttl. Defaults to 5m
+	MaxTtl *duration.Duration `protobuf:"bytes,4,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
+func (x *Discovery) Reset() {
+	*x = Discovery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[9]
+		mi := &file_tcp_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Address) String() string {
+func (x *Discovery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*Discovery) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[9]
+func (x *Discovery) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,53 +1586,65 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Discovery.ProtoReflect.Descriptor instead.
+func (*Discovery) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Address) GetNetwork() string {
+func (x *Discovery) GetType() Discovery_Type {
 	if x != nil {
-		return x.Network
+		return x.Type
 	}
-	return ""
+	return Discovery_STATIC
 }
 
-func (x *Address) GetAddress() string {
+func (x *Discovery) GetResolver() string {
 	if x != nil {
-		return x.Address
+		return x.Resolver
 	}
 	return ""
 }
 
-type Middleware struct {
+func (x *Discovery) GetMinTtl() *duration.Duration {
+	if x != nil {
+		return x.MinTtl
+	}
+	return nil
+}
+
+func (x *Discovery) GetMaxTtl() *duration.Duration {
+	if x != nil {
+		return x.MaxTtl
+	}
+	return nil
+}
+
+type Address struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Match:
-	//	*Middleware_Wasm_
-	//	*Middleware_StripPathPrefix_
-	Match isMiddleware_Match `protobuf_oneof:"match"`
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *Middleware) Reset() {
-	*x = Middleware{}
+func (x *Address) Reset() {
+	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[10]
+		mi := &file_tcp_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Middleware) String() string {
+func (x *Address) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware) ProtoMessage() {}
+func (*Address) ProtoMessage() {}
 
-func (x *Middleware) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[10]
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,9 +1655,71 @@ func (x *Middleware) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware.ProtoReflect.Descriptor instead.
-func (*Middleware) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Address) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Address) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Middleware struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//	*Middleware_Wasm_
+	//	*Middleware_StripPathPrefix_
+	//	*Middleware_Cache_
+	//	*Middleware_Compress_
+	//	*Middleware_Headers_
+	//	*Middleware_Rewrite_
+	//	*Middleware_Redirect_
+	Match isMiddleware_Match `protobuf_oneof:"match"`
+}
+
+func (x *Middleware) Reset() {
+	*x = Middleware{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware) ProtoMessage() {}
+
+func (x *Middleware) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware.ProtoReflect.Descriptor instead.
+func (*Middleware) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *Middleware) GetMatch() isMiddleware_Match {
@@ -1019,6 +1743,41 @@ func (x *Middleware) GetStripPathPrefix() *Middleware_StripPathPrefix {
 	return nil
 }
 
+func (x *Middleware) GetCache() *Middleware_Cache {
+	if x, ok := x.GetMatch().(*Middleware_Cache_); ok {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *Middleware) GetCompress() *Middleware_Compress {
+	if x, ok := x.GetMatch().(*Middleware_Compress_); ok {
+		return x.Compress
+	}
+	return nil
+}
+
+func (x *Middleware) GetHeaders() *Middleware_Headers {
+	if x, ok := x.GetMatch().(*Middleware_Headers_); ok {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Middleware) GetRewrite() *Middleware_Rewrite {
+	if x, ok := x.GetMatch().(*Middleware_Rewrite_); ok {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *Middleware) GetRedirect() *Middleware_Redirect {
+	if x, ok := x.GetMatch().(*Middleware_Redirect_); ok {
+		return x.Redirect
+	}
+	return nil
+}
+
 type isMiddleware_Match interface {
 	isMiddleware_Match()
 }
@@ -1031,10 +1790,40 @@ type Middleware_StripPathPrefix_ struct {
 	StripPathPrefix *Middleware_StripPathPrefix `protobuf:"bytes,2,opt,name=strip_path_prefix,json=stripPathPrefix,proto3,oneof"`
 }
 
+type Middleware_Cache_ struct {
+	Cache *Middleware_Cache `protobuf:"bytes,3,opt,name=cache,proto3,oneof"`
+}
+
+type Middleware_Compress_ struct {
+	Compress *Middleware_Compress `protobuf:"bytes,4,opt,name=compress,proto3,oneof"`
+}
+
+type Middleware_Headers_ struct {
+	Headers *Middleware_Headers `protobuf:"bytes,5,opt,name=headers,proto3,oneof"`
+}
+
+type Middleware_Rewrite_ struct {
+	Rewrite *Middleware_Rewrite `protobuf:"bytes,6,opt,name=rewrite,proto3,oneof"`
+}
+
+type Middleware_Redirect_ struct {
+	Redirect *Middleware_Redirect `protobuf:"bytes,7,opt,name=redirect,proto3,oneof"`
+}
+
 func (*Middleware_Wasm_) isMiddleware_Match() {}
 
 func (*Middleware_StripPathPrefix_) isMiddleware_Match() {}
 
+func (*Middleware_Cache_) isMiddleware_Match() {}
+
+func (*Middleware_Compress_) isMiddleware_Match() {}
+
+func (*Middleware_Headers_) isMiddleware_Match() {}
+
+func (*Middleware_Rewrite_) isMiddleware_Match() {}
+
+func (*Middleware_Redirect_) isMiddleware_Match() {}
+
 type Bind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1043,13 +1832,26 @@ type Bind struct {
 	// Types that are assignable to To:
 	//	*Bind_Port
 	//	*Bind_HostPort
-	To isBind_To `protobuf_oneof:"to"`
+	//	*Bind_Unix_
+	//	*Bind_Internal
+	To      isBind_To           `protobuf_oneof:"to"`
+	Options *Bind_ListenOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// Fallback handles connections that don't match any route on this listener.
+	// Unmatched connections are closed when it is not set.
+	Fallback *Fallback `protobuf:"bytes,6,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// MatchOptions limits how long and how much tt reads from connections while
+	// matching them against routes.
+	MatchOptions *MatchOptions `protobuf:"bytes,7,opt,name=match_options,json=matchOptions,proto3" json:"match_options,omitempty"`
+	// Http configures how HTTP routes bound to this listener are served.
+	Http *HTTPOptions `protobuf:"bytes,8,opt,name=http,proto3" json:"http,omitempty"`
+	// Tls serves HTTP routes bound to this listener over TLS.
+	Tls *TLS `protobuf:"bytes,9,opt,name=tls,proto3" json:"tls,omitempty"`
 }
 
 func (x *Bind) Reset() {
 	*x = Bind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[11]
+		mi := &file_tcp_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1062,7 +1864,7 @@ func (x *Bind) String() string {
 func (*Bind) ProtoMessage() {}
 
 func (x *Bind) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[11]
+	mi := &file_tcp_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1877,7 @@ func (x *Bind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bind.ProtoReflect.Descriptor instead.
 func (*Bind) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{11}
+	return file_tcp_proto_rawDescGZIP(), []int{13}
 }
 
 func (m *Bind) GetTo() isBind_To {
@@ -1099,6 +1901,55 @@ func (x *Bind) GetHostPort() string {
 	return ""
 }
 
+func (x *Bind) GetUnix() *Bind_Unix {
+	if x, ok := x.GetTo().(*Bind_Unix_); ok {
+		return x.Unix
+	}
+	return nil
+}
+
+func (x *Bind) GetInternal() string {
+	if x, ok := x.GetTo().(*Bind_Internal); ok {
+		return x.Internal
+	}
+	return ""
+}
+
+func (x *Bind) GetOptions() *Bind_ListenOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Bind) GetFallback() *Fallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+func (x *Bind) GetMatchOptions() *MatchOptions {
+	if x != nil {
+		return x.MatchOptions
+	}
+	return nil
+}
+
+func (x *Bind) GetHttp() *HTTPOptions {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Bind) GetTls() *TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
 type isBind_To interface {
 	isBind_To()
 }
@@ -1111,53 +1962,55 @@ type Bind_HostPort struct {
 	HostPort string `protobuf:"bytes,2,opt,name=hostPort,proto3,oneof"`
 }
 
+type Bind_Unix_ struct {
+	Unix *Bind_Unix `protobuf:"bytes,3,opt,name=unix,proto3,oneof"`
+}
+
+type Bind_Internal struct {
+	// Internal is a named in process listener. It only receives connections
+	// passed from TCP routes with a matching http_listener.
+	Internal string `protobuf:"bytes,5,opt,name=internal,proto3,oneof"`
+}
+
 func (*Bind_Port) isBind_To() {}
 
 func (*Bind_HostPort) isBind_To() {}
 
-type Route struct {
+func (*Bind_Unix_) isBind_To() {}
+
+func (*Bind_Internal) isBind_To() {}
+
+// TLS are the certificates served by an HTTP listener. Certificates are
+// selected by the server name clients ask for, using host_names of the route
+// the certificate is configured on, or the names in the certificate when the
+// route has no host_names. A certificate can be both ECDSA and RSA, the one
+// supported by the client is served. Certificates are reloaded when their
+// files or store keys change.
+type TLS struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bind                *Bind                 `protobuf:"bytes,1,opt,name=bind,proto3" json:"bind,omitempty"`
-	Condition           *RequestMatch         `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
-	MetricsLabels       map[string]string     `protobuf:"bytes,3,rep,name=metrics_labels,json=metricsLabels,proto3" json:"metrics_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Retries             *Retries              `protobuf:"bytes,4,opt,name=retries,proto3" json:"retries,omitempty"`
-	Timeout             *duration.Duration    `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	KeepAlive           *duration.Duration    `protobuf:"bytes,6,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
-	LoadBalance         []*WeightedAddr       `protobuf:"bytes,8,rep,name=load_balance,json=loadBalance,proto3" json:"load_balance,omitempty"`
-	LoadBalanceAlgo     Route_LoadBalanceAlgo `protobuf:"varint,9,opt,name=load_balance_algo,json=loadBalanceAlgo,proto3,enum=Route_LoadBalanceAlgo" json:"load_balance_algo,omitempty"`
-	AllowAcme           bool                  `protobuf:"varint,10,opt,name=allow_acme,json=allowAcme,proto3" json:"allow_acme,omitempty"`
-	EnableOptimizedCopy bool                  `protobuf:"varint,11,opt,name=enable_optimized_copy,json=enableOptimizedCopy,proto3" json:"enable_optimized_copy,omitempty"`
-	Speed               *Speed                `protobuf:"bytes,12,opt,name=speed,proto3" json:"speed,omitempty"`
-	Name                string                `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
-	Rule                *Rule                 `protobuf:"bytes,14,opt,name=rule,proto3" json:"rule,omitempty"`
-	Priority            int32                 `protobuf:"varint,15,opt,name=priority,proto3" json:"priority,omitempty"`
-	Middlewares         *Middleware_List      `protobuf:"bytes,16,opt,name=middlewares,proto3" json:"middlewares,omitempty"`
-	Protocol            Protocol              `protobuf:"varint,17,opt,name=protocol,proto3,enum=Protocol" json:"protocol,omitempty"`
-	IsHealthEndpoint    bool                  `protobuf:"varint,18,opt,name=is_health_endpoint,json=isHealthEndpoint,proto3" json:"is_health_endpoint,omitempty"`
-	Service             string                `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
-	HostNames           []string              `protobuf:"bytes,20,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	Certificates []*TLS_Certificate `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
 }
 
-func (x *Route) Reset() {
-	*x = Route{}
+func (x *TLS) Reset() {
+	*x = TLS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[12]
+		mi := &file_tcp_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Route) String() string {
+func (x *TLS) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Route) ProtoMessage() {}
+func (*TLS) ProtoMessage() {}
 
-func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[12]
+func (x *TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,186 +2021,209 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Route.ProtoReflect.Descriptor instead.
-func (*Route) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TLS.ProtoReflect.Descriptor instead.
+func (*TLS) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Route) GetBind() *Bind {
+func (x *TLS) GetCertificates() []*TLS_Certificate {
 	if x != nil {
-		return x.Bind
+		return x.Certificates
 	}
 	return nil
 }
 
-func (x *Route) GetCondition() *RequestMatch {
-	if x != nil {
-		return x.Condition
-	}
-	return nil
-}
+// HTTPOptions configures HTTP listeners. HTTP/2 is negotiated with ALPN on
+// listeners serving TLS, cleartext listeners only serve HTTP/1.1 unless h2c is
+// enabled.
+type HTTPOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Route) GetMetricsLabels() map[string]string {
-	if x != nil {
-		return x.MetricsLabels
-	}
-	return nil
+	// Serve HTTP/2 over cleartext, both to clients with prior knowledge and to
+	// clients asking for an h2c upgrade.
+	H2C bool `protobuf:"varint,1,opt,name=h2c,proto3" json:"h2c,omitempty"`
+	// Only serve HTTP/1.1.
+	DisableHttp2 bool           `protobuf:"varint,2,opt,name=disable_http2,json=disableHttp2,proto3" json:"disable_http2,omitempty"`
+	Http2        *HTTP2Settings `protobuf:"bytes,3,opt,name=http2,proto3" json:"http2,omitempty"`
 }
 
-func (x *Route) GetRetries() *Retries {
-	if x != nil {
-		return x.Retries
+func (x *HTTPOptions) Reset() {
+	*x = HTTPOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Route) GetTimeout() *duration.Duration {
-	if x != nil {
-		return x.Timeout
-	}
-	return nil
+func (x *HTTPOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Route) GetKeepAlive() *duration.Duration {
-	if x != nil {
-		return x.KeepAlive
-	}
-	return nil
-}
+func (*HTTPOptions) ProtoMessage() {}
 
-func (x *Route) GetLoadBalance() []*WeightedAddr {
-	if x != nil {
-		return x.LoadBalance
+func (x *HTTPOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Route) GetLoadBalanceAlgo() Route_LoadBalanceAlgo {
-	if x != nil {
-		return x.LoadBalanceAlgo
-	}
-	return Route_RoundRobinWeighted
+// Deprecated: Use HTTPOptions.ProtoReflect.Descriptor instead.
+func (*HTTPOptions) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Route) GetAllowAcme() bool {
+func (x *HTTPOptions) GetH2C() bool {
 	if x != nil {
-		return x.AllowAcme
+		return x.H2C
 	}
 	return false
 }
 
-func (x *Route) GetEnableOptimizedCopy() bool {
+func (x *HTTPOptions) GetDisableHttp2() bool {
 	if x != nil {
-		return x.EnableOptimizedCopy
+		return x.DisableHttp2
 	}
 	return false
 }
 
-func (x *Route) GetSpeed() *Speed {
+func (x *HTTPOptions) GetHttp2() *HTTP2Settings {
 	if x != nil {
-		return x.Speed
+		return x.Http2
 	}
 	return nil
 }
 
-func (x *Route) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// HTTP2Settings are stream level settings of HTTP/2 connections served by a
+// listener. Zero values use the defaults of the Go HTTP/2 server.
+type HTTP2Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of concurrent streams per connection. Defaults to 250.
+	MaxConcurrentStreams uint32 `protobuf:"varint,1,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// Flow-control window of each stream in bytes. Defaults to 1MB.
+	InitialStreamWindowSize int32 `protobuf:"varint,2,opt,name=initial_stream_window_size,json=initialStreamWindowSize,proto3" json:"initial_stream_window_size,omitempty"`
+	// Flow-control window of each connection in bytes. Defaults to 1MB.
+	InitialConnectionWindowSize int32 `protobuf:"varint,3,opt,name=initial_connection_window_size,json=initialConnectionWindowSize,proto3" json:"initial_connection_window_size,omitempty"`
+	// Largest frame the server reads. Defaults to 1MB.
+	MaxReadFrameSize uint32 `protobuf:"varint,4,opt,name=max_read_frame_size,json=maxReadFrameSize,proto3" json:"max_read_frame_size,omitempty"`
+	// Connections without active streams are closed after idle_timeout.
+	IdleTimeout *duration.Duration `protobuf:"bytes,5,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
 }
 
-func (x *Route) GetRule() *Rule {
-	if x != nil {
-		return x.Rule
+func (x *HTTP2Settings) Reset() {
+	*x = HTTP2Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Route) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
+func (x *HTTP2Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTP2Settings) ProtoMessage() {}
+
+func (x *HTTP2Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Route) GetMiddlewares() *Middleware_List {
+// Deprecated: Use HTTP2Settings.ProtoReflect.Descriptor instead.
+func (*HTTP2Settings) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HTTP2Settings) GetMaxConcurrentStreams() uint32 {
 	if x != nil {
-		return x.Middlewares
+		return x.MaxConcurrentStreams
 	}
-	return nil
+	return 0
 }
 
-func (x *Route) GetProtocol() Protocol {
+func (x *HTTP2Settings) GetInitialStreamWindowSize() int32 {
 	if x != nil {
-		return x.Protocol
+		return x.InitialStreamWindowSize
 	}
-	return Protocol_TCP
+	return 0
 }
 
-func (x *Route) GetIsHealthEndpoint() bool {
+func (x *HTTP2Settings) GetInitialConnectionWindowSize() int32 {
 	if x != nil {
-		return x.IsHealthEndpoint
+		return x.InitialConnectionWindowSize
 	}
-	return false
+	return 0
 }
 
-func (x *Route) GetService() string {
+func (x *HTTP2Settings) GetMaxReadFrameSize() uint32 {
 	if x != nil {
-		return x.Service
+		return x.MaxReadFrameSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Route) GetHostNames() []string {
+func (x *HTTP2Settings) GetIdleTimeout() *duration.Duration {
 	if x != nil {
-		return x.HostNames
+		return x.IdleTimeout
 	}
 	return nil
 }
 
-// Speed defines rate limiting of how fast data willl be copied. This is a
-// described in bytes units
-// "B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"
-//
-// I you want do have upload speed of up to 40 mp per seconds then you can set
-// downstream="20MiB/s"
-type Speed struct {
+// MatchOptions protects listeners from clients that open connections and send
+// data slowly or never. Timeouts only apply to routes that read from the
+// connection to match it, like sni routes.
+type MatchOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The rate at which bytes are written to downstream connection. By downstream
-	// connection we are refering to a connection established on tt server by the
-	// world
-	//   World => tt => Internal
-	//   World [==> downstream connection] tt => Internal
-	Downstream string `protobuf:"bytes,1,opt,name=downstream,proto3" json:"downstream,omitempty"`
-	// The rate at which bytes are written to upstream connection. By upstream
-	// connection we are refering to a connection established by tt server to the
-	// configured internal service.
-	//   World => tt => Internal
-	//   World => tt [=> upstream connection] Internal
-	Upstream string `protobuf:"bytes,2,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// Connections that send nothing within first_byte_timeout are closed.
+	// Defaults to 10s, zero disables it.
+	FirstByteTimeout *duration.Duration `protobuf:"bytes,1,opt,name=first_byte_timeout,json=firstByteTimeout,proto3" json:"first_byte_timeout,omitempty"`
+	// Connections that don't match a route within timeout after they were
+	// accepted are treated as unmatched. Defaults to 30s, zero disables it.
+	Timeout *duration.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Maximum number of bytes read while matching. Defaults to 16389, the size
+	// of the largest TLS record.
+	MaxPeekBytes uint32 `protobuf:"varint,3,opt,name=max_peek_bytes,json=maxPeekBytes,proto3" json:"max_peek_bytes,omitempty"`
 }
 
-func (x *Speed) Reset() {
-	*x = Speed{}
+func (x *MatchOptions) Reset() {
+	*x = MatchOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[13]
+		mi := &file_tcp_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Speed) String() string {
+func (x *MatchOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Speed) ProtoMessage() {}
+func (*MatchOptions) ProtoMessage() {}
 
-func (x *Speed) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[13]
+func (x *MatchOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,51 +2234,64 @@ func (x *Speed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Speed.ProtoReflect.Descriptor instead.
-func (*Speed) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MatchOptions.ProtoReflect.Descriptor instead.
+func (*MatchOptions) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Speed) GetDownstream() string {
+func (x *MatchOptions) GetFirstByteTimeout() *duration.Duration {
 	if x != nil {
-		return x.Downstream
+		return x.FirstByteTimeout
 	}
-	return ""
+	return nil
 }
 
-func (x *Speed) GetUpstream() string {
+func (x *MatchOptions) GetTimeout() *duration.Duration {
 	if x != nil {
-		return x.Upstream
+		return x.Timeout
 	}
-	return ""
+	return nil
 }
 
-type Retries struct {
+func (x *MatchOptions) GetMaxPeekBytes() uint32 {
+	if x != nil {
+		return x.MaxPeekBytes
+	}
+	return 0
+}
+
+// Fallback is what a listener does with connections that match no route.
+type Fallback struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Budget  *RetryBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	Action Fallback_Action `protobuf:"varint,1,opt,name=action,proto3,enum=Fallback_Action" json:"action,omitempty"`
+	// Status code of responses to rejected HTTP requests. Only 421 and 503 are
+	// allowed, defaults to 421.
+	HttpStatus int32 `protobuf:"varint,2,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// Upstreams of forwarded connections.
+	LoadBalance     []*WeightedAddr       `protobuf:"bytes,3,rep,name=load_balance,json=loadBalance,proto3" json:"load_balance,omitempty"`
+	LoadBalanceAlgo Route_LoadBalanceAlgo `protobuf:"varint,4,opt,name=load_balance_algo,json=loadBalanceAlgo,proto3,enum=Route_LoadBalanceAlgo" json:"load_balance_algo,omitempty"`
 }
 
-func (x *Retries) Reset() {
-	*x = Retries{}
+func (x *Fallback) Reset() {
+	*x = Fallback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[14]
+		mi := &file_tcp_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Retries) String() string {
+func (x *Fallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Retries) ProtoMessage() {}
+func (*Fallback) ProtoMessage() {}
 
-func (x *Retries) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[14]
+func (x *Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,63 +2302,101 @@ func (x *Retries) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Retries.ProtoReflect.Descriptor instead.
-func (*Retries) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Fallback.ProtoReflect.Descriptor instead.
+func (*Fallback) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Retries) GetEnabled() bool {
+func (x *Fallback) GetAction() Fallback_Action {
 	if x != nil {
-		return x.Enabled
+		return x.Action
 	}
-	return false
+	return Fallback_CLOSE
 }
 
-func (x *Retries) GetBudget() *RetryBudget {
+func (x *Fallback) GetHttpStatus() int32 {
 	if x != nil {
-		return x.Budget
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *Fallback) GetLoadBalance() []*WeightedAddr {
+	if x != nil {
+		return x.LoadBalance
 	}
 	return nil
 }
 
-type RetryBudget struct {
+func (x *Fallback) GetLoadBalanceAlgo() Route_LoadBalanceAlgo {
+	if x != nil {
+		return x.LoadBalanceAlgo
+	}
+	return Route_RoundRobinWeighted
+}
+
+type Route struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The ratio of additional traffic that may be added by retries.  A
-	// retry_ratio of 0.1 means that 1 retry may be attempted for every 10 regular
-	// requests.  A retry_ratio of 1.0 means that 1 retry may be attempted for
-	// every 1 regular request (in other words, total request load may be doubled
-	// as a result of retries).
-	RetryRatio float32 `protobuf:"fixed32,1,opt,name=retry_ratio,json=retryRatio,proto3" json:"retry_ratio,omitempty"`
-	// The proxy may always attempt this number of retries per second, even if it
-	// would violate the retry_ratio.  This is to allow retries to happen even
-	// when the request rate is very low.
-	MinRetriesPerSecond uint32 `protobuf:"varint,2,opt,name=min_retries_per_second,json=minRetriesPerSecond,proto3" json:"min_retries_per_second,omitempty"`
-	// This duration indicates for how long requests should be considered for the
-	// purposes of enforcing the retry_ratio.  A higher value considers a larger
-	// window and therefore allows burstier retries.
-	Ttl *duration.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Bind                *Bind                 `protobuf:"bytes,1,opt,name=bind,proto3" json:"bind,omitempty"`
+	Condition           *RequestMatch         `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	MetricsLabels       map[string]string     `protobuf:"bytes,3,rep,name=metrics_labels,json=metricsLabels,proto3" json:"metrics_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Retries             *Retries              `protobuf:"bytes,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	Timeout             *duration.Duration    `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	KeepAlive           *duration.Duration    `protobuf:"bytes,6,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
+	LoadBalance         []*WeightedAddr       `protobuf:"bytes,8,rep,name=load_balance,json=loadBalance,proto3" json:"load_balance,omitempty"`
+	LoadBalanceAlgo     Route_LoadBalanceAlgo `protobuf:"varint,9,opt,name=load_balance_algo,json=loadBalanceAlgo,proto3,enum=Route_LoadBalanceAlgo" json:"load_balance_algo,omitempty"`
+	AllowAcme           bool                  `protobuf:"varint,10,opt,name=allow_acme,json=allowAcme,proto3" json:"allow_acme,omitempty"`
+	EnableOptimizedCopy bool                  `protobuf:"varint,11,opt,name=enable_optimized_copy,json=enableOptimizedCopy,proto3" json:"enable_optimized_copy,omitempty"`
+	Speed               *Speed                `protobuf:"bytes,12,opt,name=speed,proto3" json:"speed,omitempty"`
+	Name                string                `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
+	Rule                *Rule                 `protobuf:"bytes,14,opt,name=rule,proto3" json:"rule,omitempty"`
+	Priority            int32                 `protobuf:"varint,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	Middlewares         *Middleware_List      `protobuf:"bytes,16,opt,name=middlewares,proto3" json:"middlewares,omitempty"`
+	Protocol            Protocol              `protobuf:"varint,17,opt,name=protocol,proto3,enum=Protocol" json:"protocol,omitempty"`
+	IsHealthEndpoint    bool                  `protobuf:"varint,18,opt,name=is_health_endpoint,json=isHealthEndpoint,proto3" json:"is_health_endpoint,omitempty"`
+	Service             string                `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
+	HostNames           []string              `protobuf:"bytes,20,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	ForwardProxy        *ForwardProxy         `protobuf:"bytes,21,opt,name=forward_proxy,json=forwardProxy,proto3" json:"forward_proxy,omitempty"`
+	// HttpListener passes connections matched by this TCP route to the HTTP
+	// routes bound to the internal listener with this name, instead of dialing
+	// load_balance targets.
+	HttpListener string `protobuf:"bytes,22,opt,name=http_listener,json=httpListener,proto3" json:"http_listener,omitempty"`
+	// TlsFingerprint routes, blocks or rate limits TLS connections by the
+	// fingerprint of their ClientHello.
+	TlsFingerprint *TLSFingerprint `protobuf:"bytes,23,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"`
+	// Affinity pins clients to the upstream endpoint they were first balanced
+	// to. It requires the cache to be enabled.
+	Affinity *Affinity `protobuf:"bytes,24,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	// HttpUpstream configures connections of HTTP routes to load_balance.
+	HttpUpstream *HTTPUpstream `protobuf:"bytes,25,opt,name=http_upstream,json=httpUpstream,proto3" json:"http_upstream,omitempty"`
+	// Websocket configures routes with the WEBSOCKET protocol.
+	Websocket *WebSocket `protobuf:"bytes,26,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	// Grpc proxies requests of HTTP and QUIC routes as gRPC calls.
+	Grpc *GRPC `protobuf:"bytes,27,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// HealthCheck checks load_balance targets of HTTP, WEBSOCKET and QUIC routes.
+	HealthCheck *HealthCheck `protobuf:"bytes,28,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
 }
 
-func (x *RetryBudget) Reset() {
-	*x = RetryBudget{}
+func (x *Route) Reset() {
+	*x = Route{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[15]
+		mi := &file_tcp_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetryBudget) String() string {
+func (x *Route) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryBudget) ProtoMessage() {}
+func (*Route) ProtoMessage() {}
 
-func (x *RetryBudget) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[15]
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,250 +2407,246 @@ func (x *RetryBudget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryBudget.ProtoReflect.Descriptor instead.
-func (*RetryBudget) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RetryBudget) GetRetryRatio() float32 {
+func (x *Route) GetBind() *Bind {
 	if x != nil {
-		return x.RetryRatio
+		return x.Bind
 	}
-	return 0
+	return nil
 }
 
-func (x *RetryBudget) GetMinRetriesPerSecond() uint32 {
+func (x *Route) GetCondition() *RequestMatch {
 	if x != nil {
-		return x.MinRetriesPerSecond
+		return x.Condition
 	}
-	return 0
+	return nil
 }
 
-func (x *RetryBudget) GetTtl() *duration.Duration {
+func (x *Route) GetMetricsLabels() map[string]string {
 	if x != nil {
-		return x.Ttl
+		return x.MetricsLabels
 	}
 	return nil
 }
 
-type RequestMatch struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Match:
-	//	*RequestMatch_Sni
-	//	*RequestMatch_Host
-	//	*RequestMatch_Path
-	//	*RequestMatch_Fixed
-	Match isRequestMatch_Match `protobuf_oneof:"match"`
-}
-
-func (x *RequestMatch) Reset() {
-	*x = RequestMatch{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Route) GetRetries() *Retries {
+	if x != nil {
+		return x.Retries
 	}
+	return nil
 }
 
-func (x *RequestMatch) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RequestMatch) ProtoMessage() {}
-
-func (x *RequestMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Route) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RequestMatch.ProtoReflect.Descriptor instead.
-func (*RequestMatch) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{16}
+func (x *Route) GetKeepAlive() *duration.Duration {
+	if x != nil {
+		return x.KeepAlive
+	}
+	return nil
 }
 
-func (m *RequestMatch) GetMatch() isRequestMatch_Match {
-	if m != nil {
-		return m.Match
+func (x *Route) GetLoadBalance() []*WeightedAddr {
+	if x != nil {
+		return x.LoadBalance
 	}
 	return nil
 }
 
-func (x *RequestMatch) GetSni() string {
-	if x, ok := x.GetMatch().(*RequestMatch_Sni); ok {
-		return x.Sni
+func (x *Route) GetLoadBalanceAlgo() Route_LoadBalanceAlgo {
+	if x != nil {
+		return x.LoadBalanceAlgo
 	}
-	return ""
+	return Route_RoundRobinWeighted
 }
 
-func (x *RequestMatch) GetHost() string {
-	if x, ok := x.GetMatch().(*RequestMatch_Host); ok {
-		return x.Host
+func (x *Route) GetAllowAcme() bool {
+	if x != nil {
+		return x.AllowAcme
 	}
-	return ""
+	return false
 }
 
-func (x *RequestMatch) GetPath() string {
-	if x, ok := x.GetMatch().(*RequestMatch_Path); ok {
-		return x.Path
+func (x *Route) GetEnableOptimizedCopy() bool {
+	if x != nil {
+		return x.EnableOptimizedCopy
 	}
-	return ""
+	return false
 }
 
-func (x *RequestMatch) GetFixed() *empty.Empty {
-	if x, ok := x.GetMatch().(*RequestMatch_Fixed); ok {
-		return x.Fixed
+func (x *Route) GetSpeed() *Speed {
+	if x != nil {
+		return x.Speed
 	}
 	return nil
 }
 
-type isRequestMatch_Match interface {
-	isRequestMatch_Match()
+func (x *Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type RequestMatch_Sni struct {
-	Sni string `protobuf:"bytes,1,opt,name=sni,proto3,oneof"`
+func (x *Route) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
 }
 
-type RequestMatch_Host struct {
-	Host string `protobuf:"bytes,2,opt,name=host,proto3,oneof"`
+func (x *Route) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-type RequestMatch_Path struct {
-	Path string `protobuf:"bytes,3,opt,name=path,proto3,oneof"`
+func (x *Route) GetMiddlewares() *Middleware_List {
+	if x != nil {
+		return x.Middlewares
+	}
+	return nil
 }
 
-type RequestMatch_Fixed struct {
-	Fixed *empty.Empty `protobuf:"bytes,4,opt,name=fixed,proto3,oneof"`
+func (x *Route) GetProtocol() Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Protocol_TCP
 }
 
-func (*RequestMatch_Sni) isRequestMatch_Match() {}
-
-func (*RequestMatch_Host) isRequestMatch_Match() {}
-
-func (*RequestMatch_Path) isRequestMatch_Match() {}
-
-func (*RequestMatch_Fixed) isRequestMatch_Match() {}
-
-type Context struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Protocol   Protocol      `protobuf:"varint,2,opt,name=protocol,proto3,enum=Protocol" json:"protocol,omitempty"`
-	Downstream *Context_Conn `protobuf:"bytes,3,opt,name=downstream,proto3" json:"downstream,omitempty"`
-	Upstream   *Context_Conn `protobuf:"bytes,4,opt,name=upstream,proto3" json:"upstream,omitempty"`
-	Info       *Context_Info `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+func (x *Route) GetIsHealthEndpoint() bool {
+	if x != nil {
+		return x.IsHealthEndpoint
+	}
+	return false
 }
 
-func (x *Context) Reset() {
-	*x = Context{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Route) GetService() string {
+	if x != nil {
+		return x.Service
 	}
+	return ""
 }
 
-func (x *Context) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Route) GetHostNames() []string {
+	if x != nil {
+		return x.HostNames
+	}
+	return nil
 }
 
-func (*Context) ProtoMessage() {}
+func (x *Route) GetForwardProxy() *ForwardProxy {
+	if x != nil {
+		return x.ForwardProxy
+	}
+	return nil
+}
 
-func (x *Context) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Route) GetHttpListener() string {
+	if x != nil {
+		return x.HttpListener
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{17}
+func (x *Route) GetTlsFingerprint() *TLSFingerprint {
+	if x != nil {
+		return x.TlsFingerprint
+	}
+	return nil
 }
 
-func (x *Context) GetId() int64 {
+func (x *Route) GetAffinity() *Affinity {
 	if x != nil {
-		return x.Id
+		return x.Affinity
 	}
-	return 0
+	return nil
 }
 
-func (x *Context) GetProtocol() Protocol {
+func (x *Route) GetHttpUpstream() *HTTPUpstream {
 	if x != nil {
-		return x.Protocol
+		return x.HttpUpstream
 	}
-	return Protocol_TCP
+	return nil
 }
 
-func (x *Context) GetDownstream() *Context_Conn {
+func (x *Route) GetWebsocket() *WebSocket {
 	if x != nil {
-		return x.Downstream
+		return x.Websocket
 	}
 	return nil
 }
 
-func (x *Context) GetUpstream() *Context_Conn {
+func (x *Route) GetGrpc() *GRPC {
 	if x != nil {
-		return x.Upstream
+		return x.Grpc
 	}
 	return nil
 }
 
-func (x *Context) GetInfo() *Context_Info {
+func (x *Route) GetHealthCheck() *HealthCheck {
 	if x != nil {
-		return x.Info
+		return x.HealthCheck
 	}
 	return nil
 }
 
-type Rule struct {
+// HealthCheck configures health checks of load_balance targets. Requests are
+// only balanced over healthy targets, when no target is healthy all targets
+// are used. Targets are healthy until checks fail.
+type HealthCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Match:
-	//	*Rule_All
-	//	*Rule_Any
-	//	*Rule_Not
-	//	*Rule_Tcp
-	//	*Rule_Http
-	Match isRule_Match `protobuf_oneof:"match"`
-}
-
-func (x *Rule) Reset() {
-	*x = Rule{}
+	// Path requested from targets by active checks. Active checks are disabled
+	// when empty.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Status codes of healthy responses. Defaults to any 2xx or 3xx status.
+	ExpectedStatus []int32 `protobuf:"varint,2,rep,packed,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`
+	// Regular expression the body of healthy responses must match. Only the
+	// first 64KiB of the body is read.
+	BodyRegex string `protobuf:"bytes,3,opt,name=body_regex,json=bodyRegex,proto3" json:"body_regex,omitempty"`
+	// Time between active checks. Defaults to 10s.
+	Interval *duration.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Checks not answered within timeout fail. Defaults to 2s.
+	Timeout *duration.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Number of checks in a row that must pass to mark an unhealthy target
+	// healthy again. Defaults to 2.
+	HealthyThreshold uint32 `protobuf:"varint,6,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	// Number of checks in a row that must fail to mark a target unhealthy.
+	// Defaults to 3.
+	UnhealthyThreshold uint32               `protobuf:"varint,7,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
+	Passive            *HealthCheck_Passive `protobuf:"bytes,8,opt,name=passive,proto3" json:"passive,omitempty"`
+}
+
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[18]
+		mi := &file_tcp_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rule) String() string {
+func (x *HealthCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule) ProtoMessage() {}
+func (*HealthCheck) ProtoMessage() {}
 
-func (x *Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[18]
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,117 +2657,102 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
-func (*Rule) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *Rule) GetMatch() isRule_Match {
-	if m != nil {
-		return m.Match
+func (x *HealthCheck) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *Rule) GetAll() *Rule_List {
-	if x, ok := x.GetMatch().(*Rule_All); ok {
-		return x.All
+func (x *HealthCheck) GetExpectedStatus() []int32 {
+	if x != nil {
+		return x.ExpectedStatus
 	}
 	return nil
 }
 
-func (x *Rule) GetAny() *Rule_List {
-	if x, ok := x.GetMatch().(*Rule_Any); ok {
-		return x.Any
+func (x *HealthCheck) GetBodyRegex() string {
+	if x != nil {
+		return x.BodyRegex
 	}
-	return nil
+	return ""
 }
 
-func (x *Rule) GetNot() *Rule {
-	if x, ok := x.GetMatch().(*Rule_Not); ok {
-		return x.Not
+func (x *HealthCheck) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *Rule) GetTcp() *Rule_TCP {
-	if x, ok := x.GetMatch().(*Rule_Tcp); ok {
-		return x.Tcp
+func (x *HealthCheck) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
 	}
 	return nil
 }
 
-func (x *Rule) GetHttp() *Rule_HTTP {
-	if x, ok := x.GetMatch().(*Rule_Http); ok {
-		return x.Http
+func (x *HealthCheck) GetHealthyThreshold() uint32 {
+	if x != nil {
+		return x.HealthyThreshold
 	}
-	return nil
-}
-
-type isRule_Match interface {
-	isRule_Match()
-}
-
-type Rule_All struct {
-	All *Rule_List `protobuf:"bytes,1,opt,name=all,proto3,oneof"`
-}
-
-type Rule_Any struct {
-	Any *Rule_List `protobuf:"bytes,2,opt,name=any,proto3,oneof"`
-}
-
-type Rule_Not struct {
-	Not *Rule `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
+	return 0
 }
 
-type Rule_Tcp struct {
-	Tcp *Rule_TCP `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
+func (x *HealthCheck) GetUnhealthyThreshold() uint32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
 }
 
-type Rule_Http struct {
-	Http *Rule_HTTP `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
+func (x *HealthCheck) GetPassive() *HealthCheck_Passive {
+	if x != nil {
+		return x.Passive
+	}
+	return nil
 }
 
-func (*Rule_All) isRule_Match() {}
-
-func (*Rule_Any) isRule_Match() {}
-
-func (*Rule_Not) isRule_Match() {}
-
-func (*Rule_Tcp) isRule_Match() {}
-
-func (*Rule_Http) isRule_Match() {}
-
-// AccessEntry records metrics about a http request.
-type AccessEntry struct {
+// GRPC configures routes proxying gRPC calls. Upstreams are always reached over
+// HTTP/2, cleartext for http addresses, unless http_upstream picks H2 or H2C.
+// Calls share connections to upstreams so each call is balanced on its own.
+type GRPC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request      *AccessEntry_Request      `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	Response     *AccessEntry_Response     `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	ReverseProxy *AccessEntry_ReverseProxy `protobuf:"bytes,3,opt,name=reverse_proxy,json=reverseProxy,proto3" json:"reverse_proxy,omitempty"`
-	Info         *AccessEntry_Info         `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Duration     *duration.Duration        `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Web translates gRPC-Web requests from browsers into native gRPC calls.
+	Web bool `protobuf:"varint,1,opt,name=web,proto3" json:"web,omitempty"`
+	// Deadline of calls without a grpc-timeout header. Zero leaves them without
+	// a deadline.
+	DefaultTimeout *duration.Duration `protobuf:"bytes,2,opt,name=default_timeout,json=defaultTimeout,proto3" json:"default_timeout,omitempty"`
+	// Upper limit of deadlines asked for by clients with grpc-timeout, calls
+	// without a deadline get max_timeout too. Zero allows any deadline.
+	MaxTimeout *duration.Duration `protobuf:"bytes,3,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
 }
 
-func (x *AccessEntry) Reset() {
-	*x = AccessEntry{}
+func (x *GRPC) Reset() {
+	*x = GRPC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[19]
+		mi := &file_tcp_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccessEntry) String() string {
+func (x *GRPC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry) ProtoMessage() {}
+func (*GRPC) ProtoMessage() {}
 
-func (x *AccessEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[19]
+func (x *GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,72 +2763,76 @@ func (x *AccessEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessEntry.ProtoReflect.Descriptor instead.
-func (*AccessEntry) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *AccessEntry) GetRequest() *AccessEntry_Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *AccessEntry) GetResponse() *AccessEntry_Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+// Deprecated: Use GRPC.ProtoReflect.Descriptor instead.
+func (*GRPC) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AccessEntry) GetReverseProxy() *AccessEntry_ReverseProxy {
+func (x *GRPC) GetWeb() bool {
 	if x != nil {
-		return x.ReverseProxy
+		return x.Web
 	}
-	return nil
+	return false
 }
 
-func (x *AccessEntry) GetInfo() *AccessEntry_Info {
+func (x *GRPC) GetDefaultTimeout() *duration.Duration {
 	if x != nil {
-		return x.Info
+		return x.DefaultTimeout
 	}
 	return nil
 }
 
-func (x *AccessEntry) GetDuration() *duration.Duration {
+func (x *GRPC) GetMaxTimeout() *duration.Duration {
 	if x != nil {
-		return x.Duration
+		return x.MaxTimeout
 	}
 	return nil
 }
 
-type Raft_KeyValue struct {
+// WebSocket configures how upgraded connections are proxied. Only HTTP/1.1
+// upgrades are supported, other requests to WEBSOCKET routes are answered with
+// 426 Upgrade Required.
+type WebSocket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action  Raft_KeyValue_Action   `protobuf:"varint,1,opt,name=action,proto3,enum=Raft_KeyValue_Action" json:"action,omitempty"`
-	Context *Raft_KeyValue_Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
-}
-
-func (x *Raft_KeyValue) Reset() {
-	*x = Raft_KeyValue{}
+	// Origins allowed to open connections, compared with the Origin header.
+	// Entries are exact origins eg https://example.com or wildcards eg
+	// https://*.example.com. Empty allows any origin, otherwise requests without
+	// an Origin header are rejected.
+	AllowedOrigins []string `protobuf:"bytes,1,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	// Subprotocols clients are allowed to ask for with Sec-WebSocket-Protocol.
+	// Other subprotocols are removed from the request, and requests that only ask
+	// for other subprotocols are rejected. Empty allows any subprotocol.
+	Subprotocols []string `protobuf:"bytes,2,rep,name=subprotocols,proto3" json:"subprotocols,omitempty"`
+	// Connections with no frames in either direction within idle_timeout are
+	// closed. Zero disables it.
+	IdleTimeout *duration.Duration `protobuf:"bytes,3,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	// Clients are sent a ping every ping_interval. Connections of clients that
+	// send nothing within ping_timeout after a ping are closed. ping_timeout
+	// defaults to ping_interval.
+	PingInterval *duration.Duration `protobuf:"bytes,4,opt,name=ping_interval,json=pingInterval,proto3" json:"ping_interval,omitempty"`
+	PingTimeout  *duration.Duration `protobuf:"bytes,5,opt,name=ping_timeout,json=pingTimeout,proto3" json:"ping_timeout,omitempty"`
+}
+
+func (x *WebSocket) Reset() {
+	*x = WebSocket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[20]
+		mi := &file_tcp_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Raft_KeyValue) String() string {
+func (x *WebSocket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Raft_KeyValue) ProtoMessage() {}
+func (*WebSocket) ProtoMessage() {}
 
-func (x *Raft_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[20]
+func (x *WebSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,52 +2843,100 @@ func (x *Raft_KeyValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Raft_KeyValue.ProtoReflect.Descriptor instead.
-func (*Raft_KeyValue) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use WebSocket.ProtoReflect.Descriptor instead.
+func (*WebSocket) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Raft_KeyValue) GetAction() Raft_KeyValue_Action {
+func (x *WebSocket) GetAllowedOrigins() []string {
 	if x != nil {
-		return x.Action
+		return x.AllowedOrigins
 	}
-	return Raft_KeyValue_GET
+	return nil
 }
 
-func (x *Raft_KeyValue) GetContext() *Raft_KeyValue_Context {
+func (x *WebSocket) GetSubprotocols() []string {
 	if x != nil {
-		return x.Context
+		return x.Subprotocols
 	}
 	return nil
 }
 
-type Raft_Log struct {
+func (x *WebSocket) GetIdleTimeout() *duration.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *WebSocket) GetPingInterval() *duration.Duration {
+	if x != nil {
+		return x.PingInterval
+	}
+	return nil
+}
+
+func (x *WebSocket) GetPingTimeout() *duration.Duration {
+	if x != nil {
+		return x.PingTimeout
+	}
+	return nil
+}
+
+// HTTPUpstream configures the protocol HTTP routes use to talk to upstreams.
+// Connections to upstreams are dialed with the timeout and keepAlive of the
+// route.
+type HTTPUpstream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Entry:
-	//	*Raft_Log_KeyValue
-	Entry isRaft_Log_Entry `protobuf_oneof:"entry"`
-}
-
-func (x *Raft_Log) Reset() {
-	*x = Raft_Log{}
+	Protocol HTTPUpstream_Protocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=HTTPUpstream_Protocol" json:"protocol,omitempty"`
+	// HTTP/2 connections that received no frame within read_idle_timeout are
+	// checked with a ping, and closed if the ping is not answered within
+	// ping_timeout. Defaults to 15s when read_idle_timeout is set.
+	ReadIdleTimeout *duration.Duration `protobuf:"bytes,2,opt,name=read_idle_timeout,json=readIdleTimeout,proto3" json:"read_idle_timeout,omitempty"`
+	PingTimeout     *duration.Duration `protobuf:"bytes,3,opt,name=ping_timeout,json=pingTimeout,proto3" json:"ping_timeout,omitempty"`
+	// Wait for a stream to finish instead of opening a new connection when an
+	// HTTP/2 upstream reached its max concurrent streams.
+	StrictMaxConcurrentStreams bool `protobuf:"varint,4,opt,name=strict_max_concurrent_streams,json=strictMaxConcurrentStreams,proto3" json:"strict_max_concurrent_streams,omitempty"`
+	// Largest response header list accepted from HTTP/2 upstreams in bytes.
+	MaxHeaderListSize uint32 `protobuf:"varint,5,opt,name=max_header_list_size,json=maxHeaderListSize,proto3" json:"max_header_list_size,omitempty"`
+	// Requests whose response headers did not arrive within
+	// response_header_timeout after the request was sent fail with 504.
+	ResponseHeaderTimeout *duration.Duration `protobuf:"bytes,6,opt,name=response_header_timeout,json=responseHeaderTimeout,proto3" json:"response_header_timeout,omitempty"`
+	// Requests that are not completed within request_timeout fail with 504 when
+	// response headers were not sent yet, otherwise they are aborted.
+	RequestTimeout *duration.Duration `protobuf:"bytes,7,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// Maximum number of idle connections to all upstreams of the route.
+	MaxIdleConns uint32 `protobuf:"varint,8,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	// Maximum number of idle connections to each upstream, defaults to 2.
+	MaxIdleConnsPerHost uint32 `protobuf:"varint,9,opt,name=max_idle_conns_per_host,json=maxIdleConnsPerHost,proto3" json:"max_idle_conns_per_host,omitempty"`
+	// Maximum number of connections to each upstream. Requests wait for a
+	// connection when the limit is reached. Zero means no limit.
+	MaxConnsPerHost uint32 `protobuf:"varint,10,opt,name=max_conns_per_host,json=maxConnsPerHost,proto3" json:"max_conns_per_host,omitempty"`
+	// Idle connections are closed after idle_conn_timeout. Connection pool
+	// settings only apply to AUTO and HTTP1 upstreams.
+	IdleConnTimeout *duration.Duration `protobuf:"bytes,11,opt,name=idle_conn_timeout,json=idleConnTimeout,proto3" json:"idle_conn_timeout,omitempty"`
+}
+
+func (x *HTTPUpstream) Reset() {
+	*x = HTTPUpstream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[21]
+		mi := &file_tcp_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Raft_Log) String() string {
+func (x *HTTPUpstream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Raft_Log) ProtoMessage() {}
+func (*HTTPUpstream) ProtoMessage() {}
 
-func (x *Raft_Log) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[21]
+func (x *HTTPUpstream) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,62 +2947,118 @@ func (x *Raft_Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Raft_Log.ProtoReflect.Descriptor instead.
-func (*Raft_Log) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use HTTPUpstream.ProtoReflect.Descriptor instead.
+func (*HTTPUpstream) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *Raft_Log) GetEntry() isRaft_Log_Entry {
-	if m != nil {
-		return m.Entry
+func (x *HTTPUpstream) GetProtocol() HTTPUpstream_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return HTTPUpstream_AUTO
+}
+
+func (x *HTTPUpstream) GetReadIdleTimeout() *duration.Duration {
+	if x != nil {
+		return x.ReadIdleTimeout
 	}
 	return nil
 }
 
-func (x *Raft_Log) GetKeyValue() *Raft_KeyValue {
-	if x, ok := x.GetEntry().(*Raft_Log_KeyValue); ok {
-		return x.KeyValue
+func (x *HTTPUpstream) GetPingTimeout() *duration.Duration {
+	if x != nil {
+		return x.PingTimeout
 	}
 	return nil
 }
 
-type isRaft_Log_Entry interface {
-	isRaft_Log_Entry()
+func (x *HTTPUpstream) GetStrictMaxConcurrentStreams() bool {
+	if x != nil {
+		return x.StrictMaxConcurrentStreams
+	}
+	return false
 }
 
-type Raft_Log_KeyValue struct {
-	KeyValue *Raft_KeyValue `protobuf:"bytes,1,opt,name=key_value,json=keyValue,proto3,oneof"`
+func (x *HTTPUpstream) GetMaxHeaderListSize() uint32 {
+	if x != nil {
+		return x.MaxHeaderListSize
+	}
+	return 0
 }
 
-func (*Raft_Log_KeyValue) isRaft_Log_Entry() {}
+func (x *HTTPUpstream) GetResponseHeaderTimeout() *duration.Duration {
+	if x != nil {
+		return x.ResponseHeaderTimeout
+	}
+	return nil
+}
 
-type Raft_KeyValue_Context struct {
+func (x *HTTPUpstream) GetRequestTimeout() *duration.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *HTTPUpstream) GetMaxIdleConns() uint32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *HTTPUpstream) GetMaxIdleConnsPerHost() uint32 {
+	if x != nil {
+		return x.MaxIdleConnsPerHost
+	}
+	return 0
+}
+
+func (x *HTTPUpstream) GetMaxConnsPerHost() uint32 {
+	if x != nil {
+		return x.MaxConnsPerHost
+	}
+	return 0
+}
+
+func (x *HTTPUpstream) GetIdleConnTimeout() *duration.Duration {
+	if x != nil {
+		return x.IdleConnTimeout
+	}
+	return nil
+}
+
+// Affinity keeps sending connections from the same client to the same
+// load_balance endpoint. Clients are balanced again when their endpoint fails
+// to connect or is no longer in load_balance.
+type Affinity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Key Affinity_Key `protobuf:"varint,1,opt,name=key,proto3,enum=Affinity_Key" json:"key,omitempty"`
+	// How long a client stays pinned after its last connection. Defaults to 10m.
+	Ttl *duration.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *Raft_KeyValue_Context) Reset() {
-	*x = Raft_KeyValue_Context{}
+func (x *Affinity) Reset() {
+	*x = Affinity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[22]
+		mi := &file_tcp_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Raft_KeyValue_Context) String() string {
+func (x *Affinity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Raft_KeyValue_Context) ProtoMessage() {}
+func (*Affinity) ProtoMessage() {}
 
-func (x *Raft_KeyValue_Context) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[22]
+func (x *Affinity) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,59 +3069,62 @@ func (x *Raft_KeyValue_Context) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Raft_KeyValue_Context.ProtoReflect.Descriptor instead.
-func (*Raft_KeyValue_Context) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{4, 0, 0}
+// Deprecated: Use Affinity.ProtoReflect.Descriptor instead.
+func (*Affinity) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Raft_KeyValue_Context) GetKey() []byte {
+func (x *Affinity) GetKey() Affinity_Key {
 	if x != nil {
 		return x.Key
 	}
-	return nil
+	return Affinity_SOURCE_IP
 }
 
-func (x *Raft_KeyValue_Context) GetValue() []byte {
+func (x *Affinity) GetTtl() *duration.Duration {
 	if x != nil {
-		return x.Value
+		return x.Ttl
 	}
 	return nil
 }
 
-func (x *Raft_KeyValue_Context) GetExpiresAt() uint64 {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return 0
-}
-
-type Store_SetRequest struct {
+// TLSFingerprint filters connections of a TCP route by the JA3 or JA4
+// fingerprint of their TLS ClientHello. JA3 values are md5 hashes in hex eg
+// 773906b0efdefa24a7f2b8eb6985bf37 and JA4 values are full fingerprints eg
+// t13d1516h2_8daaf6152771_e5627efa2ab1. Connections that are not TLS have no
+// fingerprint.
+type TLSFingerprint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// When set the route only matches connections with one of these
+	// fingerprints, other routes on the listener are tried otherwise.
+	Match *TLSFingerprint_List `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Connections with one of these fingerprints are closed.
+	Block *TLSFingerprint_List `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Limits how often each fingerprint can connect. Connections over the limit
+	// are closed.
+	Rate *TLSFingerprint_Rate `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (x *Store_SetRequest) Reset() {
-	*x = Store_SetRequest{}
+func (x *TLSFingerprint) Reset() {
+	*x = TLSFingerprint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[23]
+		mi := &file_tcp_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Store_SetRequest) String() string {
+func (x *TLSFingerprint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store_SetRequest) ProtoMessage() {}
+func (*TLSFingerprint) ProtoMessage() {}
 
-func (x *Store_SetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[23]
+func (x *TLSFingerprint) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2116,55 +3135,66 @@ func (x *Store_SetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store_SetRequest.ProtoReflect.Descriptor instead.
-func (*Store_SetRequest) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use TLSFingerprint.ProtoReflect.Descriptor instead.
+func (*TLSFingerprint) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Store_SetRequest) GetKey() []byte {
+func (x *TLSFingerprint) GetMatch() *TLSFingerprint_List {
 	if x != nil {
-		return x.Key
+		return x.Match
 	}
 	return nil
 }
 
-func (x *Store_SetRequest) GetValue() []byte {
+func (x *TLSFingerprint) GetBlock() *TLSFingerprint_List {
 	if x != nil {
-		return x.Value
+		return x.Block
 	}
 	return nil
 }
 
-func (x *Store_SetRequest) GetExpiresAt() uint64 {
+func (x *TLSFingerprint) GetRate() *TLSFingerprint_Rate {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Rate
 	}
-	return 0
+	return nil
 }
 
-type Store_SetResponse struct {
+// ForwardProxy turns a tcp route into an egress proxy. Instead of dialing the
+// load_balance targets, the client is expected to ask for a destination using
+// SOCKS5 or HTTP CONNECT. The destination is checked against allow before it
+// is dialed.
+type ForwardProxy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Mode ForwardProxy_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=ForwardProxy_Mode" json:"mode,omitempty"`
+	// When set clients must authenticate with one of these users. SOCKS5 uses
+	// username/password authentication and HTTP CONNECT uses Proxy-Authorization
+	// basic auth.
+	Users []*ForwardProxy_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Allow *ForwardProxy_Allow  `protobuf:"bytes,3,opt,name=allow,proto3" json:"allow,omitempty"`
 }
 
-func (x *Store_SetResponse) Reset() {
-	*x = Store_SetResponse{}
+func (x *ForwardProxy) Reset() {
+	*x = ForwardProxy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[24]
+		mi := &file_tcp_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Store_SetResponse) String() string {
+func (x *ForwardProxy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store_SetResponse) ProtoMessage() {}
+func (*ForwardProxy) ProtoMessage() {}
 
-func (x *Store_SetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[24]
+func (x *ForwardProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2175,36 +3205,74 @@ func (x *Store_SetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store_SetResponse.ProtoReflect.Descriptor instead.
-func (*Store_SetResponse) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use ForwardProxy.ProtoReflect.Descriptor instead.
+func (*ForwardProxy) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{26}
 }
 
-type Store_GetRequest struct {
+func (x *ForwardProxy) GetMode() ForwardProxy_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return ForwardProxy_ANY
+}
+
+func (x *ForwardProxy) GetUsers() []*ForwardProxy_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ForwardProxy) GetAllow() *ForwardProxy_Allow {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+// Speed defines rate limiting of how fast data willl be copied. This is a
+// described in bytes units
+// "B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"
+//
+// I you want do have upload speed of up to 40 mp per seconds then you can set
+// downstream="20MiB/s"
+type Speed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The rate at which bytes are written to downstream connection. By downstream
+	// connection we are refering to a connection established on tt server by the
+	// world
+	//   World => tt => Internal
+	//   World [==> downstream connection] tt => Internal
+	Downstream string `protobuf:"bytes,1,opt,name=downstream,proto3" json:"downstream,omitempty"`
+	// The rate at which bytes are written to upstream connection. By upstream
+	// connection we are refering to a connection established by tt server to the
+	// configured internal service.
+	//   World => tt => Internal
+	//   World => tt [=> upstream connection] Internal
+	Upstream string `protobuf:"bytes,2,opt,name=upstream,proto3" json:"upstream,omitempty"`
 }
 
-func (x *Store_GetRequest) Reset() {
-	*x = Store_GetRequest{}
+func (x *Speed) Reset() {
+	*x = Speed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[25]
+		mi := &file_tcp_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Store_GetRequest) String() string {
+func (x *Speed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store_GetRequest) ProtoMessage() {}
+func (*Speed) ProtoMessage() {}
 
-func (x *Store_GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[25]
+func (x *Speed) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2215,44 +3283,64 @@ func (x *Store_GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store_GetRequest.ProtoReflect.Descriptor instead.
-func (*Store_GetRequest) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{5, 2}
+// Deprecated: Use Speed.ProtoReflect.Descriptor instead.
+func (*Speed) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Store_GetRequest) GetKey() []byte {
+func (x *Speed) GetDownstream() string {
 	if x != nil {
-		return x.Key
+		return x.Downstream
 	}
-	return nil
+	return ""
 }
 
-type Store_GetResponse struct {
+func (x *Speed) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
+}
+
+// Retries configures retries of requests to HTTP routes. Only requests with
+// idempotent methods are retried, on a different load_balance target when there
+// is one. Requests are retried when connecting to the upstream failed or the
+// upstream answered with 502, 503, 504 or one of status_codes.
+type Retries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value     []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	ExpiresAt uint64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Budget limits retries across all requests of the route. Defaults to a
+	// retry_ratio of 0.2, 10 min_retries_per_second and a ttl of 10s.
+	Budget *RetryBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Other status codes of responses that are retried.
+	StatusCodes []int32 `protobuf:"varint,3,rep,packed,name=status_codes,json=statusCodes,proto3" json:"status_codes,omitempty"`
+	// Maximum number of retries of a request, defaults to 2.
+	MaxRetries uint32 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Request bodies are buffered up to max_body_size bytes so they can be sent
+	// again, requests with larger bodies are not retried. Defaults to 64KiB.
+	MaxBodySize uint32 `protobuf:"varint,5,opt,name=max_body_size,json=maxBodySize,proto3" json:"max_body_size,omitempty"`
 }
 
-func (x *Store_GetResponse) Reset() {
-	*x = Store_GetResponse{}
+func (x *Retries) Reset() {
+	*x = Retries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[26]
+		mi := &file_tcp_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Store_GetResponse) String() string {
+func (x *Retries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store_GetResponse) ProtoMessage() {}
+func (*Retries) ProtoMessage() {}
 
-func (x *Store_GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[26]
+func (x *Retries) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2263,50 +3351,84 @@ func (x *Store_GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store_GetResponse.ProtoReflect.Descriptor instead.
-func (*Store_GetResponse) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{5, 3}
-}
+// Deprecated: Use Retries.ProtoReflect.Descriptor instead.
+func (*Retries) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{28}
+}
 
-func (x *Store_GetResponse) GetValue() []byte {
+func (x *Retries) GetEnabled() bool {
 	if x != nil {
-		return x.Value
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Retries) GetBudget() *RetryBudget {
+	if x != nil {
+		return x.Budget
 	}
 	return nil
 }
 
-func (x *Store_GetResponse) GetExpiresAt() uint64 {
+func (x *Retries) GetStatusCodes() []int32 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *Retries) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
 	}
 	return 0
 }
 
-type Middleware_List struct {
+func (x *Retries) GetMaxBodySize() uint32 {
+	if x != nil {
+		return x.MaxBodySize
+	}
+	return 0
+}
+
+type RetryBudget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*Middleware `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// The ratio of additional traffic that may be added by retries.  A
+	// retry_ratio of 0.1 means that 1 retry may be attempted for every 10 regular
+	// requests.  A retry_ratio of 1.0 means that 1 retry may be attempted for
+	// every 1 regular request (in other words, total request load may be doubled
+	// as a result of retries).
+	RetryRatio float32 `protobuf:"fixed32,1,opt,name=retry_ratio,json=retryRatio,proto3" json:"retry_ratio,omitempty"`
+	// The proxy may always attempt this number of retries per second, even if it
+	// would violate the retry_ratio.  This is to allow retries to happen even
+	// when the request rate is very low.
+	MinRetriesPerSecond uint32 `protobuf:"varint,2,opt,name=min_retries_per_second,json=minRetriesPerSecond,proto3" json:"min_retries_per_second,omitempty"`
+	// This duration indicates for how long requests should be considered for the
+	// purposes of enforcing the retry_ratio.  A higher value considers a larger
+	// window and therefore allows burstier retries.
+	Ttl *duration.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *Middleware_List) Reset() {
-	*x = Middleware_List{}
+func (x *RetryBudget) Reset() {
+	*x = RetryBudget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[28]
+		mi := &file_tcp_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Middleware_List) String() string {
+func (x *RetryBudget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware_List) ProtoMessage() {}
+func (*RetryBudget) ProtoMessage() {}
 
-func (x *Middleware_List) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[28]
+func (x *RetryBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,54 +3439,62 @@ func (x *Middleware_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware_List.ProtoReflect.Descriptor instead.
-func (*Middleware_List) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use RetryBudget.ProtoReflect.Descriptor instead.
+func (*RetryBudget) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Middleware_List) GetList() []*Middleware {
+func (x *RetryBudget) GetRetryRatio() float32 {
 	if x != nil {
-		return x.List
+		return x.RetryRatio
+	}
+	return 0
+}
+
+func (x *RetryBudget) GetMinRetriesPerSecond() uint32 {
+	if x != nil {
+		return x.MinRetriesPerSecond
+	}
+	return 0
+}
+
+func (x *RetryBudget) GetTtl() *duration.Duration {
+	if x != nil {
+		return x.Ttl
 	}
 	return nil
 }
 
-type Middleware_Wasm struct {
+type RequestMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of this middleware. This is optiona, but when set it will be
-	// used in logs and tracing.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The name of wasm module to load this is required. If this is let to empty
-	// then this middleware will never be registered.
-	//
-	// This will be eagely lloaded meaning the module doesn't need to be
-	// necessarily precompiled before the route is being registed.
-	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	// Options for creating the wasm module instance.
-	Config  *Middleware_Wasm_Config `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
-	Version Middleware_Version      `protobuf:"varint,4,opt,name=version,proto3,enum=Middleware_Version" json:"version,omitempty"`
+	// Types that are assignable to Match:
+	//	*RequestMatch_Sni
+	//	*RequestMatch_Host
+	//	*RequestMatch_Path
+	//	*RequestMatch_Fixed
+	Match isRequestMatch_Match `protobuf_oneof:"match"`
 }
 
-func (x *Middleware_Wasm) Reset() {
-	*x = Middleware_Wasm{}
+func (x *RequestMatch) Reset() {
+	*x = RequestMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[29]
+		mi := &file_tcp_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Middleware_Wasm) String() string {
+func (x *RequestMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware_Wasm) ProtoMessage() {}
+func (*RequestMatch) ProtoMessage() {}
 
-func (x *Middleware_Wasm) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[29]
+func (x *RequestMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2375,105 +3505,88 @@ func (x *Middleware_Wasm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware_Wasm.ProtoReflect.Descriptor instead.
-func (*Middleware_Wasm) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 1}
+// Deprecated: Use RequestMatch.ProtoReflect.Descriptor instead.
+func (*RequestMatch) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Middleware_Wasm) GetName() string {
-	if x != nil {
-		return x.Name
+func (m *RequestMatch) GetMatch() isRequestMatch_Match {
+	if m != nil {
+		return m.Match
 	}
-	return ""
+	return nil
 }
 
-func (x *Middleware_Wasm) GetModule() string {
-	if x != nil {
-		return x.Module
+func (x *RequestMatch) GetSni() string {
+	if x, ok := x.GetMatch().(*RequestMatch_Sni); ok {
+		return x.Sni
 	}
 	return ""
 }
 
-func (x *Middleware_Wasm) GetConfig() *Middleware_Wasm_Config {
-	if x != nil {
-		return x.Config
+func (x *RequestMatch) GetHost() string {
+	if x, ok := x.GetMatch().(*RequestMatch_Host); ok {
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *Middleware_Wasm) GetVersion() Middleware_Version {
-	if x != nil {
-		return x.Version
+func (x *RequestMatch) GetPath() string {
+	if x, ok := x.GetMatch().(*RequestMatch_Path); ok {
+		return x.Path
 	}
-	return Middleware_V1
-}
-
-type Middleware_StripPathPrefix struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	return ""
 }
 
-func (x *Middleware_StripPathPrefix) Reset() {
-	*x = Middleware_StripPathPrefix{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RequestMatch) GetFixed() *empty.Empty {
+	if x, ok := x.GetMatch().(*RequestMatch_Fixed); ok {
+		return x.Fixed
 	}
+	return nil
 }
 
-func (x *Middleware_StripPathPrefix) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isRequestMatch_Match interface {
+	isRequestMatch_Match()
 }
 
-func (*Middleware_StripPathPrefix) ProtoMessage() {}
+type RequestMatch_Sni struct {
+	Sni string `protobuf:"bytes,1,opt,name=sni,proto3,oneof"`
+}
 
-func (x *Middleware_StripPathPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type RequestMatch_Host struct {
+	Host string `protobuf:"bytes,2,opt,name=host,proto3,oneof"`
 }
 
-// Deprecated: Use Middleware_StripPathPrefix.ProtoReflect.Descriptor instead.
-func (*Middleware_StripPathPrefix) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 2}
+type RequestMatch_Path struct {
+	Path string `protobuf:"bytes,3,opt,name=path,proto3,oneof"`
 }
 
-func (x *Middleware_StripPathPrefix) GetPrefix() string {
-	if x != nil {
-		return x.Prefix
-	}
-	return ""
+type RequestMatch_Fixed struct {
+	Fixed *empty.Empty `protobuf:"bytes,4,opt,name=fixed,proto3,oneof"`
 }
 
-type Middleware_Wasm_Setting struct {
+func (*RequestMatch_Sni) isRequestMatch_Match() {}
+
+func (*RequestMatch_Host) isRequestMatch_Match() {}
+
+func (*RequestMatch_Path) isRequestMatch_Match() {}
+
+func (*RequestMatch_Fixed) isRequestMatch_Match() {}
+
+type Context struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProgramName        string                                  `protobuf:"bytes,1,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
-	Arguments          []string                                `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
-	Environments       []*Middleware_Wasm_Setting_Env          `protobuf:"bytes,3,rep,name=environments,proto3" json:"environments,omitempty"`
-	PreopenDirectories []string                                `protobuf:"bytes,4,rep,name=preopen_directories,json=preopenDirectories,proto3" json:"preopen_directories,omitempty"`
-	MapDirectories     []*Middleware_Wasm_Setting_DirectoryMap `protobuf:"bytes,5,rep,name=map_directories,json=mapDirectories,proto3" json:"map_directories,omitempty"`
-	InheritStdin       bool                                    `protobuf:"varint,6,opt,name=inherit_stdin,json=inheritStdin,proto3" json:"inherit_stdin,omitempty"`
-	CaptureStdout      bool                                    `protobuf:"varint,7,opt,name=capture_stdout,json=captureStdout,proto3" json:"capture_stdout,omitempty"`
-	InheritStdout      bool                                    `protobuf:"varint,8,opt,name=inherit_stdout,json=inheritStdout,proto3" json:"inherit_stdout,omitempty"`
-	CaptureStderr      bool                                    `protobuf:"varint,9,opt,name=capture_stderr,json=captureStderr,proto3" json:"capture_stderr,omitempty"`
-	InheritStderr      bool                                    `protobuf:"varint,10,opt,name=inherit_stderr,json=inheritStderr,proto3" json:"inherit_stderr,omitempty"`
+	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Protocol   Protocol      `protobuf:"varint,2,opt,name=protocol,proto3,enum=Protocol" json:"protocol,omitempty"`
+	Downstream *Context_Conn `protobuf:"bytes,3,opt,name=downstream,proto3" json:"downstream,omitempty"`
+	Upstream   *Context_Conn `protobuf:"bytes,4,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Info       *Context_Info `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
 }
 
-func (x *Middleware_Wasm_Setting) Reset() {
-	*x = Middleware_Wasm_Setting{}
+func (x *Context) Reset() {
+	*x = Context{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2481,13 +3594,13 @@ func (x *Middleware_Wasm_Setting) Reset() {
 	}
 }
 
-func (x *Middleware_Wasm_Setting) String() string {
+func (x *Context) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware_Wasm_Setting) ProtoMessage() {}
+func (*Context) ProtoMessage() {}
 
-func (x *Middleware_Wasm_Setting) ProtoReflect() protoreflect.Message {
+func (x *Context) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2499,95 +3612,62 @@ func (x *Middleware_Wasm_Setting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware_Wasm_Setting.ProtoReflect.Descriptor instead.
-func (*Middleware_Wasm_Setting) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 1, 0}
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Middleware_Wasm_Setting) GetProgramName() string {
+func (x *Context) GetId() int64 {
 	if x != nil {
-		return x.ProgramName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Middleware_Wasm_Setting) GetArguments() []string {
+func (x *Context) GetProtocol() Protocol {
 	if x != nil {
-		return x.Arguments
+		return x.Protocol
 	}
-	return nil
+	return Protocol_TCP
 }
 
-func (x *Middleware_Wasm_Setting) GetEnvironments() []*Middleware_Wasm_Setting_Env {
+func (x *Context) GetDownstream() *Context_Conn {
 	if x != nil {
-		return x.Environments
+		return x.Downstream
 	}
 	return nil
 }
 
-func (x *Middleware_Wasm_Setting) GetPreopenDirectories() []string {
+func (x *Context) GetUpstream() *Context_Conn {
 	if x != nil {
-		return x.PreopenDirectories
+		return x.Upstream
 	}
 	return nil
 }
 
-func (x *Middleware_Wasm_Setting) GetMapDirectories() []*Middleware_Wasm_Setting_DirectoryMap {
+func (x *Context) GetInfo() *Context_Info {
 	if x != nil {
-		return x.MapDirectories
+		return x.Info
 	}
 	return nil
 }
 
-func (x *Middleware_Wasm_Setting) GetInheritStdin() bool {
-	if x != nil {
-		return x.InheritStdin
-	}
-	return false
-}
-
-func (x *Middleware_Wasm_Setting) GetCaptureStdout() bool {
-	if x != nil {
-		return x.CaptureStdout
-	}
-	return false
-}
-
-func (x *Middleware_Wasm_Setting) GetInheritStdout() bool {
-	if x != nil {
-		return x.InheritStdout
-	}
-	return false
-}
-
-func (x *Middleware_Wasm_Setting) GetCaptureStderr() bool {
-	if x != nil {
-		return x.CaptureStderr
-	}
-	return false
-}
-
-func (x *Middleware_Wasm_Setting) GetInheritStderr() bool {
-	if x != nil {
-		return x.InheritStderr
-	}
-	return false
-}
-
-type Middleware_Wasm_Config struct {
+type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The options used to create wasm instance of the module.
-	Instance *Middleware_Wasm_Setting `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Plugin specific settings that the wasm module will load and use to
-	// configer itself.
-	Plugin *_struct.Struct `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Types that are assignable to Match:
+	//	*Rule_All
+	//	*Rule_Any
+	//	*Rule_Not
+	//	*Rule_Tcp
+	//	*Rule_Http
+	Match isRule_Match `protobuf_oneof:"match"`
 }
 
-func (x *Middleware_Wasm_Config) Reset() {
-	*x = Middleware_Wasm_Config{}
+func (x *Rule) Reset() {
+	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,13 +3675,13 @@ func (x *Middleware_Wasm_Config) Reset() {
 	}
 }
 
-func (x *Middleware_Wasm_Config) String() string {
+func (x *Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware_Wasm_Config) ProtoMessage() {}
+func (*Rule) ProtoMessage() {}
 
-func (x *Middleware_Wasm_Config) ProtoReflect() protoreflect.Message {
+func (x *Rule) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2613,36 +3693,107 @@ func (x *Middleware_Wasm_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware_Wasm_Config.ProtoReflect.Descriptor instead.
-func (*Middleware_Wasm_Config) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 1, 1}
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Middleware_Wasm_Config) GetInstance() *Middleware_Wasm_Setting {
-	if x != nil {
-		return x.Instance
+func (m *Rule) GetMatch() isRule_Match {
+	if m != nil {
+		return m.Match
 	}
 	return nil
 }
 
-func (x *Middleware_Wasm_Config) GetPlugin() *_struct.Struct {
-	if x != nil {
-		return x.Plugin
+func (x *Rule) GetAll() *Rule_List {
+	if x, ok := x.GetMatch().(*Rule_All); ok {
+		return x.All
 	}
 	return nil
 }
 
-type Middleware_Wasm_Setting_Env struct {
+func (x *Rule) GetAny() *Rule_List {
+	if x, ok := x.GetMatch().(*Rule_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *Rule) GetNot() *Rule {
+	if x, ok := x.GetMatch().(*Rule_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *Rule) GetTcp() *Rule_TCP {
+	if x, ok := x.GetMatch().(*Rule_Tcp); ok {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *Rule) GetHttp() *Rule_HTTP {
+	if x, ok := x.GetMatch().(*Rule_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+type isRule_Match interface {
+	isRule_Match()
+}
+
+type Rule_All struct {
+	All *Rule_List `protobuf:"bytes,1,opt,name=all,proto3,oneof"`
+}
+
+type Rule_Any struct {
+	Any *Rule_List `protobuf:"bytes,2,opt,name=any,proto3,oneof"`
+}
+
+type Rule_Not struct {
+	Not *Rule `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
+}
+
+type Rule_Tcp struct {
+	Tcp *Rule_TCP `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
+}
+
+type Rule_Http struct {
+	Http *Rule_HTTP `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
+}
+
+func (*Rule_All) isRule_Match() {}
+
+func (*Rule_Any) isRule_Match() {}
+
+func (*Rule_Not) isRule_Match() {}
+
+func (*Rule_Tcp) isRule_Match() {}
+
+func (*Rule_Http) isRule_Match() {}
+
+// AccessEntry records metrics about a http request.
+type AccessEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Request      *AccessEntry_Request      `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response     *AccessEntry_Response     `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ReverseProxy *AccessEntry_ReverseProxy `protobuf:"bytes,3,opt,name=reverse_proxy,json=reverseProxy,proto3" json:"reverse_proxy,omitempty"`
+	Info         *AccessEntry_Info         `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Duration     *duration.Duration        `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Connection   *AccessEntry_Connection   `protobuf:"bytes,6,opt,name=connection,proto3" json:"connection,omitempty"`
+	Session      *AccessEntry_Session      `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"`
+	Websocket    *AccessEntry_WebSocket    `protobuf:"bytes,8,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	Grpc         *AccessEntry_GRPC         `protobuf:"bytes,9,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Cache        AccessEntry_CacheStatus   `protobuf:"varint,10,opt,name=cache,proto3,enum=AccessEntry_CacheStatus" json:"cache,omitempty"`
 }
 
-func (x *Middleware_Wasm_Setting_Env) Reset() {
-	*x = Middleware_Wasm_Setting_Env{}
+func (x *AccessEntry) Reset() {
+	*x = AccessEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2650,13 +3801,13 @@ func (x *Middleware_Wasm_Setting_Env) Reset() {
 	}
 }
 
-func (x *Middleware_Wasm_Setting_Env) String() string {
+func (x *AccessEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Middleware_Wasm_Setting_Env) ProtoMessage() {}
+func (*AccessEntry) ProtoMessage() {}
 
-func (x *Middleware_Wasm_Setting_Env) ProtoReflect() protoreflect.Message {
+func (x *AccessEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2668,106 +3819,107 @@ func (x *Middleware_Wasm_Setting_Env) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Middleware_Wasm_Setting_Env.ProtoReflect.Descriptor instead.
-func (*Middleware_Wasm_Setting_Env) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 1, 0, 0}
+// Deprecated: Use AccessEntry.ProtoReflect.Descriptor instead.
+func (*AccessEntry) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Middleware_Wasm_Setting_Env) GetKey() string {
+func (x *AccessEntry) GetRequest() *AccessEntry_Request {
 	if x != nil {
-		return x.Key
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *Middleware_Wasm_Setting_Env) GetValue() string {
+func (x *AccessEntry) GetResponse() *AccessEntry_Response {
 	if x != nil {
-		return x.Value
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type Middleware_Wasm_Setting_DirectoryMap struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Alias     string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
-	Directory string `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+func (x *AccessEntry) GetReverseProxy() *AccessEntry_ReverseProxy {
+	if x != nil {
+		return x.ReverseProxy
+	}
+	return nil
 }
 
-func (x *Middleware_Wasm_Setting_DirectoryMap) Reset() {
-	*x = Middleware_Wasm_Setting_DirectoryMap{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessEntry) GetInfo() *AccessEntry_Info {
+	if x != nil {
+		return x.Info
 	}
+	return nil
 }
 
-func (x *Middleware_Wasm_Setting_DirectoryMap) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessEntry) GetDuration() *duration.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
 }
 
-func (*Middleware_Wasm_Setting_DirectoryMap) ProtoMessage() {}
+func (x *AccessEntry) GetConnection() *AccessEntry_Connection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
 
-func (x *Middleware_Wasm_Setting_DirectoryMap) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessEntry) GetSession() *AccessEntry_Session {
+	if x != nil {
+		return x.Session
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Middleware_Wasm_Setting_DirectoryMap.ProtoReflect.Descriptor instead.
-func (*Middleware_Wasm_Setting_DirectoryMap) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{10, 1, 0, 1}
+func (x *AccessEntry) GetWebsocket() *AccessEntry_WebSocket {
+	if x != nil {
+		return x.Websocket
+	}
+	return nil
 }
 
-func (x *Middleware_Wasm_Setting_DirectoryMap) GetAlias() string {
+func (x *AccessEntry) GetGrpc() *AccessEntry_GRPC {
 	if x != nil {
-		return x.Alias
+		return x.Grpc
 	}
-	return ""
+	return nil
 }
 
-func (x *Middleware_Wasm_Setting_DirectoryMap) GetDirectory() string {
+func (x *AccessEntry) GetCache() AccessEntry_CacheStatus {
 	if x != nil {
-		return x.Directory
+		return x.Cache
 	}
-	return ""
+	return AccessEntry_NONE
 }
 
-type Context_Stat struct {
+type Raft_KeyValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BytesRead    int64 `protobuf:"varint,1,opt,name=bytesRead,proto3" json:"bytesRead,omitempty"`
-	BytesWritten int64 `protobuf:"varint,2,opt,name=bytesWritten,proto3" json:"bytesWritten,omitempty"`
+	Action  Raft_KeyValue_Action   `protobuf:"varint,1,opt,name=action,proto3,enum=Raft_KeyValue_Action" json:"action,omitempty"`
+	Context *Raft_KeyValue_Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 }
 
-func (x *Context_Stat) Reset() {
-	*x = Context_Stat{}
+func (x *Raft_KeyValue) Reset() {
+	*x = Raft_KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[36]
+		mi := &file_tcp_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Context_Stat) String() string {
+func (x *Raft_KeyValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context_Stat) ProtoMessage() {}
+func (*Raft_KeyValue) ProtoMessage() {}
 
-func (x *Context_Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[36]
+func (x *Raft_KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2778,52 +3930,52 @@ func (x *Context_Stat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context_Stat.ProtoReflect.Descriptor instead.
-func (*Context_Stat) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use Raft_KeyValue.ProtoReflect.Descriptor instead.
+func (*Raft_KeyValue) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *Context_Stat) GetBytesRead() int64 {
+func (x *Raft_KeyValue) GetAction() Raft_KeyValue_Action {
 	if x != nil {
-		return x.BytesRead
+		return x.Action
 	}
-	return 0
+	return Raft_KeyValue_GET
 }
 
-func (x *Context_Stat) GetBytesWritten() int64 {
+func (x *Raft_KeyValue) GetContext() *Raft_KeyValue_Context {
 	if x != nil {
-		return x.BytesWritten
+		return x.Context
 	}
-	return 0
+	return nil
 }
 
-type Context_Conn struct {
+type Raft_Log struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocalAddress  string        `protobuf:"bytes,1,opt,name=localAddress,proto3" json:"localAddress,omitempty"`
-	RemoteAddress string        `protobuf:"bytes,2,opt,name=remoteAddress,proto3" json:"remoteAddress,omitempty"`
-	Stat          *Context_Stat `protobuf:"bytes,3,opt,name=stat,proto3" json:"stat,omitempty"`
+	// Types that are assignable to Entry:
+	//	*Raft_Log_KeyValue
+	Entry isRaft_Log_Entry `protobuf_oneof:"entry"`
 }
 
-func (x *Context_Conn) Reset() {
-	*x = Context_Conn{}
+func (x *Raft_Log) Reset() {
+	*x = Raft_Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[37]
+		mi := &file_tcp_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Context_Conn) String() string {
+func (x *Raft_Log) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context_Conn) ProtoMessage() {}
+func (*Raft_Log) ProtoMessage() {}
 
-func (x *Context_Conn) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[37]
+func (x *Raft_Log) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2834,61 +3986,62 @@ func (x *Context_Conn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context_Conn.ProtoReflect.Descriptor instead.
-func (*Context_Conn) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{17, 1}
+// Deprecated: Use Raft_Log.ProtoReflect.Descriptor instead.
+func (*Raft_Log) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *Context_Conn) GetLocalAddress() string {
-	if x != nil {
-		return x.LocalAddress
+func (m *Raft_Log) GetEntry() isRaft_Log_Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return ""
+	return nil
 }
 
-func (x *Context_Conn) GetRemoteAddress() string {
-	if x != nil {
-		return x.RemoteAddress
+func (x *Raft_Log) GetKeyValue() *Raft_KeyValue {
+	if x, ok := x.GetEntry().(*Raft_Log_KeyValue); ok {
+		return x.KeyValue
 	}
-	return ""
+	return nil
 }
 
-func (x *Context_Conn) GetStat() *Context_Stat {
-	if x != nil {
-		return x.Stat
-	}
-	return nil
+type isRaft_Log_Entry interface {
+	isRaft_Log_Entry()
 }
 
-type Context_Info struct {
+type Raft_Log_KeyValue struct {
+	KeyValue *Raft_KeyValue `protobuf:"bytes,1,opt,name=key_value,json=keyValue,proto3,oneof"`
+}
+
+func (*Raft_Log_KeyValue) isRaft_Log_Entry() {}
+
+type Raft_KeyValue_Context struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sni        *wrappers.StringValue `protobuf:"bytes,1,opt,name=sni,proto3" json:"sni,omitempty"`
-	HostNames  []string              `protobuf:"bytes,2,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
-	Path       *wrappers.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	RouteName  string                `protobuf:"bytes,4,opt,name=routeName,proto3" json:"routeName,omitempty"`
-	ListenPort int32                 `protobuf:"varint,5,opt,name=listenPort,proto3" json:"listenPort,omitempty"`
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *Context_Info) Reset() {
-	*x = Context_Info{}
+func (x *Raft_KeyValue_Context) Reset() {
+	*x = Raft_KeyValue_Context{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[38]
+		mi := &file_tcp_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Context_Info) String() string {
+func (x *Raft_KeyValue_Context) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context_Info) ProtoMessage() {}
+func (*Raft_KeyValue_Context) ProtoMessage() {}
 
-func (x *Context_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[38]
+func (x *Raft_KeyValue_Context) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2899,56 +4052,143 @@ func (x *Context_Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context_Info.ProtoReflect.Descriptor instead.
-func (*Context_Info) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{17, 2}
+// Deprecated: Use Raft_KeyValue_Context.ProtoReflect.Descriptor instead.
+func (*Raft_KeyValue_Context) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{5, 0, 0}
 }
 
-func (x *Context_Info) GetSni() *wrappers.StringValue {
+func (x *Raft_KeyValue_Context) GetKey() []byte {
 	if x != nil {
-		return x.Sni
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Context_Info) GetHostNames() []string {
+func (x *Raft_KeyValue_Context) GetValue() []byte {
 	if x != nil {
-		return x.HostNames
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Context_Info) GetPath() *wrappers.StringValue {
+func (x *Raft_KeyValue_Context) GetExpiresAt() uint64 {
 	if x != nil {
-		return x.Path
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type Store_SetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Store_SetRequest) Reset() {
+	*x = Store_SetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Store_SetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store_SetRequest) ProtoMessage() {}
+
+func (x *Store_SetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store_SetRequest.ProtoReflect.Descriptor instead.
+func (*Store_SetRequest) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Store_SetRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Context_Info) GetRouteName() string {
+func (x *Store_SetRequest) GetValue() []byte {
 	if x != nil {
-		return x.RouteName
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *Context_Info) GetListenPort() int32 {
+func (x *Store_SetRequest) GetExpiresAt() uint64 {
 	if x != nil {
-		return x.ListenPort
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type Rule_List struct {
+type Store_SetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Rules []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+func (x *Store_SetResponse) Reset() {
+	*x = Store_SetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Rule_List) Reset() {
-	*x = Rule_List{}
+func (x *Store_SetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store_SetResponse) ProtoMessage() {}
+
+func (x *Store_SetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store_SetResponse.ProtoReflect.Descriptor instead.
+func (*Store_SetResponse) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{6, 1}
+}
+
+type Store_GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Store_GetRequest) Reset() {
+	*x = Store_GetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2956,13 +4196,13 @@ func (x *Rule_List) Reset() {
 	}
 }
 
-func (x *Rule_List) String() string {
+func (x *Store_GetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_List) ProtoMessage() {}
+func (*Store_GetRequest) ProtoMessage() {}
 
-func (x *Rule_List) ProtoReflect() protoreflect.Message {
+func (x *Store_GetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2974,32 +4214,29 @@ func (x *Rule_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_List.ProtoReflect.Descriptor instead.
-func (*Rule_List) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use Store_GetRequest.ProtoReflect.Descriptor instead.
+func (*Store_GetRequest) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{6, 2}
 }
 
-func (x *Rule_List) GetRules() []*Rule {
+func (x *Store_GetRequest) GetKey() []byte {
 	if x != nil {
-		return x.Rules
+		return x.Key
 	}
 	return nil
 }
 
-type Rule_TCP struct {
+type Store_GetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Match:
-	//	*Rule_TCP_Port
-	//	*Rule_TCP_Ports
-	//	*Rule_TCP_Sni
-	Match isRule_TCP_Match `protobuf_oneof:"match"`
+	Value     []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt uint64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *Rule_TCP) Reset() {
-	*x = Rule_TCP{}
+func (x *Store_GetResponse) Reset() {
+	*x = Store_GetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3007,13 +4244,13 @@ func (x *Rule_TCP) Reset() {
 	}
 }
 
-func (x *Rule_TCP) String() string {
+func (x *Store_GetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_TCP) ProtoMessage() {}
+func (*Store_GetResponse) ProtoMessage() {}
 
-func (x *Rule_TCP) ProtoReflect() protoreflect.Message {
+func (x *Store_GetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3025,91 +4262,50 @@ func (x *Rule_TCP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_TCP.ProtoReflect.Descriptor instead.
-func (*Rule_TCP) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 1}
+// Deprecated: Use Store_GetResponse.ProtoReflect.Descriptor instead.
+func (*Store_GetResponse) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{6, 3}
 }
 
-func (m *Rule_TCP) GetMatch() isRule_TCP_Match {
-	if m != nil {
-		return m.Match
+func (x *Store_GetResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Rule_TCP) GetPort() uint32 {
-	if x, ok := x.GetMatch().(*Rule_TCP_Port); ok {
-		return x.Port
+func (x *Store_GetResponse) GetExpiresAt() uint64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-func (x *Rule_TCP) GetPorts() *Rule_TCP_PortRange {
-	if x, ok := x.GetMatch().(*Rule_TCP_Ports); ok {
-		return x.Ports
-	}
-	return nil
-}
-
-func (x *Rule_TCP) GetSni() string {
-	if x, ok := x.GetMatch().(*Rule_TCP_Sni); ok {
-		return x.Sni
-	}
-	return ""
-}
-
-type isRule_TCP_Match interface {
-	isRule_TCP_Match()
-}
-
-type Rule_TCP_Port struct {
-	Port uint32 `protobuf:"varint,1,opt,name=port,proto3,oneof"`
-}
-
-type Rule_TCP_Ports struct {
-	Ports *Rule_TCP_PortRange `protobuf:"bytes,2,opt,name=ports,proto3,oneof"`
-}
-
-type Rule_TCP_Sni struct {
-	Sni string `protobuf:"bytes,3,opt,name=sni,proto3,oneof"`
-}
-
-func (*Rule_TCP_Port) isRule_TCP_Match() {}
-
-func (*Rule_TCP_Ports) isRule_TCP_Match() {}
-
-func (*Rule_TCP_Sni) isRule_TCP_Match() {}
-
-type Rule_HTTP struct {
+type Middleware_List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Match:
-	//	*Rule_HTTP_Methods
-	//	*Rule_HTTP_Path_
-	//	*Rule_HTTP_Headers
-	//	*Rule_HTTP_QueryParam
-	Match isRule_HTTP_Match `protobuf_oneof:"match"`
+	List []*Middleware `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *Rule_HTTP) Reset() {
-	*x = Rule_HTTP{}
+func (x *Middleware_List) Reset() {
+	*x = Middleware_List{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[41]
+		mi := &file_tcp_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rule_HTTP) String() string {
+func (x *Middleware_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_HTTP) ProtoMessage() {}
+func (*Middleware_List) ProtoMessage() {}
 
-func (x *Rule_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[41]
+func (x *Middleware_List) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,100 +4316,54 @@ func (x *Rule_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_HTTP.ProtoReflect.Descriptor instead.
-func (*Rule_HTTP) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2}
-}
-
-func (m *Rule_HTTP) GetMatch() isRule_HTTP_Match {
-	if m != nil {
-		return m.Match
-	}
-	return nil
-}
-
-func (x *Rule_HTTP) GetMethods() *Rule_HTTP_MethodList {
-	if x, ok := x.GetMatch().(*Rule_HTTP_Methods); ok {
-		return x.Methods
-	}
-	return nil
-}
-
-func (x *Rule_HTTP) GetPath() *Rule_HTTP_Path {
-	if x, ok := x.GetMatch().(*Rule_HTTP_Path_); ok {
-		return x.Path
-	}
-	return nil
-}
-
-func (x *Rule_HTTP) GetHeaders() *Rule_HTTP_KeyValueList {
-	if x, ok := x.GetMatch().(*Rule_HTTP_Headers); ok {
-		return x.Headers
-	}
-	return nil
+// Deprecated: Use Middleware_List.ProtoReflect.Descriptor instead.
+func (*Middleware_List) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 0}
 }
 
-func (x *Rule_HTTP) GetQueryParam() *Rule_HTTP_KeyValueList {
-	if x, ok := x.GetMatch().(*Rule_HTTP_QueryParam); ok {
-		return x.QueryParam
+func (x *Middleware_List) GetList() []*Middleware {
+	if x != nil {
+		return x.List
 	}
 	return nil
 }
 
-type isRule_HTTP_Match interface {
-	isRule_HTTP_Match()
-}
-
-type Rule_HTTP_Methods struct {
-	Methods *Rule_HTTP_MethodList `protobuf:"bytes,1,opt,name=methods,proto3,oneof"`
-}
-
-type Rule_HTTP_Path_ struct {
-	Path *Rule_HTTP_Path `protobuf:"bytes,2,opt,name=path,proto3,oneof"`
-}
-
-type Rule_HTTP_Headers struct {
-	Headers *Rule_HTTP_KeyValueList `protobuf:"bytes,3,opt,name=headers,proto3,oneof"`
-}
-
-type Rule_HTTP_QueryParam struct {
-	QueryParam *Rule_HTTP_KeyValueList `protobuf:"bytes,4,opt,name=query_param,json=queryParam,proto3,oneof"`
-}
-
-func (*Rule_HTTP_Methods) isRule_HTTP_Match() {}
-
-func (*Rule_HTTP_Path_) isRule_HTTP_Match() {}
-
-func (*Rule_HTTP_Headers) isRule_HTTP_Match() {}
-
-func (*Rule_HTTP_QueryParam) isRule_HTTP_Match() {}
-
-type Rule_TCP_PortRange struct {
+type Middleware_Wasm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	// The name of this middleware. This is optiona, but when set it will be
+	// used in logs and tracing.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of wasm module to load this is required. If this is let to empty
+	// then this middleware will never be registered.
+	//
+	// This will be eagely lloaded meaning the module doesn't need to be
+	// necessarily precompiled before the route is being registed.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// Options for creating the wasm module instance.
+	Config  *Middleware_Wasm_Config `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Version Middleware_Version      `protobuf:"varint,4,opt,name=version,proto3,enum=Middleware_Version" json:"version,omitempty"`
 }
 
-func (x *Rule_TCP_PortRange) Reset() {
-	*x = Rule_TCP_PortRange{}
+func (x *Middleware_Wasm) Reset() {
+	*x = Middleware_Wasm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[42]
+		mi := &file_tcp_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rule_TCP_PortRange) String() string {
+func (x *Middleware_Wasm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_TCP_PortRange) ProtoMessage() {}
+func (*Middleware_Wasm) ProtoMessage() {}
 
-func (x *Rule_TCP_PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[42]
+func (x *Middleware_Wasm) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3224,50 +4374,64 @@ func (x *Rule_TCP_PortRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_TCP_PortRange.ProtoReflect.Descriptor instead.
-func (*Rule_TCP_PortRange) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 1, 0}
+// Deprecated: Use Middleware_Wasm.ProtoReflect.Descriptor instead.
+func (*Middleware_Wasm) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 1}
 }
 
-func (x *Rule_TCP_PortRange) GetMin() uint32 {
+func (x *Middleware_Wasm) GetName() string {
 	if x != nil {
-		return x.Min
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Rule_TCP_PortRange) GetMax() uint32 {
+func (x *Middleware_Wasm) GetModule() string {
 	if x != nil {
-		return x.Max
+		return x.Module
 	}
-	return 0
+	return ""
 }
 
-type Rule_HTTP_MethodList struct {
+func (x *Middleware_Wasm) GetConfig() *Middleware_Wasm_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Middleware_Wasm) GetVersion() Middleware_Version {
+	if x != nil {
+		return x.Version
+	}
+	return Middleware_V1
+}
+
+type Middleware_StripPathPrefix struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []Rule_HTTP_Method `protobuf:"varint,1,rep,packed,name=list,proto3,enum=Rule_HTTP_Method" json:"list,omitempty"`
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 }
 
-func (x *Rule_HTTP_MethodList) Reset() {
-	*x = Rule_HTTP_MethodList{}
+func (x *Middleware_StripPathPrefix) Reset() {
+	*x = Middleware_StripPathPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[43]
+		mi := &file_tcp_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rule_HTTP_MethodList) String() string {
+func (x *Middleware_StripPathPrefix) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_HTTP_MethodList) ProtoMessage() {}
+func (*Middleware_StripPathPrefix) ProtoMessage() {}
 
-func (x *Rule_HTTP_MethodList) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[43]
+func (x *Middleware_StripPathPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,45 +4442,61 @@ func (x *Rule_HTTP_MethodList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_HTTP_MethodList.ProtoReflect.Descriptor instead.
-func (*Rule_HTTP_MethodList) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 0}
+// Deprecated: Use Middleware_StripPathPrefix.ProtoReflect.Descriptor instead.
+func (*Middleware_StripPathPrefix) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 2}
 }
 
-func (x *Rule_HTTP_MethodList) GetList() []Rule_HTTP_Method {
+func (x *Middleware_StripPathPrefix) GetPrefix() string {
 	if x != nil {
-		return x.List
+		return x.Prefix
 	}
-	return nil
+	return ""
 }
 
-type Rule_HTTP_KeyValue struct {
+// Cache caches responses of GET and HEAD requests following RFC 9111 as a
+// shared cache, private responses and responses setting cookies are not
+// cached. Cached responses are purged with the Purge admin call. It requires
+// the cache to be enabled.
+type Middleware_Cache struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  Rule_HTTP_KeyValue_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Rule_HTTP_KeyValue_Type" json:"type,omitempty"`
-	Name  string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value string                  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-}
-
-func (x *Rule_HTTP_KeyValue) Reset() {
-	*x = Rule_HTTP_KeyValue{}
+	Key *Middleware_Cache_Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Freshness of responses without explicit expiration time and without
+	// Last-Modified. Such responses are not cached when zero.
+	DefaultTtl *duration.Duration `protobuf:"bytes,2,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"`
+	// Upper bound of the freshness of responses. No bound when zero.
+	MaxTtl *duration.Duration `protobuf:"bytes,3,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`
+	// Larger responses are not cached. Defaults to 1MiB.
+	MaxBodySize uint32 `protobuf:"varint,4,opt,name=max_body_size,json=maxBodySize,proto3" json:"max_body_size,omitempty"`
+	// Stale responses are served while they are revalidated in the background
+	// for this long after they expire, unless responses set
+	// stale-while-revalidate themselves.
+	StaleWhileRevalidate *duration.Duration `protobuf:"bytes,5,opt,name=stale_while_revalidate,json=staleWhileRevalidate,proto3" json:"stale_while_revalidate,omitempty"`
+	// Stale responses are served when upstreams fail for this long after they
+	// expire, unless responses set stale-if-error themselves.
+	StaleIfError *duration.Duration `protobuf:"bytes,6,opt,name=stale_if_error,json=staleIfError,proto3" json:"stale_if_error,omitempty"`
+}
+
+func (x *Middleware_Cache) Reset() {
+	*x = Middleware_Cache{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[44]
+		mi := &file_tcp_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rule_HTTP_KeyValue) String() string {
+func (x *Middleware_Cache) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_HTTP_KeyValue) ProtoMessage() {}
+func (*Middleware_Cache) ProtoMessage() {}
 
-func (x *Rule_HTTP_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[44]
+func (x *Middleware_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3327,90 +4507,83 @@ func (x *Rule_HTTP_KeyValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_HTTP_KeyValue.ProtoReflect.Descriptor instead.
-func (*Rule_HTTP_KeyValue) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 1}
+// Deprecated: Use Middleware_Cache.ProtoReflect.Descriptor instead.
+func (*Middleware_Cache) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 3}
 }
 
-func (x *Rule_HTTP_KeyValue) GetType() Rule_HTTP_KeyValue_Type {
+func (x *Middleware_Cache) GetKey() *Middleware_Cache_Key {
 	if x != nil {
-		return x.Type
+		return x.Key
 	}
-	return Rule_HTTP_KeyValue_Exact
+	return nil
 }
 
-func (x *Rule_HTTP_KeyValue) GetName() string {
+func (x *Middleware_Cache) GetDefaultTtl() *duration.Duration {
 	if x != nil {
-		return x.Name
+		return x.DefaultTtl
 	}
-	return ""
+	return nil
 }
 
-func (x *Rule_HTTP_KeyValue) GetValue() string {
+func (x *Middleware_Cache) GetMaxTtl() *duration.Duration {
 	if x != nil {
-		return x.Value
+		return x.MaxTtl
 	}
-	return ""
+	return nil
 }
 
-type Rule_HTTP_KeyValueList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Middleware_Cache) GetMaxBodySize() uint32 {
+	if x != nil {
+		return x.MaxBodySize
+	}
+	return 0
+}
 
-	List []*Rule_HTTP_KeyValue `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-}
-
-func (x *Rule_HTTP_KeyValueList) Reset() {
-	*x = Rule_HTTP_KeyValueList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Rule_HTTP_KeyValueList) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Rule_HTTP_KeyValueList) ProtoMessage() {}
-
-func (x *Rule_HTTP_KeyValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_tcp_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Middleware_Cache) GetStaleWhileRevalidate() *duration.Duration {
+	if x != nil {
+		return x.StaleWhileRevalidate
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Rule_HTTP_KeyValueList.ProtoReflect.Descriptor instead.
-func (*Rule_HTTP_KeyValueList) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 2}
+	return nil
 }
 
-func (x *Rule_HTTP_KeyValueList) GetList() []*Rule_HTTP_KeyValue {
+func (x *Middleware_Cache) GetStaleIfError() *duration.Duration {
 	if x != nil {
-		return x.List
+		return x.StaleIfError
 	}
 	return nil
 }
 
-type Rule_HTTP_Path struct {
+// Compress compresses responses for clients accepting one of the encodings.
+// Responses that are already encoded, ranges, responses with Cache-Control
+// no-transform and upgraded connections are left untouched.
+type Middleware_Compress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  Rule_HTTP_Path_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Rule_HTTP_Path_Type" json:"type,omitempty"`
-	Value string              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-}
-
-func (x *Rule_HTTP_Path) Reset() {
-	*x = Rule_HTTP_Path{}
+	// Encodings in order of preference, used when clients accept several
+	// encodings equally. Defaults to brotli, zstd and gzip.
+	Encodings []Middleware_Compress_Encoding `protobuf:"varint,1,rep,packed,name=encodings,proto3,enum=Middleware_Compress_Encoding" json:"encodings,omitempty"`
+	// Responses smaller than min_size bytes are sent uncompressed. Responses
+	// without Content-Length are buffered until min_size bytes were written or
+	// they are flushed. Defaults to 1024.
+	MinSize uint32 `protobuf:"varint,2,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	// Media types of compressed responses, * matches any characters of a
+	// type, like text/* or application/*+json. Defaults to text/*,
+	// application/json, application/*+json, application/javascript,
+	// application/xml, application/*+xml and image/svg+xml.
+	ContentTypes []string `protobuf:"bytes,3,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// Decompress request bodies sent with one of the encodings before they are
+	// passed on.
+	DecompressRequests bool `protobuf:"varint,4,opt,name=decompress_requests,json=decompressRequests,proto3" json:"decompress_requests,omitempty"`
+	// Requests whose decompressed body is larger than max_decoded_size bytes
+	// fail with 413 Request Entity Too Large. Defaults to 10MiB.
+	MaxDecodedSize uint32 `protobuf:"varint,5,opt,name=max_decoded_size,json=maxDecodedSize,proto3" json:"max_decoded_size,omitempty"`
+}
+
+func (x *Middleware_Compress) Reset() {
+	*x = Middleware_Compress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3418,13 +4591,13 @@ func (x *Rule_HTTP_Path) Reset() {
 	}
 }
 
-func (x *Rule_HTTP_Path) String() string {
+func (x *Middleware_Compress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule_HTTP_Path) ProtoMessage() {}
+func (*Middleware_Compress) ProtoMessage() {}
 
-func (x *Rule_HTTP_Path) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Compress) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3436,44 +4609,74 @@ func (x *Rule_HTTP_Path) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule_HTTP_Path.ProtoReflect.Descriptor instead.
-func (*Rule_HTTP_Path) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{18, 2, 3}
+// Deprecated: Use Middleware_Compress.ProtoReflect.Descriptor instead.
+func (*Middleware_Compress) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 4}
 }
 
-func (x *Rule_HTTP_Path) GetType() Rule_HTTP_Path_Type {
+func (x *Middleware_Compress) GetEncodings() []Middleware_Compress_Encoding {
 	if x != nil {
-		return x.Type
+		return x.Encodings
 	}
-	return Rule_HTTP_Path_Exact
+	return nil
 }
 
-func (x *Rule_HTTP_Path) GetValue() string {
+func (x *Middleware_Compress) GetMinSize() uint32 {
 	if x != nil {
-		return x.Value
+		return x.MinSize
 	}
-	return ""
+	return 0
 }
 
-// UserAgent details about the user agent sending the http request.
-type AccessEntry_UserAgent struct {
+func (x *Middleware_Compress) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *Middleware_Compress) GetDecompressRequests() bool {
+	if x != nil {
+		return x.DecompressRequests
+	}
+	return false
+}
+
+func (x *Middleware_Compress) GetMaxDecodedSize() uint32 {
+	if x != nil {
+		return x.MaxDecodedSize
+	}
+	return 0
+}
+
+// Headers changes headers of requests before they are passed on and of
+// responses before they are sent to clients. Response headers of websocket
+// upgrades are not changed.
+//
+// Values are templates, variables in braces like {client_ip} are replaced by
+// attributes of the request: client_ip, route, service, host, method,
+// scheme, path, query, uri, request_id, time and time_unix. request_id is the
+// X-Request-Id header of the request, one is generated for requests without
+// it. Variables of path rules are var.name, like {var.path} for regular
+// expression paths. {{ is a literal brace.
+type Middleware_Headers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Os        string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
-	OsVersion string `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
-	Device    string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
-	Mobile    bool   `protobuf:"varint,6,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Tablet    bool   `protobuf:"varint,7,opt,name=tablet,proto3" json:"tablet,omitempty"`
-	Desktop   bool   `protobuf:"varint,8,opt,name=desktop,proto3" json:"desktop,omitempty"`
-	Bot       bool   `protobuf:"varint,9,opt,name=bot,proto3" json:"bot,omitempty"`
+	Request   *Middleware_Headers_Operations `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response  *Middleware_Headers_Operations `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Forwarded Middleware_Headers_Forwarded   `protobuf:"varint,3,opt,name=forwarded,proto3,enum=Middleware_Headers_Forwarded" json:"forwarded,omitempty"`
+	// Headers removed from requests and responses as hop-by-hop headers.
+	HopHeaders []string `protobuf:"bytes,4,rep,name=hop_headers,json=hopHeaders,proto3" json:"hop_headers,omitempty"`
+	// Ignore headers listed in the Connection header of requests other than
+	// close, keep-alive and upgrade, clients can't have end-to-end headers like
+	// X-Forwarded-For removed as hop-by-hop headers.
+	IgnoreConnectionTokens bool `protobuf:"varint,5,opt,name=ignore_connection_tokens,json=ignoreConnectionTokens,proto3" json:"ignore_connection_tokens,omitempty"`
 }
 
-func (x *AccessEntry_UserAgent) Reset() {
-	*x = AccessEntry_UserAgent{}
+func (x *Middleware_Headers) Reset() {
+	*x = Middleware_Headers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3481,13 +4684,13 @@ func (x *AccessEntry_UserAgent) Reset() {
 	}
 }
 
-func (x *AccessEntry_UserAgent) String() string {
+func (x *Middleware_Headers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry_UserAgent) ProtoMessage() {}
+func (*Middleware_Headers) ProtoMessage() {}
 
-func (x *AccessEntry_UserAgent) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Headers) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3499,87 +4702,68 @@ func (x *AccessEntry_UserAgent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessEntry_UserAgent.ProtoReflect.Descriptor instead.
-func (*AccessEntry_UserAgent) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19, 0}
-}
-
-func (x *AccessEntry_UserAgent) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AccessEntry_UserAgent) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *AccessEntry_UserAgent) GetOs() string {
-	if x != nil {
-		return x.Os
-	}
-	return ""
-}
-
-func (x *AccessEntry_UserAgent) GetOsVersion() string {
-	if x != nil {
-		return x.OsVersion
-	}
-	return ""
+// Deprecated: Use Middleware_Headers.ProtoReflect.Descriptor instead.
+func (*Middleware_Headers) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 5}
 }
 
-func (x *AccessEntry_UserAgent) GetDevice() string {
+func (x *Middleware_Headers) GetRequest() *Middleware_Headers_Operations {
 	if x != nil {
-		return x.Device
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *AccessEntry_UserAgent) GetMobile() bool {
+func (x *Middleware_Headers) GetResponse() *Middleware_Headers_Operations {
 	if x != nil {
-		return x.Mobile
+		return x.Response
 	}
-	return false
+	return nil
 }
 
-func (x *AccessEntry_UserAgent) GetTablet() bool {
+func (x *Middleware_Headers) GetForwarded() Middleware_Headers_Forwarded {
 	if x != nil {
-		return x.Tablet
+		return x.Forwarded
 	}
-	return false
+	return Middleware_Headers_KEEP
 }
 
-func (x *AccessEntry_UserAgent) GetDesktop() bool {
+func (x *Middleware_Headers) GetHopHeaders() []string {
 	if x != nil {
-		return x.Desktop
+		return x.HopHeaders
 	}
-	return false
+	return nil
 }
 
-func (x *AccessEntry_UserAgent) GetBot() bool {
+func (x *Middleware_Headers) GetIgnoreConnectionTokens() bool {
 	if x != nil {
-		return x.Bot
+		return x.IgnoreConnectionTokens
 	}
 	return false
 }
 
-type AccessEntry_Request struct {
+// Rewrite changes the path and host of requests before they are passed on.
+// Changes are applied in order of the fields. Requests whose rewritten path
+// has . or .. segments fail with 400 Bad Request.
+type Middleware_Rewrite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserAgent *AccessEntry_UserAgent `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Method    string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Size      int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Path      string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// Prefix replaces the path prefix from with to.
+	Prefix *Middleware_Rewrite_Prefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Regex replaces matches of pattern in the path.
+	Regex *Middleware_Rewrite_Regex `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	// Path replaces the path with a template like the values of Headers, for
+	// instance /v2/{var.path} for routes matching a regular expression path.
+	// Values of var variables are escaped.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Host replaces the host of requests with a template.
+	Host string `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
 }
 
-func (x *AccessEntry_Request) Reset() {
-	*x = AccessEntry_Request{}
+func (x *Middleware_Rewrite) Reset() {
+	*x = Middleware_Rewrite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3587,13 +4771,13 @@ func (x *AccessEntry_Request) Reset() {
 	}
 }
 
-func (x *AccessEntry_Request) String() string {
+func (x *Middleware_Rewrite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry_Request) ProtoMessage() {}
+func (*Middleware_Rewrite) ProtoMessage() {}
 
-func (x *AccessEntry_Request) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Rewrite) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3605,52 +4789,58 @@ func (x *AccessEntry_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessEntry_Request.ProtoReflect.Descriptor instead.
-func (*AccessEntry_Request) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19, 1}
+// Deprecated: Use Middleware_Rewrite.ProtoReflect.Descriptor instead.
+func (*Middleware_Rewrite) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 6}
 }
 
-func (x *AccessEntry_Request) GetUserAgent() *AccessEntry_UserAgent {
+func (x *Middleware_Rewrite) GetPrefix() *Middleware_Rewrite_Prefix {
 	if x != nil {
-		return x.UserAgent
+		return x.Prefix
 	}
 	return nil
 }
 
-func (x *AccessEntry_Request) GetMethod() string {
+func (x *Middleware_Rewrite) GetRegex() *Middleware_Rewrite_Regex {
 	if x != nil {
-		return x.Method
+		return x.Regex
 	}
-	return ""
+	return nil
 }
 
-func (x *AccessEntry_Request) GetSize() int32 {
+func (x *Middleware_Rewrite) GetPath() string {
 	if x != nil {
-		return x.Size
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *AccessEntry_Request) GetPath() string {
+func (x *Middleware_Rewrite) GetHost() string {
 	if x != nil {
-		return x.Path
+		return x.Host
 	}
 	return ""
 }
 
-type AccessEntry_ReverseProxy struct {
+// Redirect answers requests with a redirect. GET and HEAD requests are
+// redirected with 301 or 302, other methods with 308 or 307 so they are
+// repeated with the same method.
+type Middleware_Redirect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Number of bytes sent to the downstream service.
-	BytesSent     int64  `protobuf:"varint,1,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
-	BytesReceived int64  `protobuf:"varint,2,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
-	Target        string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Target is a template of the redirect location like the values of
+	// Headers. Defaults to https://{host}{uri} for https redirects.
+	Target    string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Permanent bool   `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Https only redirects requests received without TLS, requests received
+	// with TLS are passed on.
+	Https bool `protobuf:"varint,3,opt,name=https,proto3" json:"https,omitempty"`
 }
 
-func (x *AccessEntry_ReverseProxy) Reset() {
-	*x = AccessEntry_ReverseProxy{}
+func (x *Middleware_Redirect) Reset() {
+	*x = Middleware_Redirect{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3658,13 +4848,13 @@ func (x *AccessEntry_ReverseProxy) Reset() {
 	}
 }
 
-func (x *AccessEntry_ReverseProxy) String() string {
+func (x *Middleware_Redirect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry_ReverseProxy) ProtoMessage() {}
+func (*Middleware_Redirect) ProtoMessage() {}
 
-func (x *AccessEntry_ReverseProxy) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Redirect) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3676,45 +4866,51 @@ func (x *AccessEntry_ReverseProxy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessEntry_ReverseProxy.ProtoReflect.Descriptor instead.
-func (*AccessEntry_ReverseProxy) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19, 2}
+// Deprecated: Use Middleware_Redirect.ProtoReflect.Descriptor instead.
+func (*Middleware_Redirect) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 7}
 }
 
-func (x *AccessEntry_ReverseProxy) GetBytesSent() int64 {
+func (x *Middleware_Redirect) GetTarget() string {
 	if x != nil {
-		return x.BytesSent
+		return x.Target
 	}
-	return 0
+	return ""
 }
 
-func (x *AccessEntry_ReverseProxy) GetBytesReceived() int64 {
+func (x *Middleware_Redirect) GetPermanent() bool {
 	if x != nil {
-		return x.BytesReceived
+		return x.Permanent
 	}
-	return 0
+	return false
 }
 
-func (x *AccessEntry_ReverseProxy) GetTarget() string {
+func (x *Middleware_Redirect) GetHttps() bool {
 	if x != nil {
-		return x.Target
+		return x.Https
 	}
-	return ""
+	return false
 }
 
-type AccessEntry_Response struct {
+type Middleware_Wasm_Setting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Total size in bytes of the response.
-	Size              int64              `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
-	StatusCode        int32              `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	TimeToWriteHeader *duration.Duration `protobuf:"bytes,3,opt,name=time_to_write_header,json=timeToWriteHeader,proto3" json:"time_to_write_header,omitempty"`
+	ProgramName        string                                  `protobuf:"bytes,1,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
+	Arguments          []string                                `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Environments       []*Middleware_Wasm_Setting_Env          `protobuf:"bytes,3,rep,name=environments,proto3" json:"environments,omitempty"`
+	PreopenDirectories []string                                `protobuf:"bytes,4,rep,name=preopen_directories,json=preopenDirectories,proto3" json:"preopen_directories,omitempty"`
+	MapDirectories     []*Middleware_Wasm_Setting_DirectoryMap `protobuf:"bytes,5,rep,name=map_directories,json=mapDirectories,proto3" json:"map_directories,omitempty"`
+	InheritStdin       bool                                    `protobuf:"varint,6,opt,name=inherit_stdin,json=inheritStdin,proto3" json:"inherit_stdin,omitempty"`
+	CaptureStdout      bool                                    `protobuf:"varint,7,opt,name=capture_stdout,json=captureStdout,proto3" json:"capture_stdout,omitempty"`
+	InheritStdout      bool                                    `protobuf:"varint,8,opt,name=inherit_stdout,json=inheritStdout,proto3" json:"inherit_stdout,omitempty"`
+	CaptureStderr      bool                                    `protobuf:"varint,9,opt,name=capture_stderr,json=captureStderr,proto3" json:"capture_stderr,omitempty"`
+	InheritStderr      bool                                    `protobuf:"varint,10,opt,name=inherit_stderr,json=inheritStderr,proto3" json:"inherit_stderr,omitempty"`
 }
 
-func (x *AccessEntry_Response) Reset() {
-	*x = AccessEntry_Response{}
+func (x *Middleware_Wasm_Setting) Reset() {
+	*x = Middleware_Wasm_Setting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tcp_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3722,13 +4918,13 @@ func (x *AccessEntry_Response) Reset() {
 	}
 }
 
-func (x *AccessEntry_Response) String() string {
+func (x *Middleware_Wasm_Setting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry_Response) ProtoMessage() {}
+func (*Middleware_Wasm_Setting) ProtoMessage() {}
 
-func (x *AccessEntry_Response) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Wasm_Setting) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3740,701 +4936,4360 @@ func (x *AccessEntry_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessEntry_Response.ProtoReflect.Descriptor instead.
-func (*AccessEntry_Response) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19, 3}
+// Deprecated: Use Middleware_Wasm_Setting.ProtoReflect.Descriptor instead.
+func (*Middleware_Wasm_Setting) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 1, 0}
 }
 
-func (x *AccessEntry_Response) GetSize() int64 {
+func (x *Middleware_Wasm_Setting) GetProgramName() string {
 	if x != nil {
-		return x.Size
+		return x.ProgramName
 	}
-	return 0
+	return ""
 }
 
-func (x *AccessEntry_Response) GetStatusCode() int32 {
+func (x *Middleware_Wasm_Setting) GetArguments() []string {
 	if x != nil {
-		return x.StatusCode
+		return x.Arguments
 	}
-	return 0
+	return nil
 }
 
-func (x *AccessEntry_Response) GetTimeToWriteHeader() *duration.Duration {
+func (x *Middleware_Wasm_Setting) GetEnvironments() []*Middleware_Wasm_Setting_Env {
 	if x != nil {
-		return x.TimeToWriteHeader
+		return x.Environments
 	}
 	return nil
 }
 
-type AccessEntry_Info struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The name of the registered route.Do not confuse this with http route.
-	// This
-	// is configured route on tt
-	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	// The service ID. A service is a collection of routes.
-	Service      string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	VirtualHosts []string `protobuf:"bytes,4,rep,name=virtual_hosts,json=virtualHosts,proto3" json:"virtual_hosts,omitempty"`
+func (x *Middleware_Wasm_Setting) GetPreopenDirectories() []string {
+	if x != nil {
+		return x.PreopenDirectories
+	}
+	return nil
 }
 
-func (x *AccessEntry_Info) Reset() {
-	*x = AccessEntry_Info{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tcp_proto_msgTypes[51]
+func (x *Middleware_Wasm_Setting) GetMapDirectories() []*Middleware_Wasm_Setting_DirectoryMap {
+	if x != nil {
+		return x.MapDirectories
+	}
+	return nil
+}
+
+func (x *Middleware_Wasm_Setting) GetInheritStdin() bool {
+	if x != nil {
+		return x.InheritStdin
+	}
+	return false
+}
+
+func (x *Middleware_Wasm_Setting) GetCaptureStdout() bool {
+	if x != nil {
+		return x.CaptureStdout
+	}
+	return false
+}
+
+func (x *Middleware_Wasm_Setting) GetInheritStdout() bool {
+	if x != nil {
+		return x.InheritStdout
+	}
+	return false
+}
+
+func (x *Middleware_Wasm_Setting) GetCaptureStderr() bool {
+	if x != nil {
+		return x.CaptureStderr
+	}
+	return false
+}
+
+func (x *Middleware_Wasm_Setting) GetInheritStderr() bool {
+	if x != nil {
+		return x.InheritStderr
+	}
+	return false
+}
+
+type Middleware_Wasm_Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The options used to create wasm instance of the module.
+	Instance *Middleware_Wasm_Setting `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Plugin specific settings that the wasm module will load and use to
+	// configer itself.
+	Plugin *_struct.Struct `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *Middleware_Wasm_Config) Reset() {
+	*x = Middleware_Wasm_Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccessEntry_Info) String() string {
+func (x *Middleware_Wasm_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessEntry_Info) ProtoMessage() {}
+func (*Middleware_Wasm_Config) ProtoMessage() {}
 
-func (x *AccessEntry_Info) ProtoReflect() protoreflect.Message {
+func (x *Middleware_Wasm_Config) ProtoReflect() protoreflect.Message {
 	mi := &file_tcp_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AccessEntry_Info.ProtoReflect.Descriptor instead.
-func (*AccessEntry_Info) Descriptor() ([]byte, []int) {
-	return file_tcp_proto_rawDescGZIP(), []int{19, 4}
-}
-
-func (x *AccessEntry_Info) GetRoute() string {
-	if x != nil {
-		return x.Route
-	}
-	return ""
-}
-
-func (x *AccessEntry_Info) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-func (x *AccessEntry_Info) GetVirtualHosts() []string {
-	if x != nil {
-		return x.VirtualHosts
-	}
-	return nil
-}
-
-var File_tcp_proto protoreflect.FileDescriptor
-
-var file_tcp_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x74, 0x63, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
-	0x22, 0x99, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x75,
-	0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x22, 0x24, 0x0a, 0x08, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61,
-	0x67, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x01, 0x22, 0x0e, 0x0a, 0x0c,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x02, 0x0a,
-	0x04, 0x52, 0x61, 0x66, 0x74, 0x1a, 0xf8, 0x01, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x1a, 0x50, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10,
-	0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x53, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04,
-	0x1a, 0x3d, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x61, 0x66,
-	0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x65,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
-	0xcf, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x53, 0x0a, 0x0a, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x0d,
-	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x1e, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x42, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
-	0x74, 0x22, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
-	0x16, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x38, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0c,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x07, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc1, 0x08, 0x0a, 0x0a, 0x4d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x12,
-	0x49, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61, 0x74,
-	0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x69, 0x70,
-	0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x1a, 0x27, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x52, 0x04, 0x6c,
-	0x69, 0x73, 0x74, 0x1a, 0xc7, 0x06, 0x0a, 0x04, 0x57, 0x61, 0x73, 0x6d, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x4d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xc1, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x2e, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x76, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x6f, 0x70,
-	0x65, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57,
-	0x61, 0x73, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x68, 0x65,
-	0x72, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74,
-	0x64, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x5f,
-	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e,
-	0x68, 0x65, 0x72, 0x69, 0x74, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x64, 0x65,
-	0x72, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x74,
-	0x64, 0x65, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x68, 0x65,
-	0x72, 0x69, 0x74, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x1a, 0x2d, 0x0a, 0x03, 0x45, 0x6e, 0x76,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x42, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x6f, 0x0a, 0x06,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x1a, 0x29, 0x0a,
-	0x0f, 0x53, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x19, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56,
-	0x32, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x40, 0x0a, 0x04,
-	0x42, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x04, 0x0a, 0x02, 0x74, 0x6f, 0x22, 0xac,
-	0x07, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x62,
-	0x69, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x07, 0x72,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6b,
-	0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x41,
-	0x6c, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x57, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x70, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x1c, 0x0a,
-	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53,
-	0x70, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x19, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x6d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69,
-	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68,
-	0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x0f, 0x4c, 0x6f,
-	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x16, 0x0a,
-	0x12, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x6d, 0x6f,
-	0x6f, 0x74, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x10, 0x02, 0x22, 0x43, 0x0a,
-	0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x22, 0x49, 0x0a, 0x07, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x90, 0x01,
-	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x33,
-	0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c,
-	0x22, 0x87, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x03, 0x73, 0x6e, 0x69, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xc4, 0x04, 0x0a, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2d, 0x0a,
-	0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x29, 0x0a, 0x08,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x52, 0x08, 0x75,
-	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x48, 0x0a, 0x04, 0x53, 0x74,
-	0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x22, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x1a, 0x73, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x52, 0x04, 0x73, 0x74, 0x61, 0x74, 0x1a, 0xc5, 0x01, 0x0a, 0x04, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x73,
-	0x6e, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72,
-	0x74, 0x22, 0xad, 0x08, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x61, 0x6c,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x03, 0x61, 0x6e,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x19, 0x0a, 0x03, 0x6e, 0x6f,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x54, 0x43, 0x50, 0x48, 0x00, 0x52,
-	0x03, 0x74, 0x63, 0x70, 0x12, 0x20, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00,
-	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x23, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x96, 0x01, 0x0a, 0x03,
-	0x54, 0x43, 0x50, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
-	0x54, 0x43, 0x50, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x6e, 0x69, 0x1a, 0x2f, 0x0a, 0x09, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x1a, 0xc1, 0x05, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x31, 0x0a,
-	0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
-	0x12, 0x25, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x48,
-	0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
-	0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0b,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65,
-	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50,
-	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x8c, 0x01,
-	0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
-	0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x28, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x78,
-	0x61, 0x63, 0x74, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x1a, 0x37, 0x0a, 0x0c,
-	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x7c, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x52, 0x75,
-	0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x78, 0x61, 0x63, 0x74, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
-	0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x10, 0x02, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a,
-	0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01,
-	0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c,
-	0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04,
-	0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x12, 0x0b, 0x0a,
-	0x07, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45,
-	0x41, 0x44, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x08, 0x42,
-	0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x22, 0xc9, 0x07, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0xdc, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x12,
-	0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x6f,
-	0x74, 0x1a, 0x80, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x1a, 0x6c, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53,
-	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x1a, 0x8b, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x74,
-	0x69, 0x6d, 0x65, 0x54, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x1a, 0x5b, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x2a, 0x3f, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x45, 0x42, 0x53, 0x4f, 0x43, 0x4b,
-	0x45, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x51, 0x55, 0x49, 0x43, 0x10, 0x04, 0x32, 0xa8,
-	0x01, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
-	0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x07, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x03, 0x50, 0x75, 0x74, 0x12,
-	0x07, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x07, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x23, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x0c, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x4a, 0x6f, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x64, 0x0a, 0x07, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2c, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x1b, 0x5a, 0x19, 0x67, 0x69, 0x72, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65,
-	0x72, 0x6e, 0x65, 0x73, 0x74, 0x2f, 0x74, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_tcp_proto_rawDescOnce sync.Once
-	file_tcp_proto_rawDescData = file_tcp_proto_rawDesc
-)
-
-func file_tcp_proto_rawDescGZIP() []byte {
-	file_tcp_proto_rawDescOnce.Do(func() {
-		file_tcp_proto_rawDescData = protoimpl.X.CompressGZIP(file_tcp_proto_rawDescData)
-	})
-	return file_tcp_proto_rawDescData
-}
-
-var file_tcp_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_tcp_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
-var file_tcp_proto_goTypes = []interface{}{
-	(Protocol)(0),                                // 0: Protocol
-	(JoinRequest_Suffrage)(0),                    // 1: JoinRequest.Suffrage
-	(Raft_KeyValue_Action)(0),                    // 2: Raft.KeyValue.Action
-	(Middleware_Version)(0),                      // 3: Middleware.Version
-	(Route_LoadBalanceAlgo)(0),                   // 4: Route.LoadBalanceAlgo
-	(Rule_HTTP_Method)(0),                        // 5: Rule.HTTP.Method
-	(Rule_HTTP_KeyValue_Type)(0),                 // 6: Rule.HTTP.KeyValue.Type
-	(Rule_HTTP_Path_Type)(0),                     // 7: Rule.HTTP.Path.Type
-	(*ConfigRequest)(nil),                        // 8: ConfigRequest
-	(*DeleteRequest)(nil),                        // 9: DeleteRequest
-	(*JoinRequest)(nil),                          // 10: JoinRequest
-	(*JoinResponse)(nil),                         // 11: JoinResponse
-	(*Raft)(nil),                                 // 12: Raft
-	(*Store)(nil),                                // 13: Store
-	(*Response)(nil),                             // 14: Response
-	(*Config)(nil),                               // 15: Config
-	(*WeightedAddr)(nil),                         // 16: WeightedAddr
-	(*Address)(nil),                              // 17: Address
-	(*Middleware)(nil),                           // 18: Middleware
-	(*Bind)(nil),                                 // 19: Bind
-	(*Route)(nil),                                // 20: Route
-	(*Speed)(nil),                                // 21: Speed
-	(*Retries)(nil),                              // 22: Retries
-	(*RetryBudget)(nil),                          // 23: RetryBudget
-	(*RequestMatch)(nil),                         // 24: RequestMatch
-	(*Context)(nil),                              // 25: Context
-	(*Rule)(nil),                                 // 26: Rule
-	(*AccessEntry)(nil),                          // 27: AccessEntry
-	(*Raft_KeyValue)(nil),                        // 28: Raft.KeyValue
-	(*Raft_Log)(nil),                             // 29: Raft.Log
-	(*Raft_KeyValue_Context)(nil),                // 30: Raft.KeyValue.Context
-	(*Store_SetRequest)(nil),                     // 31: Store.SetRequest
-	(*Store_SetResponse)(nil),                    // 32: Store.SetResponse
-	(*Store_GetRequest)(nil),                     // 33: Store.GetRequest
-	(*Store_GetResponse)(nil),                    // 34: Store.GetResponse
-	nil,                                          // 35: WeightedAddr.MetricLabelsEntry
-	(*Middleware_List)(nil),                      // 36: Middleware.List
-	(*Middleware_Wasm)(nil),                      // 37: Middleware.Wasm
-	(*Middleware_StripPathPrefix)(nil),           // 38: Middleware.StripPathPrefix
-	(*Middleware_Wasm_Setting)(nil),              // 39: Middleware.Wasm.Setting
-	(*Middleware_Wasm_Config)(nil),               // 40: Middleware.Wasm.Config
-	(*Middleware_Wasm_Setting_Env)(nil),          // 41: Middleware.Wasm.Setting.Env
-	(*Middleware_Wasm_Setting_DirectoryMap)(nil), // 42: Middleware.Wasm.Setting.DirectoryMap
-	nil,                              // 43: Route.MetricsLabelsEntry
-	(*Context_Stat)(nil),             // 44: Context.Stat
-	(*Context_Conn)(nil),             // 45: Context.Conn
-	(*Context_Info)(nil),             // 46: Context.Info
-	(*Rule_List)(nil),                // 47: Rule.List
-	(*Rule_TCP)(nil),                 // 48: Rule.TCP
-	(*Rule_HTTP)(nil),                // 49: Rule.HTTP
-	(*Rule_TCP_PortRange)(nil),       // 50: Rule.TCP.PortRange
-	(*Rule_HTTP_MethodList)(nil),     // 51: Rule.HTTP.MethodList
-	(*Rule_HTTP_KeyValue)(nil),       // 52: Rule.HTTP.KeyValue
-	(*Rule_HTTP_KeyValueList)(nil),   // 53: Rule.HTTP.KeyValueList
-	(*Rule_HTTP_Path)(nil),           // 54: Rule.HTTP.Path
-	(*AccessEntry_UserAgent)(nil),    // 55: AccessEntry.UserAgent
-	(*AccessEntry_Request)(nil),      // 56: AccessEntry.Request
-	(*AccessEntry_ReverseProxy)(nil), // 57: AccessEntry.ReverseProxy
-	(*AccessEntry_Response)(nil),     // 58: AccessEntry.Response
-	(*AccessEntry_Info)(nil),         // 59: AccessEntry.Info
-	(*duration.Duration)(nil),        // 60: google.protobuf.Duration
-	(*empty.Empty)(nil),              // 61: google.protobuf.Empty
-	(*_struct.Struct)(nil),           // 62: google.protobuf.Struct
-	(*wrappers.StringValue)(nil),     // 63: google.protobuf.StringValue
-}
-var file_tcp_proto_depIdxs = []int32{
-	1,  // 0: JoinRequest.suffrage:type_name -> JoinRequest.Suffrage
-	20, // 1: Config.routes:type_name -> Route
-	17, // 2: WeightedAddr.addr:type_name -> Address
-	35, // 3: WeightedAddr.metric_labels:type_name -> WeightedAddr.MetricLabelsEntry
-	37, // 4: Middleware.wasm:type_name -> Middleware.Wasm
-	38, // 5: Middleware.strip_path_prefix:type_name -> Middleware.StripPathPrefix
-	19, // 6: Route.bind:type_name -> Bind
-	24, // 7: Route.condition:type_name -> RequestMatch
-	43, // 8: Route.metrics_labels:type_name -> Route.MetricsLabelsEntry
-	22, // 9: Route.retries:type_name -> Retries
-	60, // 10: Route.timeout:type_name -> google.protobuf.Duration
-	60, // 11: Route.keepAlive:type_name -> google.protobuf.Duration
-	16, // 12: Route.load_balance:type_name -> WeightedAddr
-	4,  // 13: Route.load_balance_algo:type_name -> Route.LoadBalanceAlgo
-	21, // 14: Route.speed:type_name -> Speed
-	26, // 15: Route.rule:type_name -> Rule
-	36, // 16: Route.middlewares:type_name -> Middleware.List
-	0,  // 17: Route.protocol:type_name -> Protocol
-	23, // 18: Retries.budget:type_name -> RetryBudget
-	60, // 19: RetryBudget.ttl:type_name -> google.protobuf.Duration
-	61, // 20: RequestMatch.fixed:type_name -> google.protobuf.Empty
-	0,  // 21: Context.protocol:type_name -> Protocol
-	45, // 22: Context.downstream:type_name -> Context.Conn
-	45, // 23: Context.upstream:type_name -> Context.Conn
-	46, // 24: Context.info:type_name -> Context.Info
-	47, // 25: Rule.all:type_name -> Rule.List
-	47, // 26: Rule.any:type_name -> Rule.List
-	26, // 27: Rule.not:type_name -> Rule
-	48, // 28: Rule.tcp:type_name -> Rule.TCP
-	49, // 29: Rule.http:type_name -> Rule.HTTP
-	56, // 30: AccessEntry.request:type_name -> AccessEntry.Request
-	58, // 31: AccessEntry.response:type_name -> AccessEntry.Response
-	57, // 32: AccessEntry.reverse_proxy:type_name -> AccessEntry.ReverseProxy
-	59, // 33: AccessEntry.info:type_name -> AccessEntry.Info
-	60, // 34: AccessEntry.duration:type_name -> google.protobuf.Duration
-	2,  // 35: Raft.KeyValue.action:type_name -> Raft.KeyValue.Action
-	30, // 36: Raft.KeyValue.context:type_name -> Raft.KeyValue.Context
-	28, // 37: Raft.Log.key_value:type_name -> Raft.KeyValue
-	18, // 38: Middleware.List.list:type_name -> Middleware
-	40, // 39: Middleware.Wasm.config:type_name -> Middleware.Wasm.Config
-	3,  // 40: Middleware.Wasm.version:type_name -> Middleware.Version
-	41, // 41: Middleware.Wasm.Setting.environments:type_name -> Middleware.Wasm.Setting.Env
-	42, // 42: Middleware.Wasm.Setting.map_directories:type_name -> Middleware.Wasm.Setting.DirectoryMap
-	39, // 43: Middleware.Wasm.Config.instance:type_name -> Middleware.Wasm.Setting
-	62, // 44: Middleware.Wasm.Config.plugin:type_name -> google.protobuf.Struct
-	44, // 45: Context.Conn.stat:type_name -> Context.Stat
-	63, // 46: Context.Info.sni:type_name -> google.protobuf.StringValue
-	63, // 47: Context.Info.path:type_name -> google.protobuf.StringValue
-	26, // 48: Rule.List.rules:type_name -> Rule
-	50, // 49: Rule.TCP.ports:type_name -> Rule.TCP.PortRange
-	51, // 50: Rule.HTTP.methods:type_name -> Rule.HTTP.MethodList
-	54, // 51: Rule.HTTP.path:type_name -> Rule.HTTP.Path
-	53, // 52: Rule.HTTP.headers:type_name -> Rule.HTTP.KeyValueList
-	53, // 53: Rule.HTTP.query_param:type_name -> Rule.HTTP.KeyValueList
-	5,  // 54: Rule.HTTP.MethodList.list:type_name -> Rule.HTTP.Method
-	6,  // 55: Rule.HTTP.KeyValue.type:type_name -> Rule.HTTP.KeyValue.Type
-	52, // 56: Rule.HTTP.KeyValueList.list:type_name -> Rule.HTTP.KeyValue
-	7,  // 57: Rule.HTTP.Path.type:type_name -> Rule.HTTP.Path.Type
-	55, // 58: AccessEntry.Request.user_agent:type_name -> AccessEntry.UserAgent
-	60, // 59: AccessEntry.Response.time_to_write_header:type_name -> google.protobuf.Duration
-	8,  // 60: Proxy.Get:input_type -> ConfigRequest
-	15, // 61: Proxy.Put:input_type -> Config
-	15, // 62: Proxy.Post:input_type -> Config
-	9,  // 63: Proxy.Delete:input_type -> DeleteRequest
-	10, // 64: Proxy.Join:input_type -> JoinRequest
-	31, // 65: Storage.Set:input_type -> Store.SetRequest
-	33, // 66: Storage.Get:input_type -> Store.GetRequest
-	15, // 67: Proxy.Get:output_type -> Config
-	14, // 68: Proxy.Put:output_type -> Response
-	14, // 69: Proxy.Post:output_type -> Response
-	14, // 70: Proxy.Delete:output_type -> Response
-	11, // 71: Proxy.Join:output_type -> JoinResponse
-	31, // 72: Storage.Set:output_type -> Store.SetRequest
-	34, // 73: Storage.Get:output_type -> Store.GetResponse
-	67, // [67:74] is the sub-list for method output_type
-	60, // [60:67] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
-}
-
-func init() { file_tcp_proto_init() }
-func file_tcp_proto_init() {
-	if File_tcp_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_tcp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Wasm_Config.ProtoReflect.Descriptor instead.
+func (*Middleware_Wasm_Config) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 1, 1}
+}
+
+func (x *Middleware_Wasm_Config) GetInstance() *Middleware_Wasm_Setting {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *Middleware_Wasm_Config) GetPlugin() *_struct.Struct {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type Middleware_Wasm_Setting_Env struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Middleware_Wasm_Setting_Env) Reset() {
+	*x = Middleware_Wasm_Setting_Env{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Wasm_Setting_Env) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Wasm_Setting_Env) ProtoMessage() {}
+
+func (x *Middleware_Wasm_Setting_Env) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Wasm_Setting_Env.ProtoReflect.Descriptor instead.
+func (*Middleware_Wasm_Setting_Env) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 1, 0, 0}
+}
+
+func (x *Middleware_Wasm_Setting_Env) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Middleware_Wasm_Setting_Env) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Middleware_Wasm_Setting_DirectoryMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias     string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Directory string `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+}
+
+func (x *Middleware_Wasm_Setting_DirectoryMap) Reset() {
+	*x = Middleware_Wasm_Setting_DirectoryMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Wasm_Setting_DirectoryMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Wasm_Setting_DirectoryMap) ProtoMessage() {}
+
+func (x *Middleware_Wasm_Setting_DirectoryMap) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Wasm_Setting_DirectoryMap.ProtoReflect.Descriptor instead.
+func (*Middleware_Wasm_Setting_DirectoryMap) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 1, 0, 1}
+}
+
+func (x *Middleware_Wasm_Setting_DirectoryMap) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Middleware_Wasm_Setting_DirectoryMap) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+// Key picks the parts of requests that identify cached responses. The
+// method, scheme, host, path and query are used by default. Responses are
+// further told apart by the request headers listed in their Vary header.
+type Middleware_Cache_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Leave the host out of keys, responses are shared by all hosts.
+	IgnoreHost bool `protobuf:"varint,1,opt,name=ignore_host,json=ignoreHost,proto3" json:"ignore_host,omitempty"`
+	// Leave the query string out of keys.
+	IgnoreQuery bool `protobuf:"varint,2,opt,name=ignore_query,json=ignoreQuery,proto3" json:"ignore_query,omitempty"`
+	// Request headers added to keys.
+	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Request cookies added to keys.
+	Cookies []string `protobuf:"bytes,4,rep,name=cookies,proto3" json:"cookies,omitempty"`
+}
+
+func (x *Middleware_Cache_Key) Reset() {
+	*x = Middleware_Cache_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Cache_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Cache_Key) ProtoMessage() {}
+
+func (x *Middleware_Cache_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Cache_Key.ProtoReflect.Descriptor instead.
+func (*Middleware_Cache_Key) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 3, 0}
+}
+
+func (x *Middleware_Cache_Key) GetIgnoreHost() bool {
+	if x != nil {
+		return x.IgnoreHost
+	}
+	return false
+}
+
+func (x *Middleware_Cache_Key) GetIgnoreQuery() bool {
+	if x != nil {
+		return x.IgnoreQuery
+	}
+	return false
+}
+
+func (x *Middleware_Cache_Key) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Middleware_Cache_Key) GetCookies() []string {
+	if x != nil {
+		return x.Cookies
+	}
+	return nil
+}
+
+type Middleware_Headers_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Middleware_Headers_Header) Reset() {
+	*x = Middleware_Headers_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Headers_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Headers_Header) ProtoMessage() {}
+
+func (x *Middleware_Headers_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Headers_Header.ProtoReflect.Descriptor instead.
+func (*Middleware_Headers_Header) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 5, 0}
+}
+
+func (x *Middleware_Headers_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Middleware_Headers_Header) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Middleware_Headers_Rename struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *Middleware_Headers_Rename) Reset() {
+	*x = Middleware_Headers_Rename{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Headers_Rename) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Headers_Rename) ProtoMessage() {}
+
+func (x *Middleware_Headers_Rename) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Headers_Rename.ProtoReflect.Descriptor instead.
+func (*Middleware_Headers_Rename) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 5, 1}
+}
+
+func (x *Middleware_Headers_Rename) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Middleware_Headers_Rename) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Operations are applied in order of their fields.
+type Middleware_Headers_Operations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remove []string                     `protobuf:"bytes,1,rep,name=remove,proto3" json:"remove,omitempty"`
+	Rename []*Middleware_Headers_Rename `protobuf:"bytes,2,rep,name=rename,proto3" json:"rename,omitempty"`
+	// set replaces all values of a header.
+	Set []*Middleware_Headers_Header `protobuf:"bytes,3,rep,name=set,proto3" json:"set,omitempty"`
+	// add appends a value to a header.
+	Add []*Middleware_Headers_Header `protobuf:"bytes,4,rep,name=add,proto3" json:"add,omitempty"`
+}
+
+func (x *Middleware_Headers_Operations) Reset() {
+	*x = Middleware_Headers_Operations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Headers_Operations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Headers_Operations) ProtoMessage() {}
+
+func (x *Middleware_Headers_Operations) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Headers_Operations.ProtoReflect.Descriptor instead.
+func (*Middleware_Headers_Operations) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 5, 2}
+}
+
+func (x *Middleware_Headers_Operations) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *Middleware_Headers_Operations) GetRename() []*Middleware_Headers_Rename {
+	if x != nil {
+		return x.Rename
+	}
+	return nil
+}
+
+func (x *Middleware_Headers_Operations) GetSet() []*Middleware_Headers_Header {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *Middleware_Headers_Operations) GetAdd() []*Middleware_Headers_Header {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+type Middleware_Rewrite_Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *Middleware_Rewrite_Prefix) Reset() {
+	*x = Middleware_Rewrite_Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Rewrite_Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Rewrite_Prefix) ProtoMessage() {}
+
+func (x *Middleware_Rewrite_Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Rewrite_Prefix.ProtoReflect.Descriptor instead.
+func (*Middleware_Rewrite_Prefix) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 6, 0}
+}
+
+func (x *Middleware_Rewrite_Prefix) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Middleware_Rewrite_Prefix) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type Middleware_Rewrite_Regex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// $1 and ${name} in replacement are replaced by capture groups.
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
+}
+
+func (x *Middleware_Rewrite_Regex) Reset() {
+	*x = Middleware_Rewrite_Regex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Middleware_Rewrite_Regex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Middleware_Rewrite_Regex) ProtoMessage() {}
+
+func (x *Middleware_Rewrite_Regex) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Middleware_Rewrite_Regex.ProtoReflect.Descriptor instead.
+func (*Middleware_Rewrite_Regex) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{12, 6, 1}
+}
+
+func (x *Middleware_Rewrite_Regex) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Middleware_Rewrite_Regex) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+// Unix is a unix domain socket.
+type Bind_Unix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to the socket file. Paths starting with @ are abstract sockets, they
+	// are only supported on linux and have no file mode or owner.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// File mode of the socket file in octal eg "0660".
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Owner of the socket file. This can be a name or numeric id.
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Group of the socket file. This can be a name or numeric id.
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *Bind_Unix) Reset() {
+	*x = Bind_Unix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bind_Unix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bind_Unix) ProtoMessage() {}
+
+func (x *Bind_Unix) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bind_Unix.ProtoReflect.Descriptor instead.
+func (*Bind_Unix) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *Bind_Unix) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Bind_Unix) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Bind_Unix) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Bind_Unix) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// ListenOptions are socket options applied to the listener.
+type Bind_ListenOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of sockets bound with SO_REUSEPORT on the same address, each with
+	// its own accept loop. Values less than 2 use a single socket.
+	Acceptors uint32 `protobuf:"varint,1,opt,name=acceptors,proto3" json:"acceptors,omitempty"`
+	// Maximum length of the queue of pending connections. Defaults to the
+	// system maximum.
+	Backlog int32 `protobuf:"varint,2,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	// Enables TCP_FASTOPEN with the given queue length.
+	TcpFastOpen int32 `protobuf:"varint,3,opt,name=tcp_fast_open,json=tcpFastOpen,proto3" json:"tcp_fast_open,omitempty"`
+	// Sets TCP_NODELAY on accepted connections, this is enabled by default.
+	TcpNoDelay *wrappers.BoolValue `protobuf:"bytes,4,opt,name=tcp_no_delay,json=tcpNoDelay,proto3" json:"tcp_no_delay,omitempty"`
+	// Enables TCP_DEFER_ACCEPT, connections are only accepted once data
+	// arrives or the timeout expires.
+	DeferAccept *duration.Duration `protobuf:"bytes,5,opt,name=defer_accept,json=deferAccept,proto3" json:"defer_accept,omitempty"`
+	// Name of the network interface to bind to with SO_BINDTODEVICE.
+	Interface string `protobuf:"bytes,6,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Only accept IPv6 connections on IPv6 addresses.
+	Ipv6Only bool `protobuf:"varint,7,opt,name=ipv6_only,json=ipv6Only,proto3" json:"ipv6_only,omitempty"`
+}
+
+func (x *Bind_ListenOptions) Reset() {
+	*x = Bind_ListenOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bind_ListenOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bind_ListenOptions) ProtoMessage() {}
+
+func (x *Bind_ListenOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bind_ListenOptions.ProtoReflect.Descriptor instead.
+func (*Bind_ListenOptions) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *Bind_ListenOptions) GetAcceptors() uint32 {
+	if x != nil {
+		return x.Acceptors
+	}
+	return 0
+}
+
+func (x *Bind_ListenOptions) GetBacklog() int32 {
+	if x != nil {
+		return x.Backlog
+	}
+	return 0
+}
+
+func (x *Bind_ListenOptions) GetTcpFastOpen() int32 {
+	if x != nil {
+		return x.TcpFastOpen
+	}
+	return 0
+}
+
+func (x *Bind_ListenOptions) GetTcpNoDelay() *wrappers.BoolValue {
+	if x != nil {
+		return x.TcpNoDelay
+	}
+	return nil
+}
+
+func (x *Bind_ListenOptions) GetDeferAccept() *duration.Duration {
+	if x != nil {
+		return x.DeferAccept
+	}
+	return nil
+}
+
+func (x *Bind_ListenOptions) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Bind_ListenOptions) GetIpv6Only() bool {
+	if x != nil {
+		return x.Ipv6Only
+	}
+	return false
+}
+
+type TLS_Certificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Files with the PEM encoded certificate chain and private key.
+	CertFile string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile  string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Key of the certificate in the replicated store, used instead of files.
+	// The value is the PEM encoded certificate chain followed by the private
+	// key.
+	StoreKey string `protobuf:"bytes,3,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+}
+
+func (x *TLS_Certificate) Reset() {
+	*x = TLS_Certificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLS_Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLS_Certificate) ProtoMessage() {}
+
+func (x *TLS_Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLS_Certificate.ProtoReflect.Descriptor instead.
+func (*TLS_Certificate) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *TLS_Certificate) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLS_Certificate) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLS_Certificate) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+// Passive ejects targets that fail requests. A request fails when connecting
+// to the target failed or the target answered with a 5xx status code.
+type HealthCheck_Passive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Targets are ejected after consecutive_failures failed requests in a row.
+	// Defaults to 5.
+	ConsecutiveFailures uint32 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// How long targets stay ejected. Defaults to 30s.
+	EjectionTime *duration.Duration `protobuf:"bytes,2,opt,name=ejection_time,json=ejectionTime,proto3" json:"ejection_time,omitempty"`
+}
+
+func (x *HealthCheck_Passive) Reset() {
+	*x = HealthCheck_Passive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheck_Passive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck_Passive) ProtoMessage() {}
+
+func (x *HealthCheck_Passive) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck_Passive.ProtoReflect.Descriptor instead.
+func (*HealthCheck_Passive) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *HealthCheck_Passive) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *HealthCheck_Passive) GetEjectionTime() *duration.Duration {
+	if x != nil {
+		return x.EjectionTime
+	}
+	return nil
+}
+
+type TLSFingerprint_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ja3 []string `protobuf:"bytes,1,rep,name=ja3,proto3" json:"ja3,omitempty"`
+	Ja4 []string `protobuf:"bytes,2,rep,name=ja4,proto3" json:"ja4,omitempty"`
+}
+
+func (x *TLSFingerprint_List) Reset() {
+	*x = TLSFingerprint_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSFingerprint_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSFingerprint_List) ProtoMessage() {}
+
+func (x *TLSFingerprint_List) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSFingerprint_List.ProtoReflect.Descriptor instead.
+func (*TLSFingerprint_List) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *TLSFingerprint_List) GetJa3() []string {
+	if x != nil {
+		return x.Ja3
+	}
+	return nil
+}
+
+func (x *TLSFingerprint_List) GetJa4() []string {
+	if x != nil {
+		return x.Ja4
+	}
+	return nil
+}
+
+type TLSFingerprint_Rate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of connections per second allowed for each fingerprint.
+	Average float64 `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	// Number of connections allowed above average in a burst.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+}
+
+func (x *TLSFingerprint_Rate) Reset() {
+	*x = TLSFingerprint_Rate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSFingerprint_Rate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSFingerprint_Rate) ProtoMessage() {}
+
+func (x *TLSFingerprint_Rate) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSFingerprint_Rate.ProtoReflect.Descriptor instead.
+func (*TLSFingerprint_Rate) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *TLSFingerprint_Rate) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *TLSFingerprint_Rate) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type ForwardProxy_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ForwardProxy_User) Reset() {
+	*x = ForwardProxy_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardProxy_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardProxy_User) ProtoMessage() {}
+
+func (x *ForwardProxy_User) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardProxy_User.ProtoReflect.Descriptor instead.
+func (*ForwardProxy_User) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ForwardProxy_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ForwardProxy_User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Allow lists destinations that clients are allowed to reach. A destination
+// is allowed when its host matches host_names or cidrs and its port is in
+// ports. Empty host_names and cidrs denies every host, empty ports allows
+// any port.
+type ForwardProxy_Allow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exact host names or wildcard names in the form of *.example.com
+	HostNames []string `protobuf:"bytes,1,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	Cidrs     []string `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	Ports     []uint32 `protobuf:"varint,3,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *ForwardProxy_Allow) Reset() {
+	*x = ForwardProxy_Allow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardProxy_Allow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardProxy_Allow) ProtoMessage() {}
+
+func (x *ForwardProxy_Allow) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardProxy_Allow.ProtoReflect.Descriptor instead.
+func (*ForwardProxy_Allow) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *ForwardProxy_Allow) GetHostNames() []string {
+	if x != nil {
+		return x.HostNames
+	}
+	return nil
+}
+
+func (x *ForwardProxy_Allow) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *ForwardProxy_Allow) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type Context_Stat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesRead    int64 `protobuf:"varint,1,opt,name=bytesRead,proto3" json:"bytesRead,omitempty"`
+	BytesWritten int64 `protobuf:"varint,2,opt,name=bytesWritten,proto3" json:"bytesWritten,omitempty"`
+}
+
+func (x *Context_Stat) Reset() {
+	*x = Context_Stat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context_Stat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context_Stat) ProtoMessage() {}
+
+func (x *Context_Stat) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context_Stat.ProtoReflect.Descriptor instead.
+func (*Context_Stat) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *Context_Stat) GetBytesRead() int64 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *Context_Stat) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type Context_Conn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalAddress  string        `protobuf:"bytes,1,opt,name=localAddress,proto3" json:"localAddress,omitempty"`
+	RemoteAddress string        `protobuf:"bytes,2,opt,name=remoteAddress,proto3" json:"remoteAddress,omitempty"`
+	Stat          *Context_Stat `protobuf:"bytes,3,opt,name=stat,proto3" json:"stat,omitempty"`
+}
+
+func (x *Context_Conn) Reset() {
+	*x = Context_Conn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context_Conn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context_Conn) ProtoMessage() {}
+
+func (x *Context_Conn) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context_Conn.ProtoReflect.Descriptor instead.
+func (*Context_Conn) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *Context_Conn) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *Context_Conn) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *Context_Conn) GetStat() *Context_Stat {
+	if x != nil {
+		return x.Stat
+	}
+	return nil
+}
+
+type Context_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sni        *wrappers.StringValue `protobuf:"bytes,1,opt,name=sni,proto3" json:"sni,omitempty"`
+	HostNames  []string              `protobuf:"bytes,2,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	Path       *wrappers.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	RouteName  string                `protobuf:"bytes,4,opt,name=routeName,proto3" json:"routeName,omitempty"`
+	ListenPort int32                 `protobuf:"varint,5,opt,name=listenPort,proto3" json:"listenPort,omitempty"`
+}
+
+func (x *Context_Info) Reset() {
+	*x = Context_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Context_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context_Info) ProtoMessage() {}
+
+func (x *Context_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context_Info.ProtoReflect.Descriptor instead.
+func (*Context_Info) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{31, 2}
+}
+
+func (x *Context_Info) GetSni() *wrappers.StringValue {
+	if x != nil {
+		return x.Sni
+	}
+	return nil
+}
+
+func (x *Context_Info) GetHostNames() []string {
+	if x != nil {
+		return x.HostNames
+	}
+	return nil
+}
+
+func (x *Context_Info) GetPath() *wrappers.StringValue {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Context_Info) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *Context_Info) GetListenPort() int32 {
+	if x != nil {
+		return x.ListenPort
+	}
+	return 0
+}
+
+type Rule_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *Rule_List) Reset() {
+	*x = Rule_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_List) ProtoMessage() {}
+
+func (x *Rule_List) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_List.ProtoReflect.Descriptor instead.
+func (*Rule_List) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *Rule_List) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Rule_TCP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//	*Rule_TCP_Port
+	//	*Rule_TCP_Ports
+	//	*Rule_TCP_Sni
+	Match isRule_TCP_Match `protobuf_oneof:"match"`
+}
+
+func (x *Rule_TCP) Reset() {
+	*x = Rule_TCP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_TCP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_TCP) ProtoMessage() {}
+
+func (x *Rule_TCP) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_TCP.ProtoReflect.Descriptor instead.
+func (*Rule_TCP) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (m *Rule_TCP) GetMatch() isRule_TCP_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (x *Rule_TCP) GetPort() uint32 {
+	if x, ok := x.GetMatch().(*Rule_TCP_Port); ok {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Rule_TCP) GetPorts() *Rule_TCP_PortRange {
+	if x, ok := x.GetMatch().(*Rule_TCP_Ports); ok {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Rule_TCP) GetSni() string {
+	if x, ok := x.GetMatch().(*Rule_TCP_Sni); ok {
+		return x.Sni
+	}
+	return ""
+}
+
+type isRule_TCP_Match interface {
+	isRule_TCP_Match()
+}
+
+type Rule_TCP_Port struct {
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3,oneof"`
+}
+
+type Rule_TCP_Ports struct {
+	Ports *Rule_TCP_PortRange `protobuf:"bytes,2,opt,name=ports,proto3,oneof"`
+}
+
+type Rule_TCP_Sni struct {
+	Sni string `protobuf:"bytes,3,opt,name=sni,proto3,oneof"`
+}
+
+func (*Rule_TCP_Port) isRule_TCP_Match() {}
+
+func (*Rule_TCP_Ports) isRule_TCP_Match() {}
+
+func (*Rule_TCP_Sni) isRule_TCP_Match() {}
+
+type Rule_HTTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//	*Rule_HTTP_Methods
+	//	*Rule_HTTP_Path_
+	//	*Rule_HTTP_Headers
+	//	*Rule_HTTP_QueryParam
+	Match isRule_HTTP_Match `protobuf_oneof:"match"`
+}
+
+func (x *Rule_HTTP) Reset() {
+	*x = Rule_HTTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_HTTP) ProtoMessage() {}
+
+func (x *Rule_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_HTTP.ProtoReflect.Descriptor instead.
+func (*Rule_HTTP) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2}
+}
+
+func (m *Rule_HTTP) GetMatch() isRule_HTTP_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (x *Rule_HTTP) GetMethods() *Rule_HTTP_MethodList {
+	if x, ok := x.GetMatch().(*Rule_HTTP_Methods); ok {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *Rule_HTTP) GetPath() *Rule_HTTP_Path {
+	if x, ok := x.GetMatch().(*Rule_HTTP_Path_); ok {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Rule_HTTP) GetHeaders() *Rule_HTTP_KeyValueList {
+	if x, ok := x.GetMatch().(*Rule_HTTP_Headers); ok {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Rule_HTTP) GetQueryParam() *Rule_HTTP_KeyValueList {
+	if x, ok := x.GetMatch().(*Rule_HTTP_QueryParam); ok {
+		return x.QueryParam
+	}
+	return nil
+}
+
+type isRule_HTTP_Match interface {
+	isRule_HTTP_Match()
+}
+
+type Rule_HTTP_Methods struct {
+	Methods *Rule_HTTP_MethodList `protobuf:"bytes,1,opt,name=methods,proto3,oneof"`
+}
+
+type Rule_HTTP_Path_ struct {
+	Path *Rule_HTTP_Path `protobuf:"bytes,2,opt,name=path,proto3,oneof"`
+}
+
+type Rule_HTTP_Headers struct {
+	Headers *Rule_HTTP_KeyValueList `protobuf:"bytes,3,opt,name=headers,proto3,oneof"`
+}
+
+type Rule_HTTP_QueryParam struct {
+	QueryParam *Rule_HTTP_KeyValueList `protobuf:"bytes,4,opt,name=query_param,json=queryParam,proto3,oneof"`
+}
+
+func (*Rule_HTTP_Methods) isRule_HTTP_Match() {}
+
+func (*Rule_HTTP_Path_) isRule_HTTP_Match() {}
+
+func (*Rule_HTTP_Headers) isRule_HTTP_Match() {}
+
+func (*Rule_HTTP_QueryParam) isRule_HTTP_Match() {}
+
+type Rule_TCP_PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *Rule_TCP_PortRange) Reset() {
+	*x = Rule_TCP_PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_TCP_PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_TCP_PortRange) ProtoMessage() {}
+
+func (x *Rule_TCP_PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_TCP_PortRange.ProtoReflect.Descriptor instead.
+func (*Rule_TCP_PortRange) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 1, 0}
+}
+
+func (x *Rule_TCP_PortRange) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Rule_TCP_PortRange) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type Rule_HTTP_MethodList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []Rule_HTTP_Method `protobuf:"varint,1,rep,packed,name=list,proto3,enum=Rule_HTTP_Method" json:"list,omitempty"`
+}
+
+func (x *Rule_HTTP_MethodList) Reset() {
+	*x = Rule_HTTP_MethodList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_HTTP_MethodList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_HTTP_MethodList) ProtoMessage() {}
+
+func (x *Rule_HTTP_MethodList) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_HTTP_MethodList.ProtoReflect.Descriptor instead.
+func (*Rule_HTTP_MethodList) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 0}
+}
+
+func (x *Rule_HTTP_MethodList) GetList() []Rule_HTTP_Method {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type Rule_HTTP_KeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Rule_HTTP_KeyValue_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Rule_HTTP_KeyValue_Type" json:"type,omitempty"`
+	Name  string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value string                  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Rule_HTTP_KeyValue) Reset() {
+	*x = Rule_HTTP_KeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_HTTP_KeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_HTTP_KeyValue) ProtoMessage() {}
+
+func (x *Rule_HTTP_KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_HTTP_KeyValue.ProtoReflect.Descriptor instead.
+func (*Rule_HTTP_KeyValue) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 1}
+}
+
+func (x *Rule_HTTP_KeyValue) GetType() Rule_HTTP_KeyValue_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Rule_HTTP_KeyValue_Exact
+}
+
+func (x *Rule_HTTP_KeyValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule_HTTP_KeyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Rule_HTTP_KeyValueList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Rule_HTTP_KeyValue `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *Rule_HTTP_KeyValueList) Reset() {
+	*x = Rule_HTTP_KeyValueList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_HTTP_KeyValueList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_HTTP_KeyValueList) ProtoMessage() {}
+
+func (x *Rule_HTTP_KeyValueList) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_HTTP_KeyValueList.ProtoReflect.Descriptor instead.
+func (*Rule_HTTP_KeyValueList) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 2}
+}
+
+func (x *Rule_HTTP_KeyValueList) GetList() []*Rule_HTTP_KeyValue {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type Rule_HTTP_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Rule_HTTP_Path_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Rule_HTTP_Path_Type" json:"type,omitempty"`
+	Value string              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Rule_HTTP_Path) Reset() {
+	*x = Rule_HTTP_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_HTTP_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_HTTP_Path) ProtoMessage() {}
+
+func (x *Rule_HTTP_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_HTTP_Path.ProtoReflect.Descriptor instead.
+func (*Rule_HTTP_Path) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{32, 2, 3}
+}
+
+func (x *Rule_HTTP_Path) GetType() Rule_HTTP_Path_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Rule_HTTP_Path_Exact
+}
+
+func (x *Rule_HTTP_Path) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// UserAgent details about the user agent sending the http request.
+type AccessEntry_UserAgent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Os        string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	OsVersion string `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	Device    string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	Mobile    bool   `protobuf:"varint,6,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Tablet    bool   `protobuf:"varint,7,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	Desktop   bool   `protobuf:"varint,8,opt,name=desktop,proto3" json:"desktop,omitempty"`
+	Bot       bool   `protobuf:"varint,9,opt,name=bot,proto3" json:"bot,omitempty"`
+}
+
+func (x *AccessEntry_UserAgent) Reset() {
+	*x = AccessEntry_UserAgent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_UserAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_UserAgent) ProtoMessage() {}
+
+func (x *AccessEntry_UserAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_UserAgent.ProtoReflect.Descriptor instead.
+func (*AccessEntry_UserAgent) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *AccessEntry_UserAgent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessEntry_UserAgent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AccessEntry_UserAgent) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AccessEntry_UserAgent) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *AccessEntry_UserAgent) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AccessEntry_UserAgent) GetMobile() bool {
+	if x != nil {
+		return x.Mobile
+	}
+	return false
+}
+
+func (x *AccessEntry_UserAgent) GetTablet() bool {
+	if x != nil {
+		return x.Tablet
+	}
+	return false
+}
+
+func (x *AccessEntry_UserAgent) GetDesktop() bool {
+	if x != nil {
+		return x.Desktop
+	}
+	return false
+}
+
+func (x *AccessEntry_UserAgent) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+type AccessEntry_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAgent *AccessEntry_UserAgent `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Method    string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Size      int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Path      string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *AccessEntry_Request) Reset() {
+	*x = AccessEntry_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_Request) ProtoMessage() {}
+
+func (x *AccessEntry_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_Request.ProtoReflect.Descriptor instead.
+func (*AccessEntry_Request) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *AccessEntry_Request) GetUserAgent() *AccessEntry_UserAgent {
+	if x != nil {
+		return x.UserAgent
+	}
+	return nil
+}
+
+func (x *AccessEntry_Request) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AccessEntry_Request) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AccessEntry_Request) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type AccessEntry_ReverseProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of bytes sent to the downstream service.
+	BytesSent     int64  `protobuf:"varint,1,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived int64  `protobuf:"varint,2,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Target        string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Number of requests sent to upstreams, more than one when the request was
+	// retried.
+	Attempts int32                            `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Failure  AccessEntry_ReverseProxy_Failure `protobuf:"varint,5,opt,name=failure,proto3,enum=AccessEntry_ReverseProxy_Failure" json:"failure,omitempty"`
+}
+
+func (x *AccessEntry_ReverseProxy) Reset() {
+	*x = AccessEntry_ReverseProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_ReverseProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_ReverseProxy) ProtoMessage() {}
+
+func (x *AccessEntry_ReverseProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_ReverseProxy.ProtoReflect.Descriptor instead.
+func (*AccessEntry_ReverseProxy) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 2}
+}
+
+func (x *AccessEntry_ReverseProxy) GetBytesSent() int64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *AccessEntry_ReverseProxy) GetBytesReceived() int64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *AccessEntry_ReverseProxy) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AccessEntry_ReverseProxy) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AccessEntry_ReverseProxy) GetFailure() AccessEntry_ReverseProxy_Failure {
+	if x != nil {
+		return x.Failure
+	}
+	return AccessEntry_ReverseProxy_NONE
+}
+
+type AccessEntry_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total size in bytes of the response.
+	Size              int64              `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	StatusCode        int32              `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	TimeToWriteHeader *duration.Duration `protobuf:"bytes,3,opt,name=time_to_write_header,json=timeToWriteHeader,proto3" json:"time_to_write_header,omitempty"`
+	// The encoding the response was compressed with by the proxy.
+	ContentEncoding string `protobuf:"bytes,4,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	// Size in bytes of the response before it was compressed, only set for
+	// responses compressed by the proxy.
+	UncompressedSize int64 `protobuf:"varint,5,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+}
+
+func (x *AccessEntry_Response) Reset() {
+	*x = AccessEntry_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_Response) ProtoMessage() {}
+
+func (x *AccessEntry_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_Response.ProtoReflect.Descriptor instead.
+func (*AccessEntry_Response) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 3}
+}
+
+func (x *AccessEntry_Response) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AccessEntry_Response) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *AccessEntry_Response) GetTimeToWriteHeader() *duration.Duration {
+	if x != nil {
+		return x.TimeToWriteHeader
+	}
+	return nil
+}
+
+func (x *AccessEntry_Response) GetContentEncoding() string {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return ""
+}
+
+func (x *AccessEntry_Response) GetUncompressedSize() int64 {
+	if x != nil {
+		return x.UncompressedSize
+	}
+	return 0
+}
+
+type AccessEntry_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the registered route.Do not confuse this with http route.
+	// This
+	// is configured route on tt
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// The service ID. A service is a collection of routes.
+	Service      string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	VirtualHosts []string `protobuf:"bytes,4,rep,name=virtual_hosts,json=virtualHosts,proto3" json:"virtual_hosts,omitempty"`
+}
+
+func (x *AccessEntry_Info) Reset() {
+	*x = AccessEntry_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_Info) ProtoMessage() {}
+
+func (x *AccessEntry_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_Info.ProtoReflect.Descriptor instead.
+func (*AccessEntry_Info) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 4}
+}
+
+func (x *AccessEntry_Info) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *AccessEntry_Info) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AccessEntry_Info) GetVirtualHosts() []string {
+	if x != nil {
+		return x.VirtualHosts
+	}
+	return nil
+}
+
+// Connection details about the downstream connection.
+type AccessEntry_Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the connection assigned by the TCP proxy. This is only set for
+	// connections passed from TCP routes.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The TLS server name the connection was routed with.
+	ServerName    string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	RemoteAddress string `protobuf:"bytes,3,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	LocalAddress  string `protobuf:"bytes,4,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	// Name of the TCP route that passed the connection.
+	Route string `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *AccessEntry_Connection) Reset() {
+	*x = AccessEntry_Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_Connection) ProtoMessage() {}
+
+func (x *AccessEntry_Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_Connection.ProtoReflect.Descriptor instead.
+func (*AccessEntry_Connection) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 5}
+}
+
+func (x *AccessEntry_Connection) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccessEntry_Connection) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *AccessEntry_Connection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *AccessEntry_Connection) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *AccessEntry_Connection) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+// Session details about a proxied TCP or UDP session. This is only set for
+// entries recorded by the L4 proxy.
+type AccessEntry_Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tcp or udp
+	Protocol             string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	UpstreamAddress      string `protobuf:"bytes,2,opt,name=upstream_address,json=upstreamAddress,proto3" json:"upstream_address,omitempty"`
+	UpstreamLocalAddress string `protobuf:"bytes,3,opt,name=upstream_local_address,json=upstreamLocalAddress,proto3" json:"upstream_local_address,omitempty"`
+	// Number of bytes received from the client.
+	BytesReceived int64 `protobuf:"varint,4,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	// Number of bytes sent to the client.
+	BytesSent int64 `protobuf:"varint,5,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// Number of bytes sent to the upstream.
+	UpstreamBytesSent int64 `protobuf:"varint,6,opt,name=upstream_bytes_sent,json=upstreamBytesSent,proto3" json:"upstream_bytes_sent,omitempty"`
+	// Number of bytes received from the upstream.
+	UpstreamBytesReceived int64                           `protobuf:"varint,7,opt,name=upstream_bytes_received,json=upstreamBytesReceived,proto3" json:"upstream_bytes_received,omitempty"`
+	Termination           AccessEntry_Session_Termination `protobuf:"varint,8,opt,name=termination,proto3,enum=AccessEntry_Session_Termination" json:"termination,omitempty"`
+	NoMatch               bool                            `protobuf:"varint,9,opt,name=no_match,json=noMatch,proto3" json:"no_match,omitempty"`
+	Acme                  bool                            `protobuf:"varint,10,opt,name=acme,proto3" json:"acme,omitempty"`
+	Fixed                 bool                            `protobuf:"varint,11,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	// JA3 fingerprint of the TLS ClientHello.
+	Ja3 string `protobuf:"bytes,12,opt,name=ja3,proto3" json:"ja3,omitempty"`
+	// JA4 fingerprint of the TLS ClientHello.
+	Ja4 string `protobuf:"bytes,13,opt,name=ja4,proto3" json:"ja4,omitempty"`
+}
+
+func (x *AccessEntry_Session) Reset() {
+	*x = AccessEntry_Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_Session) ProtoMessage() {}
+
+func (x *AccessEntry_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_Session.ProtoReflect.Descriptor instead.
+func (*AccessEntry_Session) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 6}
+}
+
+func (x *AccessEntry_Session) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *AccessEntry_Session) GetUpstreamAddress() string {
+	if x != nil {
+		return x.UpstreamAddress
+	}
+	return ""
+}
+
+func (x *AccessEntry_Session) GetUpstreamLocalAddress() string {
+	if x != nil {
+		return x.UpstreamLocalAddress
+	}
+	return ""
+}
+
+func (x *AccessEntry_Session) GetBytesReceived() int64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *AccessEntry_Session) GetBytesSent() int64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *AccessEntry_Session) GetUpstreamBytesSent() int64 {
+	if x != nil {
+		return x.UpstreamBytesSent
+	}
+	return 0
+}
+
+func (x *AccessEntry_Session) GetUpstreamBytesReceived() int64 {
+	if x != nil {
+		return x.UpstreamBytesReceived
+	}
+	return 0
+}
+
+func (x *AccessEntry_Session) GetTermination() AccessEntry_Session_Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return AccessEntry_Session_UNKNOWN
+}
+
+func (x *AccessEntry_Session) GetNoMatch() bool {
+	if x != nil {
+		return x.NoMatch
+	}
+	return false
+}
+
+func (x *AccessEntry_Session) GetAcme() bool {
+	if x != nil {
+		return x.Acme
+	}
+	return false
+}
+
+func (x *AccessEntry_Session) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+func (x *AccessEntry_Session) GetJa3() string {
+	if x != nil {
+		return x.Ja3
+	}
+	return ""
+}
+
+func (x *AccessEntry_Session) GetJa4() string {
+	if x != nil {
+		return x.Ja4
+	}
+	return ""
+}
+
+// WebSocket details about an upgraded connection. This is only set for
+// requests to WEBSOCKET routes that were switched to the websocket protocol.
+type AccessEntry_WebSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The subprotocol chosen by the upstream.
+	Subprotocol string `protobuf:"bytes,1,opt,name=subprotocol,proto3" json:"subprotocol,omitempty"`
+	// Number of messages received from the client.
+	MessagesReceived int64 `protobuf:"varint,2,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+	// Number of messages sent to the client.
+	MessagesSent int64 `protobuf:"varint,3,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	// Number of bytes of frames received from the client.
+	BytesReceived int64 `protobuf:"varint,4,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	// Number of bytes of frames sent to the client.
+	BytesSent int64 `protobuf:"varint,5,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// Status code of the first close frame, zero when the connection was closed
+	// without one.
+	CloseCode int32 `protobuf:"varint,6,opt,name=close_code,json=closeCode,proto3" json:"close_code,omitempty"`
+}
+
+func (x *AccessEntry_WebSocket) Reset() {
+	*x = AccessEntry_WebSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_WebSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_WebSocket) ProtoMessage() {}
+
+func (x *AccessEntry_WebSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_WebSocket.ProtoReflect.Descriptor instead.
+func (*AccessEntry_WebSocket) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 7}
+}
+
+func (x *AccessEntry_WebSocket) GetSubprotocol() string {
+	if x != nil {
+		return x.Subprotocol
+	}
+	return ""
+}
+
+func (x *AccessEntry_WebSocket) GetMessagesReceived() int64 {
+	if x != nil {
+		return x.MessagesReceived
+	}
+	return 0
+}
+
+func (x *AccessEntry_WebSocket) GetMessagesSent() int64 {
+	if x != nil {
+		return x.MessagesSent
+	}
+	return 0
+}
+
+func (x *AccessEntry_WebSocket) GetBytesReceived() int64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *AccessEntry_WebSocket) GetBytesSent() int64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *AccessEntry_WebSocket) GetCloseCode() int32 {
+	if x != nil {
+		return x.CloseCode
+	}
+	return 0
+}
+
+// GRPC details about a gRPC call. This is only set for requests to routes
+// with grpc enabled.
+type AccessEntry_GRPC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Method  string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// The grpc-status of the call.
+	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The grpc-message of the call.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// True when the call was translated from gRPC-Web.
+	Web bool `protobuf:"varint,5,opt,name=web,proto3" json:"web,omitempty"`
+}
+
+func (x *AccessEntry_GRPC) Reset() {
+	*x = AccessEntry_GRPC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tcp_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessEntry_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessEntry_GRPC) ProtoMessage() {}
+
+func (x *AccessEntry_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_tcp_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessEntry_GRPC.ProtoReflect.Descriptor instead.
+func (*AccessEntry_GRPC) Descriptor() ([]byte, []int) {
+	return file_tcp_proto_rawDescGZIP(), []int{33, 8}
+}
+
+func (x *AccessEntry_GRPC) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AccessEntry_GRPC) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AccessEntry_GRPC) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AccessEntry_GRPC) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AccessEntry_GRPC) GetWeb() bool {
+	if x != nil {
+		return x.Web
+	}
+	return false
+}
+
+var File_tcp_proto protoreflect.FileDescriptor
+
+var file_tcp_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x74, 0x63, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x22, 0x3a, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x99, 0x01, 0x0a,
+	0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x31, 0x0a, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x75, 0x66, 0x66, 0x72, 0x61,
+	0x67, 0x65, 0x22, 0x24, 0x0a, 0x08, 0x53, 0x75, 0x66, 0x66, 0x72, 0x61, 0x67, 0x65, 0x12, 0x09,
+	0x0a, 0x05, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54,
+	0x5f, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x01, 0x22, 0x0e, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x04, 0x52, 0x61, 0x66,
+	0x74, 0x1a, 0xf8, 0x01, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x52, 0x61, 0x66, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x52, 0x61, 0x66, 0x74, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a,
+	0x50, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x39, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x47,
+	0x45, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x03,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x1a, 0x3d, 0x0a, 0x03,
+	0x4c, 0x6f, 0x67, 0x12, 0x2d, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x2e, 0x4b, 0x65,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xcf, 0x01, 0x0a, 0x05,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x53, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x0d, 0x0a, 0x0b, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3e, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x38, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44,
+	0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x1a, 0x3f,
+	0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xda, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x32,
+	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x54,
+	0x74, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x54, 0x74, 0x6c, 0x22, 0x24, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4e,
+	0x53, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x52, 0x56, 0x10, 0x02, 0x22, 0x3d, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe6, 0x17, 0x0a, 0x0a,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x77, 0x61,
+	0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x77, 0x61,
+	0x73, 0x6d, 0x12, 0x49, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x70,
+	0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x74,
+	0x72, 0x69, 0x70, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x29, 0x0a,
+	0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x1a, 0x27, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0xc7, 0x06, 0x0a, 0x04,
+	0x57, 0x61, 0x73, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61,
+	0x73, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x1a, 0xc1, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a,
+	0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x2e, 0x57, 0x61, 0x73, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e,
+	0x76, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72,
+	0x65, 0x6f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x4e, 0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x52, 0x0e, 0x6d, 0x61, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x69,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74,
+	0x53, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x53, 0x74, 0x64,
+	0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x68, 0x65, 0x72, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x53, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x1a, 0x2d, 0x0a, 0x03, 0x45, 0x6e, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x42, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x1a, 0x6f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x61,
+	0x73, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x1a, 0x29, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x69, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x1a, 0xd5, 0x03, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x74, 0x6c, 0x12,
+	0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x54, 0x74, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42,
+	0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4f, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x6c, 0x65,
+	0x5f, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x57, 0x68, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x5f, 0x69, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x49, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x7d, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x1a, 0x8e, 0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2a, 0x0a,
+	0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x5a, 0x49,
+	0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x54, 0x4c, 0x49, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x02, 0x1a, 0xe0, 0x04, 0x0a, 0x07, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x52, 0x09, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x70, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68,
+	0x6f, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x1a, 0x32, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x2c, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x1a, 0xb4, 0x01, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x06,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x2c,
+	0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64, 0x64, 0x22, 0x2e, 0x0a, 0x09,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x45, 0x45,
+	0x50, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x1a, 0x89, 0x02, 0x0a,
+	0x07, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+	0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2e, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2f, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4d, 0x69,
+	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x1a, 0x2c, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x1a, 0x43, 0x0a, 0x05, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x56, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x22, 0x19, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x56,
+	0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x32, 0x10, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0xc3, 0x05, 0x0a, 0x04, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x2e, 0x55, 0x6e, 0x69, 0x78, 0x48, 0x00, 0x52, 0x04, 0x75,
+	0x6e, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x68,
+	0x74, 0x74, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x16, 0x0a,
+	0x03, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x54, 0x4c, 0x53,
+	0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a, 0x58, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x1a,
+	0xa2, 0x02, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x63, 0x70,
+	0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x3c, 0x0a,
+	0x0c, 0x74, 0x63, 0x70, 0x5f, 0x6e, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0a, 0x74, 0x63, 0x70, 0x4e, 0x6f, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x64,
+	0x65, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65,
+	0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x76, 0x36, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x70, 0x76, 0x36,
+	0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x04, 0x0a, 0x02, 0x74, 0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x03, 0x54,
+	0x4c, 0x53, 0x12, 0x34, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x6a, 0x0a, 0x0b,
+	0x48, 0x54, 0x54, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x68,
+	0x32, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x68, 0x32, 0x63, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x74, 0x74,
+	0x70, 0x32, 0x12, 0x24, 0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x32, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x22, 0xb4, 0x02, 0x0a, 0x0d, 0x48, 0x54, 0x54,
+	0x50, 0x32, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x3b, 0x0a, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x43, 0x0a,
+	0x1e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
+	0xb2, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x47, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x65, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x65, 0x6b, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x08, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x42,
+	0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67,
+	0x6f, 0x52, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c,
+	0x67, 0x6f, 0x22, 0x2c, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x02,
+	0x22, 0x90, 0x0a, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x62, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a,
+	0x09, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0b, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x52, 0x0f, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x70, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x6f, 0x70, 0x79, 0x12,
+	0x1c, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x69, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54,
+	0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0e, 0x74,
+	0x6c, 0x73, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x08, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x48, 0x54,
+	0x54, 0x50, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x09, 0x77, 0x65, 0x62, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x57, 0x65,
+	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x2f, 0x0a,
+	0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x40,
+	0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x51, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x6c, 0x67, 0x6f, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69,
+	0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x10, 0x02, 0x22, 0xe1, 0x03, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12,
+	0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x73,
+	0x73, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65,
+	0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x1a, 0x7c, 0x0a, 0x07, 0x50, 0x61, 0x73,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43,
+	0x12, 0x10, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77,
+	0x65, 0x62, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x3c, 0x0a,
+	0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x70,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x70,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x69,
+	0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xd4, 0x05, 0x0a, 0x0c, 0x48, 0x54,
+	0x54, 0x50, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x45,
+	0x0a, 0x11, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x51, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69,
+	0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x30,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55,
+	0x54, 0x4f, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x31, 0x10, 0x01, 0x12,
+	0x06, 0x0a, 0x02, 0x48, 0x32, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x32, 0x43, 0x10, 0x03,
+	0x22, 0x77, 0x0a, 0x08, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a,
+	0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x1d, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x50, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x53, 0x4e, 0x49, 0x10, 0x01, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x54, 0x4c,
+	0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x54, 0x4c,
+	0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x54, 0x4c, 0x53, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x1a, 0x2a,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x61, 0x33, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x61, 0x33, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x61, 0x34, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x61, 0x34, 0x1a, 0x36, 0x0a, 0x04, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x22, 0xce, 0x02, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x1a, 0x3e, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x1a, 0x52, 0x0a, 0x05, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68,
+	0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x43, 0x4b, 0x53, 0x35, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x90, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x33, 0x0a,
+	0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22,
+	0x87, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x12, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x73, 0x6e, 0x69, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xc4, 0x04, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x52,
+	0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x29, 0x0a, 0x08, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x52, 0x08, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x48, 0x0a, 0x04, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x1a, 0x73, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x52, 0x04, 0x73, 0x74, 0x61, 0x74, 0x1a, 0xc5, 0x01, 0x0a, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2e, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x73, 0x6e,
+	0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74,
+	0x22, 0xad, 0x08, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x03, 0x61, 0x6e, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x19, 0x0a, 0x03, 0x6e, 0x6f, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x03, 0x6e, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x54, 0x43, 0x50, 0x48, 0x00, 0x52, 0x03,
+	0x74, 0x63, 0x70, 0x12, 0x20, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x23, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x96, 0x01, 0x0a, 0x03, 0x54,
+	0x43, 0x50, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x54,
+	0x43, 0x50, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x6e, 0x69, 0x1a, 0x2f, 0x0a, 0x09, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x1a, 0xc1, 0x05, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x31, 0x0a, 0x07,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x8c, 0x01, 0x0a,
+	0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x48,
+	0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x28, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x78, 0x61,
+	0x63, 0x74, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x1a, 0x37, 0x0a, 0x0c, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x1a, 0x7c, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x78, 0x61, 0x63, 0x74, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x10, 0x02, 0x22, 0x6a, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12,
+	0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41,
+	0x44, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x08, 0x42, 0x07,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0xde, 0x15, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x77, 0x65,
+	0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x57, 0x65, 0x62, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x25, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x47, 0x52, 0x50,
+	0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0xdc, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x73, 0x6b, 0x74, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x73,
+	0x6b, 0x74, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x1a, 0x80, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0xca, 0x02, 0x0a, 0x0c, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x22, 0x82, 0x01, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x48, 0x45, 0x41,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x1a, 0xe3, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x75, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x5b, 0x0a, 0x04,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x1a, 0x8a, 0x05, 0x0a, 0x07,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x42, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x61, 0x63, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6a,
+	0x61, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x61, 0x33, 0x12, 0x10, 0x0a,
+	0x03, 0x6a, 0x61, 0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x61, 0x34, 0x22,
+	0xa6, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x44, 0x4f, 0x57, 0x4e, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x1a, 0xe4, 0x01, 0x0a, 0x09, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0x7c, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x77,
+	0x65, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x65, 0x62, 0x22, 0x52, 0x0a,
+	0x0b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x49, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x48, 0x49, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41,
+	0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x59, 0x50, 0x41, 0x53, 0x53, 0x10,
+	0x05, 0x2a, 0x3f, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a,
+	0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x45, 0x42,
+	0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x51, 0x55, 0x49, 0x43,
+	0x10, 0x04, 0x32, 0xcb, 0x01, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x07, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x03,
+	0x50, 0x75, 0x74, 0x12, 0x07, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x09, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x07, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x0e, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x12, 0x0d, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x4a,
+	0x6f, 0x69, 0x6e, 0x12, 0x0c, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0d, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x64, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x53,
+	0x65, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x11, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x67, 0x69, 0x72, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x72, 0x6e, 0x65, 0x73, 0x74, 0x2f, 0x74, 0x74, 0x2f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tcp_proto_rawDescOnce sync.Once
+	file_tcp_proto_rawDescData = file_tcp_proto_rawDesc
+)
+
+func file_tcp_proto_rawDescGZIP() []byte {
+	file_tcp_proto_rawDescOnce.Do(func() {
+		file_tcp_proto_rawDescData = protoimpl.X.CompressGZIP(file_tcp_proto_rawDescData)
+	})
+	return file_tcp_proto_rawDescData
+}
+
+var file_tcp_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_tcp_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_tcp_proto_goTypes = []interface{}{
+	(Protocol)(0),                                // 0: Protocol
+	(JoinRequest_Suffrage)(0),                    // 1: JoinRequest.Suffrage
+	(Raft_KeyValue_Action)(0),                    // 2: Raft.KeyValue.Action
+	(Discovery_Type)(0),                          // 3: Discovery.Type
+	(Middleware_Version)(0),                      // 4: Middleware.Version
+	(Middleware_Compress_Encoding)(0),            // 5: Middleware.Compress.Encoding
+	(Middleware_Headers_Forwarded)(0),            // 6: Middleware.Headers.Forwarded
+	(Fallback_Action)(0),                         // 7: Fallback.Action
+	(Route_LoadBalanceAlgo)(0),                   // 8: Route.LoadBalanceAlgo
+	(HTTPUpstream_Protocol)(0),                   // 9: HTTPUpstream.Protocol
+	(Affinity_Key)(0),                            // 10: Affinity.Key
+	(ForwardProxy_Mode)(0),                       // 11: ForwardProxy.Mode
+	(Rule_HTTP_Method)(0),                        // 12: Rule.HTTP.Method
+	(Rule_HTTP_KeyValue_Type)(0),                 // 13: Rule.HTTP.KeyValue.Type
+	(Rule_HTTP_Path_Type)(0),                     // 14: Rule.HTTP.Path.Type
+	(AccessEntry_CacheStatus)(0),                 // 15: AccessEntry.CacheStatus
+	(AccessEntry_ReverseProxy_Failure)(0),        // 16: AccessEntry.ReverseProxy.Failure
+	(AccessEntry_Session_Termination)(0),         // 17: AccessEntry.Session.Termination
+	(*ConfigRequest)(nil),                        // 18: ConfigRequest
+	(*DeleteRequest)(nil),                        // 19: DeleteRequest
+	(*PurgeRequest)(nil),                         // 20: PurgeRequest
+	(*JoinRequest)(nil),                          // 21: JoinRequest
+	(*JoinResponse)(nil),                         // 22: JoinResponse
+	(*Raft)(nil),                                 // 23: Raft
+	(*Store)(nil),                                // 24: Store
+	(*Response)(nil),                             // 25: Response
+	(*Config)(nil),                               // 26: Config
+	(*WeightedAddr)(nil),                         // 27: WeightedAddr
+	(*Discovery)(nil),                            // 28: Discovery
+	(*Address)(nil),                              // 29: Address
+	(*Middleware)(nil),                           // 30: Middleware
+	(*Bind)(nil),                                 // 31: Bind
+	(*TLS)(nil),                                  // 32: TLS
+	(*HTTPOptions)(nil),                          // 33: HTTPOptions
+	(*HTTP2Settings)(nil),                        // 34: HTTP2Settings
+	(*MatchOptions)(nil),                         // 35: MatchOptions
+	(*Fallback)(nil),                             // 36: Fallback
+	(*Route)(nil),                                // 37: Route
+	(*HealthCheck)(nil),                          // 38: HealthCheck
+	(*GRPC)(nil),                                 // 39: GRPC
+	(*WebSocket)(nil),                            // 40: WebSocket
+	(*HTTPUpstream)(nil),                         // 41: HTTPUpstream
+	(*Affinity)(nil),                             // 42: Affinity
+	(*TLSFingerprint)(nil),                       // 43: TLSFingerprint
+	(*ForwardProxy)(nil),                         // 44: ForwardProxy
+	(*Speed)(nil),                                // 45: Speed
+	(*Retries)(nil),                              // 46: Retries
+	(*RetryBudget)(nil),                          // 47: RetryBudget
+	(*RequestMatch)(nil),                         // 48: RequestMatch
+	(*Context)(nil),                              // 49: Context
+	(*Rule)(nil),                                 // 50: Rule
+	(*AccessEntry)(nil),                          // 51: AccessEntry
+	(*Raft_KeyValue)(nil),                        // 52: Raft.KeyValue
+	(*Raft_Log)(nil),                             // 53: Raft.Log
+	(*Raft_KeyValue_Context)(nil),                // 54: Raft.KeyValue.Context
+	(*Store_SetRequest)(nil),                     // 55: Store.SetRequest
+	(*Store_SetResponse)(nil),                    // 56: Store.SetResponse
+	(*Store_GetRequest)(nil),                     // 57: Store.GetRequest
+	(*Store_GetResponse)(nil),                    // 58: Store.GetResponse
+	nil,                                          // 59: WeightedAddr.MetricLabelsEntry
+	(*Middleware_List)(nil),                      // 60: Middleware.List
+	(*Middleware_Wasm)(nil),                      // 61: Middleware.Wasm
+	(*Middleware_StripPathPrefix)(nil),           // 62: Middleware.StripPathPrefix
+	(*Middleware_Cache)(nil),                     // 63: Middleware.Cache
+	(*Middleware_Compress)(nil),                  // 64: Middleware.Compress
+	(*Middleware_Headers)(nil),                   // 65: Middleware.Headers
+	(*Middleware_Rewrite)(nil),                   // 66: Middleware.Rewrite
+	(*Middleware_Redirect)(nil),                  // 67: Middleware.Redirect
+	(*Middleware_Wasm_Setting)(nil),              // 68: Middleware.Wasm.Setting
+	(*Middleware_Wasm_Config)(nil),               // 69: Middleware.Wasm.Config
+	(*Middleware_Wasm_Setting_Env)(nil),          // 70: Middleware.Wasm.Setting.Env
+	(*Middleware_Wasm_Setting_DirectoryMap)(nil), // 71: Middleware.Wasm.Setting.DirectoryMap
+	(*Middleware_Cache_Key)(nil),                 // 72: Middleware.Cache.Key
+	(*Middleware_Headers_Header)(nil),            // 73: Middleware.Headers.Header
+	(*Middleware_Headers_Rename)(nil),            // 74: Middleware.Headers.Rename
+	(*Middleware_Headers_Operations)(nil),        // 75: Middleware.Headers.Operations
+	(*Middleware_Rewrite_Prefix)(nil),            // 76: Middleware.Rewrite.Prefix
+	(*Middleware_Rewrite_Regex)(nil),             // 77: Middleware.Rewrite.Regex
+	(*Bind_Unix)(nil),                            // 78: Bind.Unix
+	(*Bind_ListenOptions)(nil),                   // 79: Bind.ListenOptions
+	(*TLS_Certificate)(nil),                      // 80: TLS.Certificate
+	nil,                                          // 81: Route.MetricsLabelsEntry
+	(*HealthCheck_Passive)(nil),                  // 82: HealthCheck.Passive
+	(*TLSFingerprint_List)(nil),                  // 83: TLSFingerprint.List
+	(*TLSFingerprint_Rate)(nil),                  // 84: TLSFingerprint.Rate
+	(*ForwardProxy_User)(nil),                    // 85: ForwardProxy.User
+	(*ForwardProxy_Allow)(nil),                   // 86: ForwardProxy.Allow
+	(*Context_Stat)(nil),                         // 87: Context.Stat
+	(*Context_Conn)(nil),                         // 88: Context.Conn
+	(*Context_Info)(nil),                         // 89: Context.Info
+	(*Rule_List)(nil),                            // 90: Rule.List
+	(*Rule_TCP)(nil),                             // 91: Rule.TCP
+	(*Rule_HTTP)(nil),                            // 92: Rule.HTTP
+	(*Rule_TCP_PortRange)(nil),                   // 93: Rule.TCP.PortRange
+	(*Rule_HTTP_MethodList)(nil),                 // 94: Rule.HTTP.MethodList
+	(*Rule_HTTP_KeyValue)(nil),                   // 95: Rule.HTTP.KeyValue
+	(*Rule_HTTP_KeyValueList)(nil),               // 96: Rule.HTTP.KeyValueList
+	(*Rule_HTTP_Path)(nil),                       // 97: Rule.HTTP.Path
+	(*AccessEntry_UserAgent)(nil),                // 98: AccessEntry.UserAgent
+	(*AccessEntry_Request)(nil),                  // 99: AccessEntry.Request
+	(*AccessEntry_ReverseProxy)(nil),             // 100: AccessEntry.ReverseProxy
+	(*AccessEntry_Response)(nil),                 // 101: AccessEntry.Response
+	(*AccessEntry_Info)(nil),                     // 102: AccessEntry.Info
+	(*AccessEntry_Connection)(nil),               // 103: AccessEntry.Connection
+	(*AccessEntry_Session)(nil),                  // 104: AccessEntry.Session
+	(*AccessEntry_WebSocket)(nil),                // 105: AccessEntry.WebSocket
+	(*AccessEntry_GRPC)(nil),                     // 106: AccessEntry.GRPC
+	(*duration.Duration)(nil),                    // 107: google.protobuf.Duration
+	(*empty.Empty)(nil),                          // 108: google.protobuf.Empty
+	(*_struct.Struct)(nil),                       // 109: google.protobuf.Struct
+	(*wrappers.BoolValue)(nil),                   // 110: google.protobuf.BoolValue
+	(*wrappers.StringValue)(nil),                 // 111: google.protobuf.StringValue
+}
+var file_tcp_proto_depIdxs = []int32{
+	1,   // 0: JoinRequest.suffrage:type_name -> JoinRequest.Suffrage
+	37,  // 1: Config.routes:type_name -> Route
+	29,  // 2: WeightedAddr.addr:type_name -> Address
+	59,  // 3: WeightedAddr.metric_labels:type_name -> WeightedAddr.MetricLabelsEntry
+	28,  // 4: WeightedAddr.discovery:type_name -> Discovery
+	3,   // 5: Discovery.type:type_name -> Discovery.Type
+	107, // 6: Discovery.min_ttl:type_name -> google.protobuf.Duration
+	107, // 7: Discovery.max_ttl:type_name -> google.protobuf.Duration
+	61,  // 8: Middleware.wasm:type_name -> Middleware.Wasm
+	62,  // 9: Middleware.strip_path_prefix:type_name -> Middleware.StripPathPrefix
+	63,  // 10: Middleware.cache:type_name -> Middleware.Cache
+	64,  // 11: Middleware.compress:type_name -> Middleware.Compress
+	65,  // 12: Middleware.headers:type_name -> Middleware.Headers
+	66,  // 13: Middleware.rewrite:type_name -> Middleware.Rewrite
+	67,  // 14: Middleware.redirect:type_name -> Middleware.Redirect
+	78,  // 15: Bind.unix:type_name -> Bind.Unix
+	79,  // 16: Bind.options:type_name -> Bind.ListenOptions
+	36,  // 17: Bind.fallback:type_name -> Fallback
+	35,  // 18: Bind.match_options:type_name -> MatchOptions
+	33,  // 19: Bind.http:type_name -> HTTPOptions
+	32,  // 20: Bind.tls:type_name -> TLS
+	80,  // 21: TLS.certificates:type_name -> TLS.Certificate
+	34,  // 22: HTTPOptions.http2:type_name -> HTTP2Settings
+	107, // 23: HTTP2Settings.idle_timeout:type_name -> google.protobuf.Duration
+	107, // 24: MatchOptions.first_byte_timeout:type_name -> google.protobuf.Duration
+	107, // 25: MatchOptions.timeout:type_name -> google.protobuf.Duration
+	7,   // 26: Fallback.action:type_name -> Fallback.Action
+	27,  // 27: Fallback.load_balance:type_name -> WeightedAddr
+	8,   // 28: Fallback.load_balance_algo:type_name -> Route.LoadBalanceAlgo
+	31,  // 29: Route.bind:type_name -> Bind
+	48,  // 30: Route.condition:type_name -> RequestMatch
+	81,  // 31: Route.metrics_labels:type_name -> Route.MetricsLabelsEntry
+	46,  // 32: Route.retries:type_name -> Retries
+	107, // 33: Route.timeout:type_name -> google.protobuf.Duration
+	107, // 34: Route.keepAlive:type_name -> google.protobuf.Duration
+	27,  // 35: Route.load_balance:type_name -> WeightedAddr
+	8,   // 36: Route.load_balance_algo:type_name -> Route.LoadBalanceAlgo
+	45,  // 37: Route.speed:type_name -> Speed
+	50,  // 38: Route.rule:type_name -> Rule
+	60,  // 39: Route.middlewares:type_name -> Middleware.List
+	0,   // 40: Route.protocol:type_name -> Protocol
+	44,  // 41: Route.forward_proxy:type_name -> ForwardProxy
+	43,  // 42: Route.tls_fingerprint:type_name -> TLSFingerprint
+	42,  // 43: Route.affinity:type_name -> Affinity
+	41,  // 44: Route.http_upstream:type_name -> HTTPUpstream
+	40,  // 45: Route.websocket:type_name -> WebSocket
+	39,  // 46: Route.grpc:type_name -> GRPC
+	38,  // 47: Route.health_check:type_name -> HealthCheck
+	107, // 48: HealthCheck.interval:type_name -> google.protobuf.Duration
+	107, // 49: HealthCheck.timeout:type_name -> google.protobuf.Duration
+	82,  // 50: HealthCheck.passive:type_name -> HealthCheck.Passive
+	107, // 51: GRPC.default_timeout:type_name -> google.protobuf.Duration
+	107, // 52: GRPC.max_timeout:type_name -> google.protobuf.Duration
+	107, // 53: WebSocket.idle_timeout:type_name -> google.protobuf.Duration
+	107, // 54: WebSocket.ping_interval:type_name -> google.protobuf.Duration
+	107, // 55: WebSocket.ping_timeout:type_name -> google.protobuf.Duration
+	9,   // 56: HTTPUpstream.protocol:type_name -> HTTPUpstream.Protocol
+	107, // 57: HTTPUpstream.read_idle_timeout:type_name -> google.protobuf.Duration
+	107, // 58: HTTPUpstream.ping_timeout:type_name -> google.protobuf.Duration
+	107, // 59: HTTPUpstream.response_header_timeout:type_name -> google.protobuf.Duration
+	107, // 60: HTTPUpstream.request_timeout:type_name -> google.protobuf.Duration
+	107, // 61: HTTPUpstream.idle_conn_timeout:type_name -> google.protobuf.Duration
+	10,  // 62: Affinity.key:type_name -> Affinity.Key
+	107, // 63: Affinity.ttl:type_name -> google.protobuf.Duration
+	83,  // 64: TLSFingerprint.match:type_name -> TLSFingerprint.List
+	83,  // 65: TLSFingerprint.block:type_name -> TLSFingerprint.List
+	84,  // 66: TLSFingerprint.rate:type_name -> TLSFingerprint.Rate
+	11,  // 67: ForwardProxy.mode:type_name -> ForwardProxy.Mode
+	85,  // 68: ForwardProxy.users:type_name -> ForwardProxy.User
+	86,  // 69: ForwardProxy.allow:type_name -> ForwardProxy.Allow
+	47,  // 70: Retries.budget:type_name -> RetryBudget
+	107, // 71: RetryBudget.ttl:type_name -> google.protobuf.Duration
+	108, // 72: RequestMatch.fixed:type_name -> google.protobuf.Empty
+	0,   // 73: Context.protocol:type_name -> Protocol
+	88,  // 74: Context.downstream:type_name -> Context.Conn
+	88,  // 75: Context.upstream:type_name -> Context.Conn
+	89,  // 76: Context.info:type_name -> Context.Info
+	90,  // 77: Rule.all:type_name -> Rule.List
+	90,  // 78: Rule.any:type_name -> Rule.List
+	50,  // 79: Rule.not:type_name -> Rule
+	91,  // 80: Rule.tcp:type_name -> Rule.TCP
+	92,  // 81: Rule.http:type_name -> Rule.HTTP
+	99,  // 82: AccessEntry.request:type_name -> AccessEntry.Request
+	101, // 83: AccessEntry.response:type_name -> AccessEntry.Response
+	100, // 84: AccessEntry.reverse_proxy:type_name -> AccessEntry.ReverseProxy
+	102, // 85: AccessEntry.info:type_name -> AccessEntry.Info
+	107, // 86: AccessEntry.duration:type_name -> google.protobuf.Duration
+	103, // 87: AccessEntry.connection:type_name -> AccessEntry.Connection
+	104, // 88: AccessEntry.session:type_name -> AccessEntry.Session
+	105, // 89: AccessEntry.websocket:type_name -> AccessEntry.WebSocket
+	106, // 90: AccessEntry.grpc:type_name -> AccessEntry.GRPC
+	15,  // 91: AccessEntry.cache:type_name -> AccessEntry.CacheStatus
+	2,   // 92: Raft.KeyValue.action:type_name -> Raft.KeyValue.Action
+	54,  // 93: Raft.KeyValue.context:type_name -> Raft.KeyValue.Context
+	52,  // 94: Raft.Log.key_value:type_name -> Raft.KeyValue
+	30,  // 95: Middleware.List.list:type_name -> Middleware
+	69,  // 96: Middleware.Wasm.config:type_name -> Middleware.Wasm.Config
+	4,   // 97: Middleware.Wasm.version:type_name -> Middleware.Version
+	72,  // 98: Middleware.Cache.key:type_name -> Middleware.Cache.Key
+	107, // 99: Middleware.Cache.default_ttl:type_name -> google.protobuf.Duration
+	107, // 100: Middleware.Cache.max_ttl:type_name -> google.protobuf.Duration
+	107, // 101: Middleware.Cache.stale_while_revalidate:type_name -> google.protobuf.Duration
+	107, // 102: Middleware.Cache.stale_if_error:type_name -> google.protobuf.Duration
+	5,   // 103: Middleware.Compress.encodings:type_name -> Middleware.Compress.Encoding
+	75,  // 104: Middleware.Headers.request:type_name -> Middleware.Headers.Operations
+	75,  // 105: Middleware.Headers.response:type_name -> Middleware.Headers.Operations
+	6,   // 106: Middleware.Headers.forwarded:type_name -> Middleware.Headers.Forwarded
+	76,  // 107: Middleware.Rewrite.prefix:type_name -> Middleware.Rewrite.Prefix
+	77,  // 108: Middleware.Rewrite.regex:type_name -> Middleware.Rewrite.Regex
+	70,  // 109: Middleware.Wasm.Setting.environments:type_name -> Middleware.Wasm.Setting.Env
+	71,  // 110: Middleware.Wasm.Setting.map_directories:type_name -> Middleware.Wasm.Setting.DirectoryMap
+	68,  // 111: Middleware.Wasm.Config.instance:type_name -> Middleware.Wasm.Setting
+	109, // 112: Middleware.Wasm.Config.plugin:type_name -> google.protobuf.Struct
+	74,  // 113: Middleware.Headers.Operations.rename:type_name -> Middleware.Headers.Rename
+	73,  // 114: Middleware.Headers.Operations.set:type_name -> Middleware.Headers.Header
+	73,  // 115: Middleware.Headers.Operations.add:type_name -> Middleware.Headers.Header
+	110, // 116: Bind.ListenOptions.tcp_no_delay:type_name -> google.protobuf.BoolValue
+	107, // 117: Bind.ListenOptions.defer_accept:type_name -> google.protobuf.Duration
+	107, // 118: HealthCheck.Passive.ejection_time:type_name -> google.protobuf.Duration
+	87,  // 119: Context.Conn.stat:type_name -> Context.Stat
+	111, // 120: Context.Info.sni:type_name -> google.protobuf.StringValue
+	111, // 121: Context.Info.path:type_name -> google.protobuf.StringValue
+	50,  // 122: Rule.List.rules:type_name -> Rule
+	93,  // 123: Rule.TCP.ports:type_name -> Rule.TCP.PortRange
+	94,  // 124: Rule.HTTP.methods:type_name -> Rule.HTTP.MethodList
+	97,  // 125: Rule.HTTP.path:type_name -> Rule.HTTP.Path
+	96,  // 126: Rule.HTTP.headers:type_name -> Rule.HTTP.KeyValueList
+	96,  // 127: Rule.HTTP.query_param:type_name -> Rule.HTTP.KeyValueList
+	12,  // 128: Rule.HTTP.MethodList.list:type_name -> Rule.HTTP.Method
+	13,  // 129: Rule.HTTP.KeyValue.type:type_name -> Rule.HTTP.KeyValue.Type
+	95,  // 130: Rule.HTTP.KeyValueList.list:type_name -> Rule.HTTP.KeyValue
+	14,  // 131: Rule.HTTP.Path.type:type_name -> Rule.HTTP.Path.Type
+	98,  // 132: AccessEntry.Request.user_agent:type_name -> AccessEntry.UserAgent
+	16,  // 133: AccessEntry.ReverseProxy.failure:type_name -> AccessEntry.ReverseProxy.Failure
+	107, // 134: AccessEntry.Response.time_to_write_header:type_name -> google.protobuf.Duration
+	17,  // 135: AccessEntry.Session.termination:type_name -> AccessEntry.Session.Termination
+	18,  // 136: Proxy.Get:input_type -> ConfigRequest
+	26,  // 137: Proxy.Put:input_type -> Config
+	26,  // 138: Proxy.Post:input_type -> Config
+	19,  // 139: Proxy.Delete:input_type -> DeleteRequest
+	20,  // 140: Proxy.Purge:input_type -> PurgeRequest
+	21,  // 141: Proxy.Join:input_type -> JoinRequest
+	55,  // 142: Storage.Set:input_type -> Store.SetRequest
+	57,  // 143: Storage.Get:input_type -> Store.GetRequest
+	26,  // 144: Proxy.Get:output_type -> Config
+	25,  // 145: Proxy.Put:output_type -> Response
+	25,  // 146: Proxy.Post:output_type -> Response
+	25,  // 147: Proxy.Delete:output_type -> Response
+	25,  // 148: Proxy.Purge:output_type -> Response
+	22,  // 149: Proxy.Join:output_type -> JoinResponse
+	55,  // 150: Storage.Set:output_type -> Store.SetRequest
+	58,  // 151: Storage.Get:output_type -> Store.GetResponse
+	144, // [144:152] is the sub-list for method output_type
+	136, // [136:144] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
+}
+
+func init() { file_tcp_proto_init() }
+func file_tcp_proto_init() {
+	if File_tcp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tcp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Raft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Store); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightedAddr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discovery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTP2Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GRPC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebSocket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPUpstream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Affinity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSFingerprint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Speed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Context); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4445,8 +9300,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRequest); i {
+		file_tcp_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4457,8 +9312,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
+		file_tcp_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Raft_KeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4469,8 +9324,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
+		file_tcp_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Raft_Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4481,8 +9336,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Raft); i {
+		file_tcp_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Raft_KeyValue_Context); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4493,8 +9348,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Store); i {
+		file_tcp_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Store_SetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4505,8 +9360,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_tcp_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Store_SetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4517,8 +9372,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_tcp_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Store_GetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4529,8 +9384,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeightedAddr); i {
+		file_tcp_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Store_GetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4541,8 +9396,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+		file_tcp_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4553,8 +9408,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware); i {
+		file_tcp_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Wasm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4565,8 +9420,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bind); i {
+		file_tcp_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_StripPathPrefix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4577,8 +9432,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Route); i {
+		file_tcp_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Cache); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4589,8 +9444,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Speed); i {
+		file_tcp_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Compress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4601,8 +9456,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Retries); i {
+		file_tcp_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Headers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4613,8 +9468,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryBudget); i {
+		file_tcp_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Rewrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4625,8 +9480,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestMatch); i {
+		file_tcp_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Redirect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4637,8 +9492,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Context); i {
+		file_tcp_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Wasm_Setting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4649,8 +9504,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rule); i {
+		file_tcp_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Wasm_Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4661,8 +9516,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessEntry); i {
+		file_tcp_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Wasm_Setting_Env); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4673,8 +9528,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Raft_KeyValue); i {
+		file_tcp_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Wasm_Setting_DirectoryMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4685,8 +9540,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Raft_Log); i {
+		file_tcp_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Cache_Key); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4697,8 +9552,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Raft_KeyValue_Context); i {
+		file_tcp_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Headers_Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4709,8 +9564,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Store_SetRequest); i {
+		file_tcp_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Headers_Rename); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4721,8 +9576,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Store_SetResponse); i {
+		file_tcp_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Headers_Operations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4733,8 +9588,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Store_GetRequest); i {
+		file_tcp_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Rewrite_Prefix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4745,8 +9600,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Store_GetResponse); i {
+		file_tcp_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Middleware_Rewrite_Regex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4757,8 +9612,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_List); i {
+		file_tcp_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bind_Unix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4769,8 +9624,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_Wasm); i {
+		file_tcp_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bind_ListenOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4781,8 +9636,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_StripPathPrefix); i {
+		file_tcp_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLS_Certificate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4793,8 +9648,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_Wasm_Setting); i {
+		file_tcp_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck_Passive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4805,8 +9660,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_Wasm_Config); i {
+		file_tcp_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSFingerprint_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4817,8 +9672,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_Wasm_Setting_Env); i {
+		file_tcp_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSFingerprint_Rate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4829,8 +9684,8 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware_Wasm_Setting_DirectoryMap); i {
+		file_tcp_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardProxy_User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4841,7 +9696,19 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardProxy_Allow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context_Stat); i {
 			case 0:
 				return &v.state
@@ -4853,7 +9720,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context_Conn); i {
 			case 0:
 				return &v.state
@@ -4865,7 +9732,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Context_Info); i {
 			case 0:
 				return &v.state
@@ -4877,7 +9744,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_List); i {
 			case 0:
 				return &v.state
@@ -4889,7 +9756,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_TCP); i {
 			case 0:
 				return &v.state
@@ -4901,7 +9768,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_HTTP); i {
 			case 0:
 				return &v.state
@@ -4913,7 +9780,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_TCP_PortRange); i {
 			case 0:
 				return &v.state
@@ -4925,7 +9792,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_HTTP_MethodList); i {
 			case 0:
 				return &v.state
@@ -4937,7 +9804,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_HTTP_KeyValue); i {
 			case 0:
 				return &v.state
@@ -4949,7 +9816,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_HTTP_KeyValueList); i {
 			case 0:
 				return &v.state
@@ -4961,7 +9828,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rule_HTTP_Path); i {
 			case 0:
 				return &v.state
@@ -4973,7 +9840,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessEntry_UserAgent); i {
 			case 0:
 				return &v.state
@@ -4985,7 +9852,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessEntry_Request); i {
 			case 0:
 				return &v.state
@@ -4997,7 +9864,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessEntry_ReverseProxy); i {
 			case 0:
 				return &v.state
@@ -5009,7 +9876,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessEntry_Response); i {
 			case 0:
 				return &v.state
@@ -5021,7 +9888,7 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
-		file_tcp_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_tcp_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccessEntry_Info); i {
 			case 0:
 				return &v.state
@@ -5033,41 +9900,96 @@ func file_tcp_proto_init() {
 				return nil
 			}
 		}
+		file_tcp_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry_Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry_Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry_WebSocket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tcp_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessEntry_GRPC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_tcp_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*Response_Ok)(nil),
 		(*Response_Error)(nil),
 	}
-	file_tcp_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*Middleware_Wasm_)(nil),
 		(*Middleware_StripPathPrefix_)(nil),
+		(*Middleware_Cache_)(nil),
+		(*Middleware_Compress_)(nil),
+		(*Middleware_Headers_)(nil),
+		(*Middleware_Rewrite_)(nil),
+		(*Middleware_Redirect_)(nil),
 	}
-	file_tcp_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*Bind_Port)(nil),
 		(*Bind_HostPort)(nil),
+		(*Bind_Unix_)(nil),
+		(*Bind_Internal)(nil),
 	}
-	file_tcp_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*RequestMatch_Sni)(nil),
 		(*RequestMatch_Host)(nil),
 		(*RequestMatch_Path)(nil),
 		(*RequestMatch_Fixed)(nil),
 	}
-	file_tcp_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*Rule_All)(nil),
 		(*Rule_Any)(nil),
 		(*Rule_Not)(nil),
 		(*Rule_Tcp)(nil),
 		(*Rule_Http)(nil),
 	}
-	file_tcp_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*Raft_Log_KeyValue)(nil),
 	}
-	file_tcp_proto_msgTypes[40].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[73].OneofWrappers = []interface{}{
 		(*Rule_TCP_Port)(nil),
 		(*Rule_TCP_Ports)(nil),
 		(*Rule_TCP_Sni)(nil),
 	}
-	file_tcp_proto_msgTypes[41].OneofWrappers = []interface{}{
+	file_tcp_proto_msgTypes[74].OneofWrappers = []interface{}{
 		(*Rule_HTTP_Methods)(nil),
 		(*Rule_HTTP_Path_)(nil),
 		(*Rule_HTTP_Headers)(nil),
@@ -5078,8 +10000,8 @@ func file_tcp_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tcp_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   52,
+			NumEnums:      18,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
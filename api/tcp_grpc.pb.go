@@ -22,6 +22,7 @@ type ProxyClient interface {
 	Put(ctx context.Context, in *Config, opts ...grpc.CallOption) (*Response, error)
 	Post(ctx context.Context, in *Config, opts ...grpc.CallOption) (*Response, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Response, error)
+	Purge(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*Response, error)
 	// raft
 	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
 }
@@ -70,6 +71,15 @@ func (c *proxyClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grp
 	return out, nil
 }
 
+func (c *proxyClient) Purge(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/Proxy/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *proxyClient) Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
 	out := new(JoinResponse)
 	err := c.cc.Invoke(ctx, "/Proxy/Join", in, out, opts...)
@@ -87,6 +97,7 @@ type ProxyServer interface {
 	Put(context.Context, *Config) (*Response, error)
 	Post(context.Context, *Config) (*Response, error)
 	Delete(context.Context, *DeleteRequest) (*Response, error)
+	Purge(context.Context, *PurgeRequest) (*Response, error)
 	// raft
 	Join(context.Context, *JoinRequest) (*JoinResponse, error)
 	mustEmbedUnimplementedProxyServer()
@@ -108,6 +119,9 @@ func (UnimplementedProxyServer) Post(context.Context, *Config) (*Response, error
 func (UnimplementedProxyServer) Delete(context.Context, *DeleteRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedProxyServer) Purge(context.Context, *PurgeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 func (UnimplementedProxyServer) Join(context.Context, *JoinRequest) (*JoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
 }
@@ -196,6 +210,24 @@ func _Proxy_Delete_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Proxy/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).Purge(ctx, req.(*PurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Proxy_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinRequest)
 	if err := dec(in); err != nil {
@@ -237,6 +269,10 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Proxy_Delete_Handler,
 		},
+		{
+			MethodName: "Purge",
+			Handler:    _Proxy_Purge_Handler,
+		},
 		{
 			MethodName: "Join",
 			Handler:    _Proxy_Join_Handler,
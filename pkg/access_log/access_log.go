@@ -2,6 +2,7 @@ package accesslog
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
 	"github.com/gernest/tt/pkg/zlg"
 	"github.com/golang/protobuf/ptypes"
 	ua "github.com/mileusna/useragent"
@@ -25,6 +27,10 @@ var pool = &sync.Pool{
 				Response:     &api.AccessEntry_Response{},
 				ReverseProxy: &api.AccessEntry_ReverseProxy{},
 				Info:         &api.AccessEntry_Info{},
+				Connection:   &api.AccessEntry_Connection{},
+				Session:      &api.AccessEntry_Session{},
+				Websocket:    &api.AccessEntry_WebSocket{},
+				Grpc:         &api.AccessEntry_GRPC{},
 			},
 		}
 	},
@@ -48,7 +54,61 @@ func (e *Entry) reset() {
 	e.resetRequest()
 	e.resetResponse()
 	e.resetReverseProxy()
+	e.resetConnection()
+	e.resetSession()
+	e.resetWebSocket()
+	e.resetGRPC()
 	e.Duration = nil
+	e.Cache = api.AccessEntry_NONE
+}
+
+func (e *Entry) resetSession() {
+	if s := e.GetSession(); s != nil {
+		s.Protocol = ""
+		s.UpstreamAddress = ""
+		s.UpstreamLocalAddress = ""
+		s.BytesReceived = 0
+		s.BytesSent = 0
+		s.UpstreamBytesSent = 0
+		s.UpstreamBytesReceived = 0
+		s.Termination = api.AccessEntry_Session_UNKNOWN
+		s.NoMatch = false
+		s.Acme = false
+		s.Fixed = false
+		s.Ja3 = ""
+		s.Ja4 = ""
+	}
+}
+
+func (e *Entry) resetWebSocket() {
+	if w := e.GetWebsocket(); w != nil {
+		w.Subprotocol = ""
+		w.MessagesReceived = 0
+		w.MessagesSent = 0
+		w.BytesReceived = 0
+		w.BytesSent = 0
+		w.CloseCode = 0
+	}
+}
+
+func (e *Entry) resetGRPC() {
+	if g := e.GetGrpc(); g != nil {
+		g.Service = ""
+		g.Method = ""
+		g.Status = 0
+		g.Message = ""
+		g.Web = false
+	}
+}
+
+func (e *Entry) resetConnection() {
+	if c := e.GetConnection(); c != nil {
+		c.Id = 0
+		c.ServerName = ""
+		c.RemoteAddress = ""
+		c.LocalAddress = ""
+		c.Route = ""
+	}
 }
 func (e *Entry) resetInfo() {
 	e.Info.Route = ""
@@ -79,6 +139,8 @@ func (e *Entry) resetResponse() {
 		r.Size = 0
 		r.StatusCode = 0
 		r.TimeToWriteHeader = nil
+		r.ContentEncoding = ""
+		r.UncompressedSize = 0
 	}
 }
 
@@ -87,6 +149,8 @@ func (e *Entry) resetReverseProxy() {
 		r.BytesSent = 0
 		r.BytesReceived = 0
 		r.Target = ""
+		r.Attempts = 0
+		r.Failure = api.AccessEntry_ReverseProxy_NONE
 	}
 }
 
@@ -110,6 +174,17 @@ func (e *Entry) Update(
 	e.Request.UserAgent.Desktop = agent.Desktop
 	e.Request.UserAgent.Bot = agent.Bot
 
+	// connection
+	e.Connection.RemoteAddress = r.RemoteAddr
+	if a, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr); ok {
+		e.Connection.LocalAddress = a.String()
+	}
+	if m, ok := tcp.LookupContextMeta(r.Context()); ok {
+		e.Connection.Id = m.ID.Load()
+		e.Connection.ServerName = m.ServerName.Load()
+		e.Connection.Route = m.RouteName.Load()
+	}
+
 	//request
 	e.Request.Method = sanitizeMethod(r.Method)
 	e.Request.Path = sanitizeMethod(r.URL.Path)
@@ -123,6 +198,50 @@ func (e *Entry) Update(
 	e.Duration = ptypes.DurationProto(duration)
 }
 
+// IsSession returns true if e was recorded for a TCP or UDP session instead of
+// a HTTP request.
+func (e *Entry) IsSession() bool {
+	return e.GetSession().GetProtocol() != ""
+}
+
+// IsWebSocket returns true if the request of e was switched to the websocket
+// protocol.
+func (e *Entry) IsWebSocket() bool {
+	return e.GetResponse().GetStatusCode() == http.StatusSwitchingProtocols
+}
+
+// IsGRPC returns true if the request of e was proxied as a gRPC call.
+func (e *Entry) IsGRPC() bool {
+	return e.GetGrpc().GetService() != ""
+}
+
+// UpdateConn sets connection and session details of a TCP or UDP session from
+// m. It must be called after m is completed.
+func (e *Entry) UpdateConn(m *tcp.ContextMeta) {
+	e.Connection.Id = m.ID.Load()
+	e.Connection.ServerName = m.ServerName.Load()
+	e.Connection.RemoteAddress = m.D.A.R.Address
+	e.Connection.LocalAddress = m.D.A.L.Address
+	e.Connection.Route = m.RouteName.Load()
+
+	e.Session.Protocol = m.GetProtocol().String()
+	e.Session.UpstreamAddress = m.U.A.R.Address
+	e.Session.UpstreamLocalAddress = m.U.A.L.Address
+	e.Session.BytesReceived = m.D.R.Load()
+	e.Session.BytesSent = m.D.W.Load()
+	e.Session.UpstreamBytesSent = m.U.W.Load()
+	e.Session.UpstreamBytesReceived = m.U.R.Load()
+	e.Session.Termination = api.AccessEntry_Session_Termination(m.Termination.Load())
+	e.Session.NoMatch = m.NoMatch.Load()
+	e.Session.Acme = m.ACME.Load()
+	e.Session.Fixed = m.Fixed.Load()
+	e.Session.Ja3 = m.JA3.Load()
+	e.Session.Ja4 = m.JA4.Load()
+
+	e.Info.Route = m.RouteName.Load()
+	e.Duration = ptypes.DurationProto(m.End.Sub(m.Start))
+}
+
 func sanitizeMethod(m string) string {
 	switch m {
 	case "GET", "get":
@@ -303,6 +422,12 @@ func Get(ctx context.Context) Recorder {
 	return oblivion
 }
 
+// Lookup returns the access log in ctx and true if ctx has one.
+func Lookup(ctx context.Context) (*Access, bool) {
+	a, ok := ctx.Value(accessLogKey{}).(*Access)
+	return a, ok
+}
+
 func Set(ctx context.Context, a *Access) context.Context {
 	return context.WithValue(ctx, accessLogKey{}, a)
 }
@@ -21,10 +21,15 @@ func Instrument(next http.Handler) http.HandlerFunc {
 		})
 		next.ServeHTTP(d, r)
 		end := time.Since(now)
+		code := d.Status()
+		if entry.IsWebSocket() {
+			// the websocket proxy wrote the response on the hijacked connection
+			code = http.StatusSwitchingProtocols
+		}
 		entry.Update(
-			r, d.Status(), d.Written(), end, timeTOWriteHeader,
+			r, code, d.Written(), end, timeTOWriteHeader,
 		)
-		tseries.Record(d.Status(), r.Method, end, entry.Response.Size,
+		tseries.Record(code, r.Method, end, entry.Response.Size,
 			d.Written(),
 			timeTOWriteHeader,
 		)
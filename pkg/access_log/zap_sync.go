@@ -1,6 +1,9 @@
 package accesslog
 
-import "go.uber.org/zap"
+import (
+	"github.com/gernest/tt/api"
+	"go.uber.org/zap"
+)
 
 var _ Sync = (*Zap)(nil)
 
@@ -12,15 +15,85 @@ func (Zap) sync() {}
 
 func (z *Zap) Sync(e *Entry) {
 	defer e.Release()
+	if e.IsSession() {
+		z.Logger.Debug(e.Session.Protocol, e.sessionFields()...)
+		return
+	}
 	z.Logger.Debug(e.Request.Path, e.fields()...)
 }
 
+func (e *Entry) sessionFields() []zap.Field {
+	s := e.Session
+	return []zap.Field{
+		zap.Int64("conn_id", e.Connection.Id),
+		zap.String("remote_address", e.Connection.RemoteAddress),
+		zap.String("local_address", e.Connection.LocalAddress),
+		zap.String("upstream_address", s.UpstreamAddress),
+		zap.String("server_name", e.Connection.ServerName),
+		zap.String("tcp_route", e.Connection.Route),
+		zap.Int64("bytes_received", s.BytesReceived),
+		zap.Int64("bytes_sent", s.BytesSent),
+		zap.Int64("upstream_bytes_sent", s.UpstreamBytesSent),
+		zap.Int64("upstream_bytes_received", s.UpstreamBytesReceived),
+		zap.Duration("duration", e.Duration.AsDuration()),
+		zap.String("termination", s.Termination.String()),
+		zap.Bool("no_match", s.NoMatch),
+		zap.String("ja3", s.Ja3),
+		zap.String("ja4", s.Ja4),
+	}
+}
+
 func (e *Entry) fields() (ls []zap.Field) {
 	ls = append(ls,
 		zap.Int32("status", e.Response.StatusCode),
 		zap.String("route", e.Info.Route),
 		zap.String("service", e.Info.Service),
 		zap.Strings("host", e.Info.VirtualHosts),
+		zap.String("remote_address", e.Connection.RemoteAddress),
 	)
+	if e.Connection.Id != 0 {
+		ls = append(ls,
+			zap.Int64("conn_id", e.Connection.Id),
+			zap.String("server_name", e.Connection.ServerName),
+			zap.String("tcp_route", e.Connection.Route),
+		)
+	}
+	if f := e.ReverseProxy.GetFailure(); f != api.AccessEntry_ReverseProxy_NONE {
+		ls = append(ls, zap.String("upstream_failure", f.String()))
+	}
+	if a := e.ReverseProxy.GetAttempts(); a > 1 {
+		ls = append(ls, zap.Int32("attempts", a))
+	}
+	if c := e.Response.GetContentEncoding(); c != "" {
+		ls = append(ls,
+			zap.String("content_encoding", c),
+			zap.Int64("uncompressed_size", e.Response.UncompressedSize),
+		)
+	}
+	if e.Cache != api.AccessEntry_NONE {
+		ls = append(ls, zap.String("cache", e.Cache.String()))
+	}
+	if e.IsWebSocket() {
+		w := e.Websocket
+		ls = append(ls,
+			zap.String("subprotocol", w.Subprotocol),
+			zap.Int64("messages_received", w.MessagesReceived),
+			zap.Int64("messages_sent", w.MessagesSent),
+			zap.Int64("bytes_received", w.BytesReceived),
+			zap.Int64("bytes_sent", w.BytesSent),
+			zap.Int32("close_code", w.CloseCode),
+			zap.Duration("duration", e.Duration.AsDuration()),
+		)
+	}
+	if e.IsGRPC() {
+		g := e.Grpc
+		ls = append(ls,
+			zap.String("grpc_service", g.Service),
+			zap.String("grpc_method", g.Method),
+			zap.Int32("grpc_status", g.Status),
+			zap.String("grpc_message", g.Message),
+			zap.Bool("grpc_web", g.Web),
+		)
+	}
 	return
 }
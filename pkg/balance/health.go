@@ -0,0 +1,411 @@
+package balance
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/discovery"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	upstreamHealthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tt",
+			Name:      "upstream_healthy",
+			Help:      "Whether a load_balance target of a route is healthy",
+		},
+		[]string{"route", "target"},
+	)
+	totalHealthChecks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "health_checks_total",
+			Help:      "Total number of active health checks of load_balance targets",
+		},
+		[]string{"route", "target", "result"},
+	)
+	totalEjections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "upstream_ejections_total",
+			Help:      "Total number of load_balance targets ejected for failing requests",
+		},
+		[]string{"route", "target"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(upstreamHealthy, totalHealthChecks, totalEjections)
+}
+
+const (
+	defaultCheckInterval       = 10 * time.Second
+	defaultCheckTimeout        = 2 * time.Second
+	defaultHealthyThreshold    = 2
+	defaultUnhealthyThreshold  = 3
+	defaultConsecutiveFailures = 5
+	defaultEjectionTime        = 30 * time.Second
+	maxCheckBody               = 64 << 10
+)
+
+// Reporter is implemented by balancers that eject targets failing requests.
+type Reporter interface {
+	// Report reports the result of a request sent to u.
+	Report(u *url.URL, ok bool)
+}
+
+// TargetStatus is the health of a load_balance target.
+type TargetStatus struct {
+	URL *url.URL
+	// Healthy is false when the target failed active health checks.
+	Healthy bool
+	// Ejected is true when the target failed too many requests in a row.
+	Ejected bool
+}
+
+// Checked balances over targets that pass health checks. Targets failing
+// active checks, run with Run, or failing requests, reported with Report, are
+// not picked until they recover. All targets are picked when none is healthy.
+type Checked struct {
+	route string
+	algo  api.Route_LoadBalanceAlgo
+	src   source
+
+	path               *url.URL
+	status             map[int]bool
+	body               *regexp.Regexp
+	interval           time.Duration
+	healthyThreshold   int
+	unhealthyThreshold int
+	failures           int
+	ejectionTime       time.Duration
+	client             *http.Client
+	now                func() time.Time
+
+	mu    sync.Mutex
+	hosts map[string]*health
+	// changed is true when health of a target changed since ba was built.
+	changed bool
+	version uint64
+	// expiry is when the earliest ejection of a target ends.
+	expiry time.Time
+	ba     Balance
+}
+
+// health is the health of a target. Targets are healthy until checks fail.
+type health struct {
+	unhealthy bool
+	// consecutive results of active checks.
+	successes, failures int
+	// consecutive failed requests.
+	failedRequests int
+	ejectedUntil   time.Time
+}
+
+func (h *health) up(now time.Time) bool {
+	return !h.unhealthy && !now.Before(h.ejectedUntil)
+}
+
+// NewChecked returns a balancer over the load_balance targets of route using
+// the health check of route.
+func NewChecked(route *api.Route) (*Checked, error) {
+	o := route.HealthCheck
+	c := &Checked{
+		route:              route.Name,
+		algo:               route.LoadBalanceAlgo,
+		interval:           defaultCheckInterval,
+		healthyThreshold:   defaultHealthyThreshold,
+		unhealthyThreshold: defaultUnhealthyThreshold,
+		failures:           defaultConsecutiveFailures,
+		ejectionTime:       defaultEjectionTime,
+		client: &http.Client{
+			Timeout: defaultCheckTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now:   time.Now,
+		hosts: make(map[string]*health),
+	}
+	if discovery.Dynamic(route.LoadBalance...) {
+		d, err := newDynamic(route.LoadBalanceAlgo, route.LoadBalance...)
+		if err != nil {
+			return nil, err
+		}
+		c.src = d
+	} else {
+		s, err := newStatic(route.LoadBalance...)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := staticBalance(c.algo, s.ts, s.weights); err != nil {
+			return nil, err
+		}
+		c.src = s
+	}
+	if o.Path != "" {
+		u, err := url.Parse(o.Path)
+		if err != nil {
+			return nil, err
+		}
+		c.path = u
+	}
+	if len(o.ExpectedStatus) > 0 {
+		c.status = make(map[int]bool)
+		for _, v := range o.ExpectedStatus {
+			c.status[int(v)] = true
+		}
+	}
+	if o.BodyRegex != "" {
+		re, err := regexp.Compile(o.BodyRegex)
+		if err != nil {
+			return nil, err
+		}
+		c.body = re
+	}
+	if d, _ := ptypes.Duration(o.Interval); d > 0 {
+		c.interval = d
+	}
+	if d, _ := ptypes.Duration(o.Timeout); d > 0 {
+		c.client.Timeout = d
+	}
+	if o.HealthyThreshold > 0 {
+		c.healthyThreshold = int(o.HealthyThreshold)
+	}
+	if o.UnhealthyThreshold > 0 {
+		c.unhealthyThreshold = int(o.UnhealthyThreshold)
+	}
+	if n := o.GetPassive().GetConsecutiveFailures(); n > 0 {
+		c.failures = int(n)
+	}
+	if d, _ := ptypes.Duration(o.GetPassive().GetEjectionTime()); d > 0 {
+		c.ejectionTime = d
+	}
+	return c, nil
+}
+
+// Next returns nil when there are no targets.
+func (c *Checked) Next() *Target {
+	ts, weights, version := c.src.targets()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if c.ba == nil || c.changed || version != c.version ||
+		(!c.expiry.IsZero() && !now.Before(c.expiry)) {
+		c.build(ts, weights, now)
+		c.version = version
+	}
+	return c.ba.Next()
+}
+
+// build rebuilds the balancer over healthy targets of ts.
+func (c *Checked) build(ts []*Target, weights []int, now time.Time) {
+	var up []*Target
+	var upWeights []int
+	c.expiry = time.Time{}
+	seen := make(map[string]bool)
+	for i, t := range ts {
+		seen[t.URL.Host] = true
+		h := c.host(t.URL.Host)
+		if h.up(now) {
+			up = append(up, t)
+			upWeights = append(upWeights, weights[i])
+			upstreamHealthy.WithLabelValues(c.route, t.URL.Host).Set(1)
+			continue
+		}
+		upstreamHealthy.WithLabelValues(c.route, t.URL.Host).Set(0)
+		if now.Before(h.ejectedUntil) && (c.expiry.IsZero() || h.ejectedUntil.Before(c.expiry)) {
+			c.expiry = h.ejectedUntil
+		}
+	}
+	for k := range c.hosts {
+		if !seen[k] {
+			// the target was removed
+			delete(c.hosts, k)
+			upstreamHealthy.DeleteLabelValues(c.route, k)
+		}
+	}
+	if len(up) == 0 {
+		up, upWeights = ts, weights
+	}
+	c.ba, _ = staticBalance(c.algo, up, upWeights)
+	c.changed = false
+}
+
+func (c *Checked) host(h string) *health {
+	x, ok := c.hosts[h]
+	if !ok {
+		x = &health{}
+		c.hosts[h] = x
+	}
+	return x
+}
+
+// Inherit copies health of targets from old, a balancer of the same route
+// from a previous configuration, so that targets that failed checks or were
+// ejected stay out of rotation after a reload. Targets are matched by host.
+func (c *Checked) Inherit(old *Checked) {
+	old.mu.Lock()
+	hosts := make(map[string]health, len(old.hosts))
+	for k, v := range old.hosts {
+		hosts[k] = *v
+	}
+	old.mu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, v := range hosts {
+		x := v
+		c.hosts[k] = &x
+	}
+	c.changed = true
+}
+
+// Report ejects the target u when it failed too many requests in a row.
+func (c *Checked) Report(u *url.URL, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h := c.host(u.Host)
+	if ok {
+		h.failedRequests = 0
+		return
+	}
+	h.failedRequests++
+	now := c.now()
+	if h.failedRequests < c.failures || now.Before(h.ejectedUntil) {
+		return
+	}
+	h.failedRequests = 0
+	h.ejectedUntil = now.Add(c.ejectionTime)
+	c.changed = true
+	totalEjections.WithLabelValues(c.route, u.Host).Inc()
+}
+
+// Status returns the health of all targets.
+func (c *Checked) Status() []TargetStatus {
+	ts, _, _ := c.src.targets()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	o := make([]TargetStatus, len(ts))
+	for i, t := range ts {
+		h := c.host(t.URL.Host)
+		o[i] = TargetStatus{
+			URL:     t.URL,
+			Healthy: !h.unhealthy,
+			Ejected: now.Before(h.ejectedUntil),
+		}
+	}
+	return o
+}
+
+// Run checks all targets every interval until ctx is done. Discovered targets
+// are resolved again when their TTL expires. It returns immediately when the
+// health check has no path and targets are static.
+func (c *Checked) Run(ctx context.Context) {
+	if d, ok := c.src.(*dynamic); ok {
+		if c.path == nil {
+			d.Run(ctx)
+			return
+		}
+		go d.Run(ctx)
+	}
+	if c.path == nil {
+		return
+	}
+	tick := time.NewTicker(c.interval)
+	defer tick.Stop()
+	for {
+		c.checkAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+func (c *Checked) checkAll(ctx context.Context) {
+	ts, _, _ := c.src.targets()
+	var wg sync.WaitGroup
+	for _, t := range ts {
+		wg.Add(1)
+		go func(u *url.URL) {
+			defer wg.Done()
+			ok := c.check(ctx, u)
+			if ctx.Err() != nil {
+				return
+			}
+			c.setHealthy(u.Host, ok)
+		}(t.URL)
+	}
+	wg.Wait()
+}
+
+// check returns true when the target u passes the health check.
+func (c *Checked) check(ctx context.Context, u *url.URL) bool {
+	x := *u
+	x.Path = c.path.Path
+	x.RawPath = c.path.RawPath
+	x.RawQuery = c.path.RawQuery
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, x.String(), nil)
+	if err != nil {
+		return false
+	}
+	res, err := c.client.Do(r)
+	if err != nil {
+		return false
+	}
+	defer res.Body.Close()
+	if c.status != nil {
+		if !c.status[res.StatusCode] {
+			return false
+		}
+	} else if res.StatusCode < 200 || res.StatusCode >= 400 {
+		return false
+	}
+	if c.body == nil {
+		io.Copy(ioutil.Discard, io.LimitReader(res.Body, maxCheckBody))
+		return true
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(res.Body, maxCheckBody))
+	if err != nil {
+		return false
+	}
+	return c.body.Match(b)
+}
+
+// setHealthy records the result of an active check of target host.
+func (c *Checked) setHealthy(host string, ok bool) {
+	result := "pass"
+	if !ok {
+		result = "fail"
+	}
+	totalHealthChecks.WithLabelValues(c.route, host, result).Inc()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h := c.host(host)
+	if ok {
+		h.failures = 0
+		h.successes++
+		if h.unhealthy && h.successes >= c.healthyThreshold {
+			h.unhealthy = false
+			c.changed = true
+		}
+		return
+	}
+	h.successes = 0
+	h.failures++
+	if !h.unhealthy && h.failures >= c.unhealthyThreshold {
+		h.unhealthy = true
+		c.changed = true
+	}
+}
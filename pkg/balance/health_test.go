@@ -0,0 +1,124 @@
+package balance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+)
+
+func checkedRoute(t *testing.T, o *api.HealthCheck, targets ...string) *Checked {
+	t.Helper()
+	r := &api.Route{Name: "health", HealthCheck: o}
+	for _, v := range targets {
+		r.LoadBalance = append(r.LoadBalance, &api.WeightedAddr{
+			Addr:   &api.Address{Address: v},
+			Weight: 1,
+		})
+	}
+	c, err := NewChecked(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// picked returns hosts picked by n calls to c.Next.
+func picked(c *Checked, n int) map[string]int {
+	m := make(map[string]int)
+	for i := 0; i < n; i++ {
+		m[c.Next().URL.Host]++
+	}
+	return m
+}
+
+func TestCheckedActive(t *testing.T) {
+	var healthy atomic.Bool
+	healthy.Store(true)
+	a := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if healthy.Load() {
+			w.Write([]byte("ok"))
+			return
+		}
+		w.Write([]byte("starting"))
+	}))
+	defer a.Close()
+	b := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer b.Close()
+	c := checkedRoute(t, &api.HealthCheck{
+		Path:               "/healthz",
+		BodyRegex:          "^ok$",
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 2,
+	}, a.URL, b.URL)
+	ctx := context.Background()
+	hostA := a.Listener.Addr().String()
+
+	healthy.Store(false)
+	c.checkAll(ctx)
+	if m := picked(c, 4); m[hostA] == 0 {
+		t.Fatal("expected targets to be healthy until the threshold")
+	}
+	c.checkAll(ctx)
+	if m := picked(c, 4); m[hostA] != 0 {
+		t.Fatalf("expected unhealthy target to be skipped got %v", m)
+	}
+	for _, s := range c.Status() {
+		if s.URL.Host == hostA && s.Healthy {
+			t.Error("expected status to be unhealthy")
+		}
+	}
+
+	healthy.Store(true)
+	c.checkAll(ctx)
+	if m := picked(c, 4); m[hostA] != 0 {
+		t.Fatal("expected target to be unhealthy until the threshold")
+	}
+	c.checkAll(ctx)
+	if m := picked(c, 4); m[hostA] == 0 {
+		t.Fatalf("expected target to be healthy again got %v", m)
+	}
+}
+
+func TestCheckedPassive(t *testing.T) {
+	now := time.Unix(100, 0)
+	c := checkedRoute(t, &api.HealthCheck{
+		Passive: &api.HealthCheck_Passive{ConsecutiveFailures: 2},
+	}, "http://a", "http://b")
+	c.now = func() time.Time { return now }
+	a := &url.URL{Host: "a"}
+	c.Report(a, false)
+	c.Report(a, true)
+	c.Report(a, false)
+	if m := picked(c, 4); m["a"] == 0 {
+		t.Fatal("expected target to be picked until failures in a row reach the threshold")
+	}
+	c.Report(a, false)
+	if m := picked(c, 4); m["a"] != 0 {
+		t.Fatalf("expected ejected target to be skipped got %v", m)
+	}
+	// all targets are used when none is healthy.
+	b := &url.URL{Host: "b"}
+	c.Report(b, false)
+	c.Report(b, false)
+	if m := picked(c, 4); m["a"] == 0 || m["b"] == 0 {
+		t.Errorf("expected all targets to be used got %v", m)
+	}
+	c.Report(b, true)
+	now = now.Add(defaultEjectionTime)
+	c.Report(b, false)
+	if m := picked(c, 4); m["a"] == 0 {
+		t.Errorf("expected target to return after ejection time got %v", m)
+	}
+}
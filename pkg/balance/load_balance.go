@@ -1,10 +1,13 @@
 package balance
 
 import (
+	"context"
 	"errors"
 	"net/url"
+	"sync"
 
 	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/discovery"
 	"github.com/smallnest/weighted"
 )
 
@@ -32,58 +35,155 @@ func target(w *api.WeightedAddr) (*Target, error) {
 	return &Target{URL: u}, nil
 }
 
+// FromRoute returns the balancer of the load_balance targets of r. Targets are
+// health checked when r has a health check.
 func FromRoute(r *api.Route) (Balance, error) {
+	if r.HealthCheck != nil {
+		return NewChecked(r)
+	}
 	return FromWeightedAddr(r.LoadBalanceAlgo, r.LoadBalance...)
 }
 
 func FromWeightedAddr(algo api.Route_LoadBalanceAlgo, targets ...*api.WeightedAddr) (Balance, error) {
+	if discovery.Dynamic(targets...) {
+		return newDynamic(algo, targets...)
+	}
+	s, err := newStatic(targets...)
+	if err != nil {
+		return nil, err
+	}
+	return staticBalance(algo, s.ts, s.weights)
+}
+
+// staticBalance returns a balancer over targets that never change.
+func staticBalance(algo api.Route_LoadBalanceAlgo, targets []*Target, weights []int) (Balance, error) {
 	if len(targets) == 1 {
-		t, err := target(targets[0])
-		if err != nil {
-			return nil, err
-		}
+		t := targets[0]
 		return balanceFn(func() *Target {
 			return t
 		}), nil
 	}
+	return weightedBalance(algo, targets, weights)
+}
+
+func weightedBalance(algo api.Route_LoadBalanceAlgo, targets []*Target, weights []int) (Balance, error) {
+	var w weighted.W
 	switch algo {
 	case api.Route_RoundRobinWeighted:
-		w := &weighted.RRW{}
-		for _, v := range targets {
-			t, err := target(v)
-			if err != nil {
-				return nil, err
-			}
-			w.Add(t, int(v.Weight))
-		}
-		return balanceFn(func() *Target {
-			return w.Next().(*Target)
-		}), nil
+		w = &weighted.RRW{}
 	case api.Route_SmoothWeighted:
-		w := &weighted.SW{}
-		for _, v := range targets {
-			t, err := target(v)
-			if err != nil {
-				return nil, err
-			}
-			w.Add(t, int(v.Weight))
+		w = &weighted.SW{}
+	case api.Route_RandomWeighted:
+		w = &weighted.RandW{}
+	default:
+		return nil, ErrConfigNotFound
+	}
+	for i, t := range targets {
+		w.Add(t, weights[i])
+	}
+	return balanceFn(func() *Target {
+		if t, ok := w.Next().(*Target); ok {
+			return t
 		}
-		return balanceFn(func() *Target {
-			return w.Next().(*Target)
-		}), nil
+		return nil
+	}), nil
+}
 
-	case api.Route_RandomWeighted:
-		w := &weighted.RandW{}
-		for _, v := range targets {
-			t, err := target(v)
-			if err != nil {
-				return nil, err
-			}
-			w.Add(t, int(v.Weight))
+// source returns targets to balance over with their weights. version changes
+// when targets change.
+type source interface {
+	targets() (ts []*Target, weights []int, version uint64)
+}
+
+// static targets never change.
+type static struct {
+	ts      []*Target
+	weights []int
+}
+
+func (s *static) targets() ([]*Target, []int, uint64) {
+	return s.ts, s.weights, 0
+}
+
+func newStatic(targets ...*api.WeightedAddr) (*static, error) {
+	s := &static{
+		ts:      make([]*Target, len(targets)),
+		weights: make([]int, len(targets)),
+	}
+	for i, v := range targets {
+		t, err := target(v)
+		if err != nil {
+			return nil, err
 		}
-		return balanceFn(func() *Target {
-			return w.Next().(*Target)
-		}), nil
+		s.ts[i] = t
+		s.weights[i] = int(v.Weight)
 	}
-	return nil, ErrConfigNotFound
+	return s, nil
+}
+
+// dynamic balances over endpoints discovered with DNS. The balancer is rebuilt
+// when endpoints change.
+type dynamic struct {
+	algo api.Route_LoadBalanceAlgo
+	set  *discovery.Set
+	urls map[*api.WeightedAddr]*url.URL
+
+	mu      sync.Mutex
+	version uint64
+	ts      []*Target
+	weights []int
+	ba      Balance
+}
+
+func newDynamic(algo api.Route_LoadBalanceAlgo, targets ...*api.WeightedAddr) (*dynamic, error) {
+	d := &dynamic{
+		algo: algo,
+		urls: make(map[*api.WeightedAddr]*url.URL),
+	}
+	for _, v := range targets {
+		t, err := target(v)
+		if err != nil {
+			return nil, err
+		}
+		d.urls[v] = t.URL
+	}
+	if _, err := weightedBalance(algo, nil, nil); err != nil {
+		return nil, err
+	}
+	d.set = discovery.NewSet(func(a *api.WeightedAddr) string {
+		return d.urls[a].Host
+	}, targets...)
+	return d, nil
+}
+
+func (d *dynamic) targets() ([]*Target, []int, uint64) {
+	eps, version := d.set.Endpoints(context.Background())
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.ba == nil || version != d.version {
+		d.ts = make([]*Target, len(eps))
+		d.weights = make([]int, len(eps))
+		for i, e := range eps {
+			u := *d.urls[e.Addr]
+			u.Host = e.Address
+			d.ts[i] = &Target{URL: &u}
+			d.weights[i] = e.Weight
+		}
+		d.ba, _ = weightedBalance(d.algo, d.ts, d.weights)
+		d.version = version
+	}
+	return d.ts, d.weights, d.version
+}
+
+// Run resolves targets again when their TTL expires until ctx is done.
+func (d *dynamic) Run(ctx context.Context) {
+	d.set.Run(ctx)
+}
+
+// Next returns nil when no endpoints were discovered.
+func (d *dynamic) Next() *Target {
+	d.targets()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.ba.Next()
 }
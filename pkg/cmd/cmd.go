@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/gernest/tt/api"
+	accesslog "github.com/gernest/tt/pkg/access_log"
 	"github.com/gernest/tt/pkg/control/cluster"
 	proxyPkg "github.com/gernest/tt/pkg/proxy"
 	tcpProxy "github.com/gernest/tt/pkg/tcp/proxy"
+	"github.com/gernest/tt/pkg/upgrade"
 
 	"github.com/gernest/tt/pkg/xhttp"
 	"github.com/gernest/tt/pkg/zlg"
@@ -26,11 +29,13 @@ func App(version, commit, date, builtBy string) *cli.App {
 	a.Action = func(ctx *cli.Context) error {
 		return start(ctx, version, commit, date, builtBy)
 	}
+	a.Commands = []cli.Command{
+		upgradeCommand(version, commit, date, builtBy),
+	}
 	return a
 }
 
-// start starts the proxy service
-func start(ctx *cli.Context, version, commit, date, builtBy string) error {
+func options(ctx *cli.Context, version, commit, date, builtBy string) (*proxyPkg.Options, error) {
 	opts := &proxyPkg.Options{
 		Info: proxyPkg.Info{
 			Version:   version,
@@ -38,6 +43,15 @@ func start(ctx *cli.Context, version, commit, date, builtBy string) error {
 		},
 	}
 	if err := opts.Parse(ctx); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// start starts the proxy service
+func start(ctx *cli.Context, version, commit, date, builtBy string) error {
+	opts, err := options(ctx, version, commit, date, builtBy)
+	if err != nil {
 		return err
 	}
 	return StartWithContext(context.Background(), opts)
@@ -47,8 +61,10 @@ func start(ctx *cli.Context, version, commit, date, builtBy string) error {
 func StartWithContext(ctx context.Context, o *proxyPkg.Options) error {
 
 	// add health endpoint
+	var static []*api.Route
 	if !o.DisableHealthEndpoint {
-		o.Routes.Routes = append(o.Routes.Routes, xhttp.HealthEndpoint())
+		static = append(static, xhttp.HealthEndpoint())
+		o.Routes.Routes = append(o.Routes.Routes, static...)
 	}
 
 	zlg.Info("Setting up fsm for raft", zap.String("node-id", o.Info.ID))
@@ -57,7 +73,6 @@ func StartWithContext(ctx context.Context, o *proxyPkg.Options) error {
 		zlg.Logger.Error("Failed to create fms")
 		return err
 	}
-	defer fsm.Close()
 	zlg.Info("Initializing raft cluster", zap.String("node-id", o.Info.ID))
 	r, err := cluster.NewRaft(
 		o.Bootsrap,
@@ -67,14 +82,30 @@ func StartWithContext(ctx context.Context, o *proxyPkg.Options) error {
 	)
 	if err != nil {
 		zlg.Logger.Error("Failed to create raft cluster")
+		fsm.Close()
 		return err
 	}
+	// raft storage can only be opened by one process at a time. It is released
+	// early when handing over to a new process.
+	var releaseOnce sync.Once
+	release := func() {
+		releaseOnce.Do(func() {
+			if err := r.Close(); err != nil {
+				zlg.Error(err, "Failed to close raft")
+			}
+			if err := fsm.Close(); err != nil {
+				zlg.Error(err, "Failed to close fsm")
+			}
+		})
+	}
+	defer release()
+	o.Store = fsm
 	zlg.Info("Successful started raft", zap.String("leader", string(r.Leader())))
 
 	zlg.Info("setting up admin")
 
 	mgr := &ProxyManager{
-		Raft: r,
+		Raft: r.Raft,
 		Log:  zlg.Logger.Named("admin"),
 		Proxies: []proxyPkg.Proxy{
 			&tcpProxy.Proxy{},
@@ -82,7 +113,7 @@ func StartWithContext(ctx context.Context, o *proxyPkg.Options) error {
 		},
 	}
 	defer mgr.Close()
-	ls, err := net.Listen("tcp", o.Listen.Control.HostPort)
+	ls, err := proxyPkg.ListenBind(net.Listen, o.Listen.Control.HostPort, nil)
 	if err != nil {
 		return err
 	}
@@ -100,14 +131,73 @@ func StartWithContext(ctx context.Context, o *proxyPkg.Options) error {
 			zlg.Error(err, "Exit admin rpc server")
 		}
 	}()
+	// the access log is shared by all proxies so sinks receive both HTTP
+	// requests and TCP sessions.
+	access := accesslog.New(o.AccessLog, &accesslog.Zap{
+		Logger: zlg.Logger.Named("access"),
+	})
+	go access.Run(rctx)
+	ctx = accesslog.Set(ctx, access)
+	// the cache is shared by all proxies so they are bounded by one MaxCost.
+	if o.Cache.Enabled {
+		c, err := o.Cache.New()
+		if err != nil {
+			zlg.Error(err, "Failed to create cache, caching is disabled")
+		} else {
+			defer c.Close()
+			ctx = proxyPkg.SetCache(ctx, c)
+		}
+	}
 	if err := mgr.Boot(ctx, o); err != nil {
 		zlg.Error(err, "Failed to start  proxy server")
 	}
+	// close sockets inherited from a previous process that are no longer used
+	proxyPkg.CloseInherited()
+	if o.RoutesPath != "" {
+		go watchRoutes(rctx, o, mgr, static...)
+	}
+
+	up, err := upgrade.Listen(upgrade.Path(o.WorkDir))
+	if err != nil {
+		return err
+	}
+	defer up.Close()
+	handoff := make(chan *upgrade.Handoff, 1)
+	go func() {
+		h, err := up.Accept(rctx)
+		if err != nil {
+			if rctx.Err() == nil {
+				zlg.Error(err, "Stopped accepting upgrades")
+			}
+			return
+		}
+		handoff <- h
+	}()
 	if err := Join(ctx, o); err != nil {
 		cancel()
 		return err
 	}
-	<-rctx.Done()
+	select {
+	case <-rctx.Done():
+	case h := <-handoff:
+		zlg.Info("Handing over to new process")
+		drainCtx, cancelDrain := context.WithTimeout(context.Background(), o.DrainTimeout)
+		defer cancelDrain()
+		drained := make(chan error, 1)
+		go func() {
+			drained <- mgr.Shutdown(drainCtx)
+		}()
+		svr.GracefulStop()
+		release()
+		up.Close()
+		if err := h.Release(); err != nil {
+			zlg.Error(err, "Failed to release upgrade")
+		}
+		if err := <-drained; err != nil {
+			zlg.Error(err, "Failed to drain connections")
+		}
+		zlg.Info("Upgrade complete")
+	}
 	return nil
 }
 
@@ -71,6 +71,23 @@ func (p *ProxyManager) Boot(ctx context.Context, opts *proxy.Options) error {
 	return nil
 }
 
+// Shutdown gracefully shuts down all proxies concurrently.
+func (p *ProxyManager) Shutdown(ctx context.Context) error {
+	errs := make(chan error, len(p.Proxies))
+	for _, v := range p.Proxies {
+		go func(x proxy.Proxy) {
+			errs <- x.Shutdown(ctx)
+		}(v)
+	}
+	var err error
+	for range p.Proxies {
+		if e := <-errs; e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
 func (p *ProxyManager) Close() error {
 	for _, v := range p.Proxies {
 		if err := v.Close(); err != nil {
@@ -116,6 +133,21 @@ func (p *ProxyManager) Post(ctx context.Context, req *api.Config) (*api.Response
 	}, nil
 }
 
+func (p *ProxyManager) Purge(ctx context.Context, req *api.PurgeRequest) (*api.Response, error) {
+	for _, v := range p.Proxies {
+		x, ok := v.(proxy.Purger)
+		if !ok {
+			continue
+		}
+		if err := x.Purge(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return &api.Response{
+		Result: &api.Response_Ok{Ok: true},
+	}, nil
+}
+
 func (p *ProxyManager) Delete(ctx context.Context, req *api.DeleteRequest) (*api.Response, error) {
 	c := &api.Config{}
 	for _, v := range req.Routes {
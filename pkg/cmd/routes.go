@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/zlg"
+	"go.uber.org/zap"
+)
+
+// watchRoutes applies changes to route files in o.RoutesPath with mgr until
+// ctx is done. Only routes that came from files are added, replaced or
+// removed, routes added through the API are kept. static are routes that don't
+// come from files and are always kept.
+func watchRoutes(ctx context.Context, o *proxyPkg.Options, mgr *ProxyManager, static ...*api.Route) {
+	debounce := o.RoutesDebounce
+	if debounce <= 0 {
+		debounce = time.Second
+	}
+	isStatic := make(map[string]bool)
+	for _, r := range static {
+		isStatic[r.Name] = true
+	}
+	// files are the routes of the last loaded route files.
+	var files []*api.Route
+	for _, r := range o.Routes.Routes {
+		if !isStatic[r.Name] {
+			files = append(files, r)
+		}
+	}
+	err := proxyPkg.WatchRoutes(ctx, o.RoutesPath, debounce, func(routes []*api.Route) error {
+		added, removed, changed := proxyPkg.DiffRoutes(files, routes)
+		if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+			return nil
+		}
+		zlg.Info("Applying route changes",
+			zap.Strings("added", added),
+			zap.Strings("removed", removed),
+			zap.Strings("changed", changed),
+		)
+		running, err := mgr.Get(ctx, &api.ConfigRequest{})
+		if err != nil {
+			return err
+		}
+		next := proxyPkg.MergeRoutes(running.Routes, files, routes)
+		if _, err := mgr.Put(ctx, &api.Config{Routes: next}); err != nil {
+			return err
+		}
+		files = routes
+		return nil
+	})
+	if err != nil {
+		zlg.Error(err, "Stopped watching routes")
+	}
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/gernest/tt/pkg/upgrade"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+)
+
+const defaultUpgradeTimeout = time.Minute
+
+func upgradeCommand(version, commit, date, builtBy string) cli.Command {
+	return cli.Command{
+		Name:  "upgrade",
+		Usage: "Takes over listeners of the tt process running with the same work directory",
+		Flags: []cli.Flag{
+			cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Time to wait for the running process to hand over",
+				Value: defaultUpgradeTimeout,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			opts, err := options(ctx, version, commit, date, builtBy)
+			if err != nil {
+				return err
+			}
+			path := upgrade.Path(opts.WorkDir)
+			zlg.Info("Upgrading", zap.String("socket", path))
+			rctx, cancel := context.WithTimeout(context.Background(), ctx.Duration("timeout"))
+			err = upgrade.Request(rctx, path)
+			cancel()
+			if err != nil {
+				return err
+			}
+			return StartWithContext(context.Background(), opts)
+		},
+	}
+}
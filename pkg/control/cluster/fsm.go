@@ -3,9 +3,11 @@ package cluster
 import (
 	"io"
 	"path/filepath"
+	"sync"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/proxy"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/raft"
 )
@@ -13,6 +15,9 @@ import (
 // FSM implements FSM but stores data in badger
 type FSM struct {
 	db *badger.DB
+
+	mu      sync.Mutex
+	watches []func(key string)
 }
 
 func NewFSM(dataPath, nodeID string) (*FSM, error) {
@@ -31,6 +36,36 @@ func (fsm *FSM) Close() error {
 }
 
 var _ raft.FSM = (*FSM)(nil)
+var _ proxy.Store = (*FSM)(nil)
+
+// Get returns the value of key from the local replica.
+func (fsm *FSM) Get(key string) (v []byte, err error) {
+	err = fsm.db.View(func(txn *badger.Txn) error {
+		i, err := txn.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+		v, err = i.ValueCopy(nil)
+		return err
+	})
+	return
+}
+
+// Watch calls fn with keys that are set once the change is applied to the
+// local replica.
+func (fsm *FSM) Watch(fn func(key string)) {
+	fsm.mu.Lock()
+	fsm.watches = append(fsm.watches, fn)
+	fsm.mu.Unlock()
+}
+
+func (fsm *FSM) notify(key []byte) {
+	fsm.mu.Lock()
+	defer fsm.mu.Unlock()
+	for _, fn := range fsm.watches {
+		fn(string(key))
+	}
+}
 
 func (fsm *FSM) Apply(log *raft.Log) interface{} {
 	e, err := fsm.entry(log)
@@ -71,13 +106,18 @@ func (fsm *FSM) kv(e *api.Raft_KeyValue) interface{} {
 		}
 		return &o
 	case api.Raft_KeyValue_SET:
-		return fsm.db.Update(func(txn *badger.Txn) error {
+		err := fsm.db.Update(func(txn *badger.Txn) error {
 			en := badger.NewEntry(e.Context.Key, e.Context.Value)
 			if e.Context.ExpiresAt != 0 {
 				en.ExpiresAt = e.Context.ExpiresAt
 			}
 			return txn.SetEntry(en)
 		})
+		if err != nil {
+			return err
+		}
+		fsm.notify(e.Context.Key)
+		return nil
 	default:
 		return nil
 	}
@@ -98,5 +138,18 @@ func (fsm *FSM) Persist(sink raft.SnapshotSink) error {
 func (fsm *FSM) Release() {}
 
 func (fsm *FSM) Restore(r io.ReadCloser) error {
-	return fsm.db.Load(r, 5)
+	if err := fsm.db.Load(r, 5); err != nil {
+		return err
+	}
+	// values of any key may have changed, watchers are told about all of them.
+	return fsm.db.View(func(txn *badger.Txn) error {
+		o := badger.DefaultIteratorOptions
+		o.PrefetchValues = false
+		it := txn.NewIterator(o)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			fsm.notify(it.Item().Key())
+		}
+		return nil
+	})
 }
@@ -42,7 +42,7 @@ func (db *DB) send(entry *api.Raft_Log) (interface{}, error) {
 		return nil, err
 	}
 	a := db.Raft.Apply(m, db.Timeout)
-	if a.Error() != nil {
+	if err := a.Error(); err != nil {
 		return nil, err
 	}
 	res := a.Response()
@@ -13,13 +13,27 @@ import (
 	"github.com/hashicorp/raft"
 )
 
+// Raft is a raft node together with the log store it owns.
+type Raft struct {
+	*raft.Raft
+	store *raftbadger.BadgerStore
+}
+
+// Close shuts down the raft node and closes its log store.
+func (r *Raft) Close() error {
+	if err := r.Shutdown().Error(); err != nil {
+		return err
+	}
+	return r.store.Close()
+}
+
 func NewRaft(
 	bootstrap bool,
 	nodeID string,
 	nodeAddr string,
 	fsm raft.FSM,
 	dataPath string,
-) (*raft.Raft, error) {
+) (*Raft, error) {
 	c := raft.DefaultConfig()
 	c.LocalID = raft.ServerID(nodeID)
 	raftLog := zlg.Logger.Named("raft")
@@ -65,11 +79,13 @@ func NewRaft(
 			},
 		}
 		f := r.BootstrapCluster(cfg)
-		if err := f.Error(); err != nil {
+		// restarting a node that already has state in dataPath, like after an
+		// upgrade, can't bootstrap again.
+		if err := f.Error(); err != nil && err != raft.ErrCantBootstrap {
 			return nil, fmt.Errorf("raft.Raft.BootstrapCluster: %v", err)
 		}
 	}
-	return r, nil
+	return &Raft{Raft: r, store: store}, nil
 }
 
 func formatNodeAdr(addr string) string {
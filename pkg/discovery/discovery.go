@@ -0,0 +1,296 @@
+// Package discovery expands upstream addresses into endpoints using DNS.
+package discovery
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMinTTL = 5 * time.Second
+	defaultMaxTTL = 5 * time.Minute
+)
+
+// Endpoint is a single upstream address.
+type Endpoint struct {
+	// Address is host:port of the endpoint
+	Address string
+	Weight  int
+	// Addr is the configuration the endpoint was expanded from.
+	Addr *api.WeightedAddr
+}
+
+// Dynamic returns true if any of ws uses DNS discovery.
+func Dynamic(ws ...*api.WeightedAddr) bool {
+	for _, w := range ws {
+		if w.GetDiscovery().GetType() != api.Discovery_STATIC {
+			return true
+		}
+	}
+	return false
+}
+
+// Set keeps endpoints of weighted addresses. Addresses with discovery are
+// resolved lazily and resolved again in the background after their TTL
+// expires, Run keeps resolving them when the set is not used.
+type Set struct {
+	sources []*source
+
+	mu        sync.Mutex
+	version   uint64
+	endpoints []Endpoint
+}
+
+// NewSet returns a set for ws. host returns the host:port to resolve from an
+// address, this allows addresses in other forms like urls.
+func NewSet(host func(*api.WeightedAddr) string, ws ...*api.WeightedAddr) *Set {
+	s := &Set{}
+	for _, w := range ws {
+		s.sources = append(s.sources, &source{
+			addr:     w,
+			hostPort: host(w),
+			set:      s,
+		})
+	}
+	return s
+}
+
+// Endpoints returns current endpoints and their version. The version changes
+// every time endpoints change, callers can use it to tell if they need to
+// rebuild their balancers.
+//
+// Sources that were never resolved are resolved before returning, expired
+// sources are refreshed in the background.
+func (s *Set) Endpoints(ctx context.Context) ([]Endpoint, uint64) {
+	for _, src := range s.sources {
+		src.refresh(ctx, false)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.endpoints, s.version
+}
+
+// Run resolves sources when their TTL expires until ctx is done, so endpoints
+// of routes without traffic don't go stale.
+func (s *Set) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		var next time.Time
+		for _, src := range s.sources {
+			src.refresh(ctx, true)
+			if e := src.expiry(); next.IsZero() || e.Before(next) {
+				next = e
+			}
+		}
+		// sources being refreshed by Endpoints have expired already.
+		d := time.Until(next)
+		if d < time.Second {
+			d = time.Second
+		}
+		timer.Reset(d)
+	}
+}
+
+func (s *Set) update() {
+	var eps []Endpoint
+	for _, src := range s.sources {
+		eps = append(eps, src.get()...)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.version > 0 && sameEndpoints(s.endpoints, eps) {
+		return
+	}
+	s.endpoints = eps
+	s.version++
+}
+
+func sameEndpoints(a, b []Endpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type source struct {
+	addr     *api.WeightedAddr
+	hostPort string
+	set      *Set
+
+	mu         sync.Mutex
+	resolved   bool
+	refreshing bool
+	expires    time.Time
+	endpoints  []Endpoint
+}
+
+func (s *source) get() []Endpoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.endpoints
+}
+
+func (s *source) expiry() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.expires
+}
+
+// refresh resolves s when it was never resolved or its endpoints expired.
+// Expired endpoints are resolved in the background unless wait is true.
+func (s *source) refresh(ctx context.Context, wait bool) {
+	s.mu.Lock()
+	if !s.resolved {
+		// the first resolution blocks so the first connections have endpoints
+		// to use. Concurrent callers wait for it on s.mu.
+		eps, expires, ok := s.resolve(ctx)
+		s.expires = expires
+		if ok {
+			s.endpoints = eps
+		}
+		s.resolved = true
+		s.mu.Unlock()
+		s.set.update()
+		return
+	}
+	if s.refreshing || time.Now().Before(s.expires) {
+		s.mu.Unlock()
+		return
+	}
+	s.refreshing = true
+	s.mu.Unlock()
+	resolve := func(ctx context.Context) {
+		eps, expires, ok := s.resolve(ctx)
+		s.mu.Lock()
+		s.expires = expires
+		if ok {
+			s.endpoints = eps
+		}
+		s.refreshing = false
+		s.mu.Unlock()
+		if ok {
+			s.set.update()
+		}
+	}
+	if wait {
+		resolve(ctx)
+		return
+	}
+	go resolve(context.Background())
+}
+
+// resolve returns endpoints of s and when they expire. ok is false when
+// resolving failed, in that case the current endpoints are kept.
+func (s *source) resolve(ctx context.Context) (eps []Endpoint, expires time.Time, ok bool) {
+	d := s.addr.GetDiscovery()
+	weight := int(s.addr.Weight)
+	if d.GetType() == api.Discovery_STATIC {
+		return []Endpoint{{Address: s.hostPort, Weight: weight, Addr: s.addr}},
+			time.Now().Add(100 * 365 * 24 * time.Hour), true
+	}
+	minTTL, maxTTL := ttls(d)
+	host, port, err := net.SplitHostPort(s.hostPort)
+	if err != nil {
+		// SRV names don't need a port
+		host, port = s.hostPort, ""
+	}
+	r := &Resolver{Server: d.Resolver}
+	var rs []Record
+	switch d.Type {
+	case api.Discovery_DNS:
+		rs, err = r.LookupIP(ctx, host)
+	case api.Discovery_SRV:
+		rs, err = r.LookupSRV(ctx, host)
+		rs = lowestPriority(rs)
+	}
+	if err != nil {
+		zlg.Error(err, "Failed to resolve upstream",
+			zap.String("address", s.hostPort),
+		)
+		return nil, time.Now().Add(minTTL), false
+	}
+	ttl := maxTTL
+	eps = make([]Endpoint, 0, len(rs))
+	for _, r := range rs {
+		if r.TTL < ttl {
+			ttl = r.TTL
+		}
+		e := Endpoint{Weight: weight, Addr: s.addr}
+		if d.Type == api.Discovery_SRV {
+			e.Address = net.JoinHostPort(r.Host, strconv.Itoa(int(r.Port)))
+			e.Weight = int(r.Weight)
+			if e.Weight == 0 {
+				e.Weight = 1
+			}
+		} else {
+			e.Address = net.JoinHostPort(r.Host, port)
+		}
+		eps = append(eps, e)
+	}
+	if ttl < minTTL {
+		ttl = minTTL
+	}
+	// answers come in any order, sorting them keeps the set from changing when
+	// only the order did.
+	sort.Slice(eps, func(i, j int) bool {
+		return eps[i].Address < eps[j].Address
+	})
+	return eps, time.Now().Add(ttl), true
+}
+
+func ttls(d *api.Discovery) (min, max time.Duration) {
+	min, max = defaultMinTTL, defaultMaxTTL
+	if d.MinTtl != nil {
+		if v, err := ptypes.Duration(d.MinTtl); err == nil {
+			min = v
+		}
+	}
+	if d.MaxTtl != nil {
+		if v, err := ptypes.Duration(d.MaxTtl); err == nil {
+			max = v
+		}
+	}
+	if max < min {
+		max = min
+	}
+	return
+}
+
+func lowestPriority(rs []Record) []Record {
+	if len(rs) == 0 {
+		return rs
+	}
+	p := rs[0].Priority
+	for _, r := range rs {
+		if r.Priority < p {
+			p = r.Priority
+		}
+	}
+	o := rs[:0]
+	for _, r := range rs {
+		if r.Priority == p {
+			o = append(o, r)
+		}
+	}
+	return o
+}
@@ -0,0 +1,383 @@
+package discovery
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsServer is a local DNS stand-in that answers from records. Responses over
+// udp are truncated for names in truncate.
+type dnsServer struct {
+	udp net.PacketConn
+	tcp net.Listener
+
+	mu         sync.Mutex
+	records    map[string][]dnsmessage.Resource
+	additional map[string][]dnsmessage.Resource
+	truncate   map[string]bool
+}
+
+func newDNSServer(t *testing.T) *dnsServer {
+	t.Helper()
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tcp, err := net.Listen("tcp", udp.LocalAddr().String())
+	if err != nil {
+		udp.Close()
+		t.Fatal(err)
+	}
+	s := &dnsServer{
+		udp:        udp,
+		tcp:        tcp,
+		records:    make(map[string][]dnsmessage.Resource),
+		additional: make(map[string][]dnsmessage.Resource),
+		truncate:   make(map[string]bool),
+	}
+	go s.serveUDP()
+	go s.serveTCP()
+	t.Cleanup(func() {
+		udp.Close()
+		tcp.Close()
+	})
+	return s
+}
+
+func (s *dnsServer) Addr() string { return s.udp.LocalAddr().String() }
+
+func (s *dnsServer) set(name string, rs ...dnsmessage.Resource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[name] = rs
+}
+
+func (s *dnsServer) serveUDP() {
+	b := make([]byte, 512)
+	for {
+		n, addr, err := s.udp.ReadFrom(b)
+		if err != nil {
+			return
+		}
+		if res := s.answer(b[:n], true); res != nil {
+			s.udp.WriteTo(res, addr)
+		}
+	}
+}
+
+func (s *dnsServer) serveTCP() {
+	for {
+		c, err := s.tcp.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer c.Close()
+			var size [2]byte
+			if _, err := io.ReadFull(c, size[:]); err != nil {
+				return
+			}
+			b := make([]byte, binary.BigEndian.Uint16(size[:]))
+			if _, err := io.ReadFull(c, b); err != nil {
+				return
+			}
+			res := s.answer(b, false)
+			binary.BigEndian.PutUint16(size[:], uint16(len(res)))
+			c.Write(append(size[:], res...))
+		}()
+	}
+}
+
+func (s *dnsServer) answer(b []byte, udp bool) []byte {
+	var q dnsmessage.Message
+	if err := q.Unpack(b); err != nil || len(q.Questions) != 1 {
+		return nil
+	}
+	question := q.Questions[0]
+	name := question.Name.String()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			ID:       q.ID,
+			Response: true,
+		},
+		Questions: q.Questions,
+	}
+	rs, ok := s.records[name]
+	if !ok {
+		m.RCode = dnsmessage.RCodeNameError
+	}
+	if udp && s.truncate[name] {
+		m.Truncated = true
+	} else {
+		for _, r := range rs {
+			if r.Header.Type == question.Type {
+				m.Answers = append(m.Answers, r)
+			}
+		}
+		m.Additionals = s.additional[name]
+	}
+	res, _ := m.Pack()
+	return res
+}
+
+func mustName(t *testing.T, name string) dnsmessage.Name {
+	t.Helper()
+	n, err := dnsmessage.NewName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func a(t *testing.T, name string, ip string, ttl uint32) dnsmessage.Resource {
+	var v [4]byte
+	copy(v[:], net.ParseIP(ip).To4())
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{
+			Name: mustName(t, name), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: ttl,
+		},
+		Body: &dnsmessage.AResource{A: v},
+	}
+}
+
+func srv(t *testing.T, name, target string, port, weight, priority uint16) dnsmessage.Resource {
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{
+			Name: mustName(t, name), Type: dnsmessage.TypeSRV, Class: dnsmessage.ClassINET, TTL: 60,
+		},
+		Body: &dnsmessage.SRVResource{
+			Target:   mustName(t, target),
+			Port:     port,
+			Weight:   weight,
+			Priority: priority,
+		},
+	}
+}
+
+func addresses(eps []Endpoint) (o []string) {
+	for _, e := range eps {
+		o = append(o, e.Address)
+	}
+	sort.Strings(o)
+	return
+}
+
+func TestLookupIP(t *testing.T) {
+	s := newDNSServer(t)
+	s.set("backend.test.",
+		a(t, "backend.test.", "10.0.0.1", 30),
+		a(t, "backend.test.", "10.0.0.2", 10),
+	)
+	r := &Resolver{Server: s.Addr()}
+	rs, err := r.LookupIP(context.Background(), "backend.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Fatalf("expected 2 records got %d", len(rs))
+	}
+	if rs[1].Host != "10.0.0.2" || rs[1].TTL != 10*time.Second {
+		t.Errorf("unexpected record %+v", rs[1])
+	}
+	if _, err := r.LookupIP(context.Background(), "missing.test"); err == nil {
+		t.Error("expected an error for missing names")
+	}
+
+	// large responses are retried over tcp
+	s.mu.Lock()
+	s.truncate["backend.test."] = true
+	s.mu.Unlock()
+	rs, err = r.LookupIP(context.Background(), "backend.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Fatalf("expected 2 records over tcp got %d", len(rs))
+	}
+}
+
+func TestSetSRV(t *testing.T) {
+	s := newDNSServer(t)
+	s.set("_http._tcp.backend.test.",
+		srv(t, "_http._tcp.backend.test.", "a.backend.test.", 8080, 10, 1),
+		srv(t, "_http._tcp.backend.test.", "b.backend.test.", 8081, 0, 1),
+		srv(t, "_http._tcp.backend.test.", "c.backend.test.", 8082, 50, 2),
+	)
+	s.mu.Lock()
+	s.additional["_http._tcp.backend.test."] = []dnsmessage.Resource{
+		a(t, "a.backend.test.", "10.0.0.1", 60),
+	}
+	s.mu.Unlock()
+	set := NewSet(func(w *api.WeightedAddr) string {
+		return w.Addr.Address
+	}, &api.WeightedAddr{
+		Addr: &api.Address{Address: "_http._tcp.backend.test"},
+		Discovery: &api.Discovery{
+			Type:     api.Discovery_SRV,
+			Resolver: s.Addr(),
+		},
+	})
+	eps, _ := set.Endpoints(context.Background())
+	got := map[string]int{}
+	for _, e := range eps {
+		got[e.Address] = e.Weight
+	}
+	want := map[string]int{
+		"10.0.0.1:8080":       10,
+		"b.backend.test:8081": 1,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("expected %s to have weight %d got %d", k, v, got[k])
+		}
+	}
+}
+
+func TestSetRefresh(t *testing.T) {
+	s := newDNSServer(t)
+	s.set("backend.test.", a(t, "backend.test.", "10.0.0.1", 0))
+	set := NewSet(func(w *api.WeightedAddr) string {
+		return w.Addr.Address
+	},
+		&api.WeightedAddr{
+			Addr:   &api.Address{Address: "backend.test:80"},
+			Weight: 2,
+			Discovery: &api.Discovery{
+				Type:     api.Discovery_DNS,
+				Resolver: s.Addr(),
+				MinTtl:   ptypes.DurationProto(10 * time.Millisecond),
+			},
+		},
+		&api.WeightedAddr{
+			Addr:   &api.Address{Address: "127.0.0.1:90"},
+			Weight: 1,
+		},
+	)
+	eps, version := set.Endpoints(context.Background())
+	if got, want := addresses(eps), []string{"10.0.0.1:80", "127.0.0.1:90"}; !equal(got, want) {
+		t.Fatalf("expected %v got %v", want, got)
+	}
+	s.set("backend.test.",
+		a(t, "backend.test.", "10.0.0.2", 0),
+		a(t, "backend.test.", "10.0.0.3", 0),
+	)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		time.Sleep(20 * time.Millisecond)
+		var v uint64
+		eps, v = set.Endpoints(context.Background())
+		if v != version && len(eps) == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("endpoints were not refreshed got %v", addresses(eps))
+		}
+	}
+	if got, want := addresses(eps), []string{"10.0.0.2:80", "10.0.0.3:80", "127.0.0.1:90"}; !equal(got, want) {
+		t.Errorf("expected %v got %v", want, got)
+	}
+
+	// failures keep the last known endpoints
+	s.mu.Lock()
+	delete(s.records, "backend.test.")
+	s.mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	set.Endpoints(context.Background())
+	time.Sleep(50 * time.Millisecond)
+	eps, _ = set.Endpoints(context.Background())
+	if len(eps) != 3 {
+		t.Errorf("expected endpoints to be kept got %v", addresses(eps))
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testSet(t *testing.T, s *dnsServer) *Set {
+	t.Helper()
+	return NewSet(func(w *api.WeightedAddr) string {
+		return w.Addr.Address
+	}, &api.WeightedAddr{
+		Addr:   &api.Address{Address: "backend.test:80"},
+		Weight: 1,
+		Discovery: &api.Discovery{
+			Type:     api.Discovery_DNS,
+			Resolver: s.Addr(),
+			MinTtl:   ptypes.DurationProto(10 * time.Millisecond),
+		},
+	})
+}
+
+func TestSetVersion(t *testing.T) {
+	s := newDNSServer(t)
+	s.set("backend.test.",
+		a(t, "backend.test.", "10.0.0.1", 0),
+		a(t, "backend.test.", "10.0.0.2", 0),
+	)
+	set := testSet(t, s)
+	_, version := set.Endpoints(context.Background())
+	// answers in a different order are the same endpoints
+	s.set("backend.test.",
+		a(t, "backend.test.", "10.0.0.2", 0),
+		a(t, "backend.test.", "10.0.0.1", 0),
+	)
+	for i := 0; i < 5; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if _, v := set.Endpoints(context.Background()); v != version {
+			t.Fatalf("expected version %d when endpoints didn't change got %d", version, v)
+		}
+	}
+}
+
+func TestSetRun(t *testing.T) {
+	s := newDNSServer(t)
+	s.set("backend.test.", a(t, "backend.test.", "10.0.0.1", 0))
+	set := testSet(t, s)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go set.Run(ctx)
+	current := func() []string {
+		set.mu.Lock()
+		defer set.mu.Unlock()
+		return addresses(set.endpoints)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !equal(current(), []string{"10.0.0.1:80"}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("endpoints were not resolved got %v", current())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// endpoints are resolved again without calls to Endpoints
+	s.set("backend.test.", a(t, "backend.test.", "10.0.0.2", 0))
+	for !equal(current(), []string{"10.0.0.2:80"}) {
+		if time.Now().After(deadline) {
+			t.Fatalf("endpoints were not refreshed got %v", current())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,234 @@
+package discovery
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// ErrNoRecords is returned when a name has no records of the requested type.
+var ErrNoRecords = errors.New("discovery: no records found")
+
+const defaultResolver = "127.0.0.1:53"
+
+// Record is a resolved DNS record.
+type Record struct {
+	// Host is an ip address for A/AAAA records or the target of a SRV record.
+	Host     string
+	Port     uint16
+	Weight   uint16
+	Priority uint16
+	TTL      time.Duration
+}
+
+// Resolver sends DNS queries to Server.
+type Resolver struct {
+	// Server is the host:port of the DNS server
+	Server  string
+	Timeout time.Duration
+}
+
+// SystemResolver returns the address of the first nameserver in
+// /etc/resolv.conf
+func SystemResolver() string {
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return defaultResolver
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) > 1 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], "53")
+		}
+	}
+	return defaultResolver
+}
+
+// LookupIP returns A and AAAA records of name.
+func (r *Resolver) LookupIP(ctx context.Context, name string) ([]Record, error) {
+	var rs []Record
+	for _, typ := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		m, err := r.exchange(ctx, name, typ)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, records(m.Answers, typ)...)
+	}
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoRecords, name)
+	}
+	return rs, nil
+}
+
+// LookupSRV returns SRV records of name. Targets are replaced with their
+// addresses when the server includes them in the response.
+func (r *Resolver) LookupSRV(ctx context.Context, name string) ([]Record, error) {
+	m, err := r.exchange(ctx, name, dnsmessage.TypeSRV)
+	if err != nil {
+		return nil, err
+	}
+	rs := records(m.Answers, dnsmessage.TypeSRV)
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoRecords, name)
+	}
+	addrs := make(map[string]string)
+	for _, a := range m.Additionals {
+		switch e := a.Body.(type) {
+		case *dnsmessage.AResource:
+			addrs[a.Header.Name.String()] = net.IP(e.A[:]).String()
+		case *dnsmessage.AAAAResource:
+			if _, ok := addrs[a.Header.Name.String()]; !ok {
+				addrs[a.Header.Name.String()] = net.IP(e.AAAA[:]).String()
+			}
+		}
+	}
+	for i := range rs {
+		if ip, ok := addrs[rs[i].Host]; ok {
+			rs[i].Host = ip
+		} else {
+			rs[i].Host = strings.TrimSuffix(rs[i].Host, ".")
+		}
+	}
+	return rs, nil
+}
+
+func records(rs []dnsmessage.Resource, typ dnsmessage.Type) (o []Record) {
+	for _, a := range rs {
+		if a.Header.Type != typ {
+			continue
+		}
+		ttl := time.Duration(a.Header.TTL) * time.Second
+		switch e := a.Body.(type) {
+		case *dnsmessage.AResource:
+			o = append(o, Record{Host: net.IP(e.A[:]).String(), TTL: ttl})
+		case *dnsmessage.AAAAResource:
+			o = append(o, Record{Host: net.IP(e.AAAA[:]).String(), TTL: ttl})
+		case *dnsmessage.SRVResource:
+			o = append(o, Record{
+				Host:     e.Target.String(),
+				Port:     e.Port,
+				Weight:   e.Weight,
+				Priority: e.Priority,
+				TTL:      ttl,
+			})
+		}
+	}
+	return
+}
+
+func (r *Resolver) server() string {
+	if r.Server != "" {
+		return r.Server
+	}
+	return SystemResolver()
+}
+
+func (r *Resolver) timeout() time.Duration {
+	if r.Timeout > 0 {
+		return r.Timeout
+	}
+	return 5 * time.Second
+}
+
+// exchange sends a query over udp and retries over tcp when the response is
+// truncated.
+func (r *Resolver) exchange(ctx context.Context, name string, typ dnsmessage.Type) (*dnsmessage.Message, error) {
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	n, err := dnsmessage.NewName(name)
+	if err != nil {
+		return nil, err
+	}
+	id := uint16(rand.Uint32())
+	q := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{
+			{Name: n, Type: typ, Class: dnsmessage.ClassINET},
+		},
+	}
+	b, err := q.Pack()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.timeout())
+	defer cancel()
+	m, err := r.send(ctx, "udp", b, id)
+	if err != nil {
+		return nil, err
+	}
+	if m.Truncated {
+		m, err = r.send(ctx, "tcp", b, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch m.RCode {
+	case dnsmessage.RCodeSuccess:
+		return m, nil
+	case dnsmessage.RCodeNameError:
+		return nil, fmt.Errorf("%w for %s", ErrNoRecords, name)
+	default:
+		return nil, fmt.Errorf("discovery: query for %s failed with %s", name, m.RCode)
+	}
+}
+
+func (r *Resolver) send(ctx context.Context, network string, query []byte, id uint16) (*dnsmessage.Message, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, r.server())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	var b []byte
+	if network == "tcp" {
+		// messages over tcp are prefixed with their length
+		msg := make([]byte, 2+len(query))
+		binary.BigEndian.PutUint16(msg, uint16(len(query)))
+		copy(msg[2:], query)
+		if _, err := conn.Write(msg); err != nil {
+			return nil, err
+		}
+		var size [2]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return nil, err
+		}
+		b = make([]byte, binary.BigEndian.Uint16(size[:]))
+		if _, err := io.ReadFull(conn, b); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+		b = make([]byte, 4096)
+		n, err := conn.Read(b)
+		if err != nil {
+			return nil, err
+		}
+		b = b[:n]
+	}
+	var m dnsmessage.Message
+	if err := m.Unpack(b); err != nil {
+		return nil, err
+	}
+	if m.ID != id {
+		return nil, errors.New("discovery: response id mismatch")
+	}
+	return &m, nil
+}
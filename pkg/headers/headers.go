@@ -0,0 +1,241 @@
+// Package headers implements the middleware changing headers of requests and
+// responses of HTTP routes.
+package headers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tmpl"
+	"github.com/justinas/alice"
+	"golang.org/x/net/http/httpguts"
+)
+
+// forwardedHeaders are headers describing the client side of proxies.
+var forwardedHeaders = []string{
+	"Forwarded",
+	"X-Forwarded-For",
+	"X-Forwarded-Host",
+	"X-Forwarded-Proto",
+}
+
+type header struct {
+	name  string
+	value *tmpl.Template
+}
+
+type operations struct {
+	remove []string
+	rename [][2]string
+	set    []header
+	add    []header
+}
+
+type headers struct {
+	route    *api.Route
+	request  *operations
+	response *operations
+	// hop are additional hop-by-hop headers.
+	hop              []string
+	forwarded        api.Middleware_Headers_Forwarded
+	ignoreConnection bool
+}
+
+// New returns a middleware changing headers of requests and responses of route
+// as configured by o.
+func New(route *api.Route, o *api.Middleware_Headers) (alice.Constructor, error) {
+	h := &headers{
+		route:            route,
+		forwarded:        o.Forwarded,
+		ignoreConnection: o.IgnoreConnectionTokens,
+	}
+	var err error
+	if h.request, err = compile(o.Request); err != nil {
+		return nil, fmt.Errorf("request headers: %v", err)
+	}
+	if h.response, err = compile(o.Response); err != nil {
+		return nil, fmt.Errorf("response headers: %v", err)
+	}
+	for _, v := range o.HopHeaders {
+		if !httpguts.ValidHeaderFieldName(v) {
+			return nil, fmt.Errorf("invalid hop-by-hop header name %q", v)
+		}
+		h.hop = append(h.hop, http.CanonicalHeaderKey(v))
+	}
+	return h.handler, nil
+}
+
+func compile(o *api.Middleware_Headers_Operations) (*operations, error) {
+	ops := &operations{}
+	for _, v := range o.GetRemove() {
+		if !httpguts.ValidHeaderFieldName(v) {
+			return nil, fmt.Errorf("invalid header name %q", v)
+		}
+		ops.remove = append(ops.remove, http.CanonicalHeaderKey(v))
+	}
+	for _, v := range o.GetRename() {
+		if !httpguts.ValidHeaderFieldName(v.From) || !httpguts.ValidHeaderFieldName(v.To) {
+			return nil, fmt.Errorf("invalid rename of %q to %q", v.From, v.To)
+		}
+		ops.rename = append(ops.rename, [2]string{
+			http.CanonicalHeaderKey(v.From), http.CanonicalHeaderKey(v.To),
+		})
+	}
+	var err error
+	if ops.set, err = compileHeaders(o.GetSet()); err != nil {
+		return nil, err
+	}
+	if ops.add, err = compileHeaders(o.GetAdd()); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+func compileHeaders(ls []*api.Middleware_Headers_Header) ([]header, error) {
+	var o []header
+	for _, v := range ls {
+		if !httpguts.ValidHeaderFieldName(v.Name) {
+			return nil, fmt.Errorf("invalid header name %q", v.Name)
+		}
+		t, err := tmpl.Parse(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("header %q: %v", v.Name, err)
+		}
+		o = append(o, header{name: http.CanonicalHeaderKey(v.Name), value: t})
+	}
+	return o, nil
+}
+
+// apply changes h, values are expanded for request r. Setting the Host header
+// of requests changes r.Host.
+func (o *operations) apply(route *api.Route, r *http.Request, h http.Header, request bool) {
+	for _, v := range o.remove {
+		h.Del(v)
+	}
+	for _, v := range o.rename {
+		if x, ok := h[v[0]]; ok {
+			delete(h, v[0])
+			h[v[1]] = x
+		}
+	}
+	for _, v := range o.set {
+		value := v.value.Execute(route, r)
+		if request && v.name == "Host" {
+			r.Host = value
+			continue
+		}
+		h.Set(v.name, value)
+	}
+	for _, v := range o.add {
+		h.Add(v.name, v.value.Execute(route, r))
+	}
+}
+
+func (x *headers) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x.ignoreConnection {
+			keepConnectionTokens(r.Header)
+		}
+		x.setForwarded(r)
+		for _, v := range x.hop {
+			r.Header.Del(v)
+		}
+		x.request.apply(x.route, r, r.Header, true)
+		if r.Header.Get("Upgrade") != "" {
+			// upgrade responses are written to the hijacked connection.
+			next.ServeHTTP(w, r)
+			return
+		}
+		rw := &responseWriter{ResponseWriter: w}
+		rw.apply = func() {
+			h := w.Header()
+			for _, v := range x.hop {
+				h.Del(v)
+			}
+			x.response.apply(x.route, r, h, false)
+		}
+		next.ServeHTTP(rw, r)
+		rw.before()
+	})
+}
+
+func (x *headers) setForwarded(r *http.Request) {
+	switch x.forwarded {
+	case api.Middleware_Headers_REPLACE:
+		for _, v := range forwardedHeaders {
+			r.Header.Del(v)
+		}
+		// the reverse proxy sets X-Forwarded-For to the client address.
+		r.Header.Set("X-Forwarded-Host", r.Host)
+		r.Header.Set("X-Forwarded-Proto", tmpl.Scheme(r))
+	case api.Middleware_Headers_REMOVE:
+		for _, v := range forwardedHeaders {
+			r.Header.Del(v)
+		}
+		// a nil value stops the reverse proxy from adding the header.
+		r.Header["X-Forwarded-For"] = nil
+	}
+}
+
+// keepConnectionTokens removes tokens naming headers from the Connection
+// header.
+func keepConnectionTokens(h http.Header) {
+	values, ok := h["Connection"]
+	if !ok {
+		return
+	}
+	var keep []string
+	for _, v := range values {
+		for _, x := range strings.Split(v, ",") {
+			x = strings.TrimSpace(x)
+			switch strings.ToLower(x) {
+			case "close", "keep-alive", "upgrade":
+				keep = append(keep, x)
+			}
+		}
+	}
+	if len(keep) == 0 {
+		h.Del("Connection")
+		return
+	}
+	h["Connection"] = []string{strings.Join(keep, ", ")}
+}
+
+// responseWriter changes response headers before they are written.
+type responseWriter struct {
+	http.ResponseWriter
+	apply   func()
+	applied bool
+}
+
+func (rw *responseWriter) before() {
+	if !rw.applied {
+		rw.applied = true
+		rw.apply()
+	}
+}
+
+func (rw *responseWriter) WriteHeader(code int) {
+	if code >= 200 {
+		rw.before()
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.before()
+	return rw.ResponseWriter.Write(b)
+}
+
+func (rw *responseWriter) Flush() {
+	rw.before()
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
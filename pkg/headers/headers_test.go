@@ -0,0 +1,116 @@
+package headers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/gernest/tt/api"
+)
+
+func TestHeaders(t *testing.T) {
+	route := &api.Route{Name: "app", Service: "web"}
+	c, err := New(route, &api.Middleware_Headers{
+		Request: &api.Middleware_Headers_Operations{
+			Remove: []string{"Cookie"},
+			Rename: []*api.Middleware_Headers_Rename{{From: "x-old", To: "x-new"}},
+			Set: []*api.Middleware_Headers_Header{
+				{Name: "x-route", Value: "{route}/{service}"},
+				{Name: "host", Value: "upstream.internal"},
+			},
+			Add: []*api.Middleware_Headers_Header{{Name: "x-client", Value: "{client_ip}"}},
+		},
+		Response: &api.Middleware_Headers_Operations{
+			Remove: []string{"server"},
+			Set:    []*api.Middleware_Headers_Header{{Name: "x-request-id", Value: "{request_id}"}},
+		},
+		HopHeaders: []string{"x-internal"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *http.Request
+	h := c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Header().Set("Server", "upstream")
+		w.Header().Set("X-Internal", "secret")
+		w.Write([]byte("ok"))
+	}))
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "192.0.2.1:1234"
+	r.Header.Set("Cookie", "a=b")
+	r.Header.Set("X-Old", "v")
+	r.Header.Set("X-Internal", "v")
+	r.Header.Set("X-Request-Id", "abc")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	expect := http.Header{
+		"X-New":        {"v"},
+		"X-Route":      {"app/web"},
+		"X-Client":     {"192.0.2.1"},
+		"X-Request-Id": {"abc"},
+	}
+	if len(got.Header) != len(expect) {
+		t.Errorf("expected request header %v got %v", expect, got.Header)
+	}
+	for k, v := range expect {
+		if got.Header.Get(k) != v[0] {
+			t.Errorf("expected %s %q got %q", k, v[0], got.Header.Get(k))
+		}
+	}
+	if got.Host != "upstream.internal" {
+		t.Errorf("expected host to be set got %q", got.Host)
+	}
+	if w.Header().Get("Server") != "" || w.Header().Get("X-Internal") != "" || w.Header().Get("X-Request-Id") != "abc" {
+		t.Errorf("unexpected response header %v", w.Header())
+	}
+}
+
+func TestHeadersForwarded(t *testing.T) {
+	sample := []struct {
+		forwarded api.Middleware_Headers_Forwarded
+		xff       string
+		proto     string
+	}{
+		{api.Middleware_Headers_KEEP, "203.0.113.1, 192.0.2.1", "https"},
+		{api.Middleware_Headers_REPLACE, "192.0.2.1", "http"},
+		{api.Middleware_Headers_REMOVE, "", ""},
+	}
+	for _, s := range sample {
+		t.Run(s.forwarded.String(), func(t *testing.T) {
+			var got http.Header
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header
+			}))
+			defer upstream.Close()
+			u, _ := url.Parse(upstream.URL)
+			c, err := New(&api.Route{}, &api.Middleware_Headers{
+				Forwarded:              s.forwarded,
+				IgnoreConnectionTokens: true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := c(httputil.NewSingleHostReverseProxy(u))
+			r := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+			r.RemoteAddr = "192.0.2.1:1234"
+			r.Header.Set("X-Forwarded-For", "203.0.113.1")
+			r.Header.Set("X-Forwarded-Proto", "https")
+			r.Header.Set("Connection", "X-Auth")
+			r.Header.Set("X-Auth", "token")
+			h.ServeHTTP(httptest.NewRecorder(), r)
+			if v := got.Get("X-Forwarded-For"); v != s.xff {
+				t.Errorf("expected X-Forwarded-For %q got %q", s.xff, v)
+			}
+			if v := got.Get("X-Forwarded-Proto"); v != s.proto {
+				t.Errorf("expected X-Forwarded-Proto %q got %q", s.proto, v)
+			}
+			if got.Get("X-Auth") != "token" {
+				t.Error("expected headers named by Connection to be passed on")
+			}
+		})
+	}
+}
@@ -13,4 +13,14 @@ type Health struct {
 	Version   string `json:"Version,omitempty"`
 	ReleaseID string `json:"releaseId,omitempty"`
 	ServiceID string `json:"serviceId,omitempty"`
+	// Checks are keyed by "componentName:measurementName".
+	Checks map[string][]Check `json:"checks,omitempty"`
+}
+
+// Check is the health of a single component.
+type Check struct {
+	ComponentID   string `json:"componentId,omitempty"`
+	ComponentType string `json:"componentType,omitempty"`
+	Status        Status `json:"status"`
+	Output        string `json:"output,omitempty"`
 }
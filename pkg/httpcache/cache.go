@@ -0,0 +1,440 @@
+// Package httpcache caches responses of HTTP routes as a shared cache following
+// RFC 9111.
+package httpcache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var totalRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "tt",
+		Name:      "http_cache_requests_total",
+		Help:      "Total number of requests to routes with a cache by cache status",
+	},
+	[]string{"route", "status"},
+)
+
+func init() {
+	prometheus.MustRegister(totalRequests)
+}
+
+const (
+	defaultMaxBody = 1 << 20
+	// revalidateWindow is how long responses with validators are kept after
+	// they go stale so they can be revalidated.
+	revalidateWindow = 10 * time.Minute
+	// revalidateTimeout bounds background revalidation of stale responses.
+	revalidateTimeout = time.Minute
+)
+
+// Store keeps cached responses of all routes in the ristretto cache shared by
+// all proxies.
+type Store struct {
+	cache *ristretto.Cache
+	now   func() time.Time
+
+	mu     sync.Mutex
+	routes map[string]*route
+	// revalidating are keys of responses revalidated in the background.
+	revalidating map[string]bool
+}
+
+// route is the state of a route shared by its handlers.
+type route struct {
+	// gen changes when all responses of the route are purged.
+	gen atomic.Uint64
+	key *api.Middleware_Cache_Key
+}
+
+func New(c *ristretto.Cache) *Store {
+	return &Store{
+		cache:        c,
+		now:          time.Now,
+		routes:       make(map[string]*route),
+		revalidating: make(map[string]bool),
+	}
+}
+
+// Purge removes cached responses of routes, or of all routes when routes is
+// empty. Only responses to urls are removed unless urls is empty.
+func (s *Store) Purge(routes, urls []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(routes) == 0 {
+		for k := range s.routes {
+			routes = append(routes, k)
+		}
+	}
+	for _, name := range routes {
+		rt, ok := s.routes[name]
+		if !ok {
+			return fmt.Errorf("httpcache: route %q has no cache", name)
+		}
+		if len(urls) == 0 {
+			rt.gen.Add(1)
+			continue
+		}
+		for _, v := range urls {
+			u, err := url.Parse(v)
+			if err != nil {
+				return err
+			}
+			s.cache.Del(primaryKey(name, rt.gen.Load(), rt.key, u.Scheme, u.Host, u))
+		}
+	}
+	return nil
+}
+
+// Handler returns a middleware caching responses of the route name.
+func (s *Store) Handler(name string, o *api.Middleware_Cache) func(http.Handler) http.Handler {
+	s.mu.Lock()
+	rt, ok := s.routes[name]
+	if !ok {
+		rt = &route{}
+		s.routes[name] = rt
+	}
+	rt.key = o.Key
+	s.mu.Unlock()
+	c := &config{
+		route:   name,
+		key:     o.Key,
+		maxBody: defaultMaxBody,
+	}
+	c.defaultTTL, _ = ptypes.Duration(o.DefaultTtl)
+	c.maxTTL, _ = ptypes.Duration(o.MaxTtl)
+	c.swr, _ = ptypes.Duration(o.StaleWhileRevalidate)
+	c.sie, _ = ptypes.Duration(o.StaleIfError)
+	if o.MaxBodySize > 0 {
+		c.maxBody = int64(o.MaxBodySize)
+	}
+	return func(next http.Handler) http.Handler {
+		return &handler{s: s, rt: rt, c: c, next: next}
+	}
+}
+
+type config struct {
+	route      string
+	key        *api.Middleware_Cache_Key
+	defaultTTL time.Duration
+	maxTTL     time.Duration
+	swr, sie   time.Duration
+	maxBody    int64
+}
+
+// variants lists the request headers cached responses to a URL vary on.
+type variants struct {
+	vary []string
+}
+
+// primaryKey returns the key of responses to u. Keys of responses include
+// request headers the responses vary on.
+func primaryKey(route string, gen uint64, k *api.Middleware_Cache_Key, scheme, host string, u *url.URL) string {
+	var b strings.Builder
+	// the cache is shared with other proxies, keep our keys apart.
+	b.WriteString("http_cache/")
+	b.WriteString(route)
+	b.WriteByte(0)
+	b.WriteString(strconv.FormatUint(gen, 10))
+	b.WriteByte(0)
+	if !k.GetIgnoreHost() {
+		b.WriteString(scheme)
+		b.WriteString("://")
+		b.WriteString(host)
+	}
+	b.WriteString(u.EscapedPath())
+	if !k.GetIgnoreQuery() && u.RawQuery != "" {
+		b.WriteByte('?')
+		b.WriteString(u.RawQuery)
+	}
+	return b.String()
+}
+
+type handler struct {
+	s    *Store
+	rt   *route
+	c    *config
+	next http.Handler
+}
+
+func (h *handler) primary(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return primaryKey(h.c.route, h.rt.gen.Load(), h.c.key, scheme, r.Host, r.URL)
+}
+
+// variant returns the key of the response to r varying on vary.
+func (h *handler) variant(primary string, vary []string, r *http.Request) string {
+	var b strings.Builder
+	b.WriteString(primary)
+	// responses are never stored under their primary key.
+	b.WriteString("\x00response")
+	for _, k := range h.c.key.GetHeaders() {
+		b.WriteByte(0)
+		b.WriteString(strings.Join(r.Header.Values(k), ","))
+	}
+	for _, k := range h.c.key.GetCookies() {
+		b.WriteByte(0)
+		if c, err := r.Cookie(k); err == nil {
+			b.WriteString(c.Value)
+		}
+	}
+	for _, k := range vary {
+		b.WriteByte(0)
+		b.WriteString(k)
+		b.WriteByte(':')
+		b.WriteString(strings.Join(r.Header.Values(k), ","))
+	}
+	return b.String()
+}
+
+// lookup returns the cached response to r and its key.
+func (h *handler) lookup(primary string, r *http.Request) (*entry, string) {
+	v, ok := h.s.cache.Get(primary)
+	if !ok {
+		return nil, ""
+	}
+	vs, ok := v.(*variants)
+	if !ok {
+		return nil, ""
+	}
+	key := h.variant(primary, vs.vary, r)
+	x, ok := h.s.cache.Get(key)
+	if !ok {
+		return nil, key
+	}
+	e, ok := x.(*entry)
+	if !ok {
+		return nil, key
+	}
+	return e, key
+}
+
+// store caches the response to r received between start and end. It returns
+// the entry of the response.
+func (h *handler) store(r *http.Request, primary string, status int, header http.Header, body []byte, start, end time.Time) *entry {
+	e, ok := h.c.newEntry(r, status, header, body, start, end)
+	if !ok {
+		return e
+	}
+	vary := varyHeaders(header)
+	keep := e.keep()
+	h.s.cache.SetWithTTL(primary, &variants{vary: vary}, 1, keep)
+	h.s.cache.SetWithTTL(h.variant(primary, vary, r), e, e.cost(), keep)
+	return e
+}
+
+func varyHeaders(h http.Header) (vary []string) {
+	for _, v := range h.Values("Vary") {
+		for _, x := range strings.Split(v, ",") {
+			if x = strings.TrimSpace(x); x != "" {
+				vary = append(vary, http.CanonicalHeaderKey(x))
+			}
+		}
+	}
+	sort.Strings(vary)
+	return
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	default:
+		h.invalidate(w, r)
+		return
+	}
+	req := parseDirectives(r.Header)
+	if req.has("no-store") {
+		h.setStatus(r, api.AccessEntry_BYPASS)
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	primary := h.primary(r)
+	e, key := h.lookup(primary, r)
+	if e == nil {
+		if req.has("only-if-cached") {
+			h.setStatus(r, api.AccessEntry_BYPASS)
+			http.Error(w, http.StatusText(http.StatusGatewayTimeout), http.StatusGatewayTimeout)
+			return
+		}
+		h.fetch(w, r, primary)
+		return
+	}
+	now := h.s.now()
+	age := e.age(now)
+	if e.fresh(age, req) {
+		h.serve(w, r, e, age, api.AccessEntry_HIT)
+		return
+	}
+	if !e.noCache && !e.mustRevalidate && !req.has("no-cache") && e.stale(age) < e.swr {
+		h.serve(w, r, e, age, api.AccessEntry_STALE)
+		h.revalidateAsync(r, primary, key, e)
+		return
+	}
+	h.revalidate(w, r, primary, e, age)
+}
+
+// invalidate sends requests with unsafe methods upstream and removes cached
+// responses to their URL when they succeed.
+func (h *handler) invalidate(w http.ResponseWriter, r *http.Request) {
+	rec := newRecorder(w, 0, nil)
+	h.next.ServeHTTP(rec, r)
+	rec.finish()
+	switch r.Method {
+	case http.MethodOptions, http.MethodTrace:
+		return
+	}
+	if rec.status < 400 {
+		h.s.cache.Del(h.primary(r))
+	}
+}
+
+// fetch sends r upstream and caches the response.
+func (h *handler) fetch(w http.ResponseWriter, r *http.Request, primary string) {
+	h.setStatus(r, api.AccessEntry_MISS)
+	rec := newRecorder(w, h.c.maxBody, nil)
+	start := h.s.now()
+	h.next.ServeHTTP(rec, r)
+	rec.finish()
+	if !rec.overflow {
+		h.store(r, primary, rec.status, rec.header, rec.body.Bytes(), start, h.s.now())
+	}
+}
+
+// validate returns a conditional GET request validating e.
+func validate(ctx context.Context, r *http.Request, e *entry) *http.Request {
+	out := r.Clone(ctx)
+	out.Method = http.MethodGet
+	out.Header.Del("If-None-Match")
+	out.Header.Del("If-Modified-Since")
+	out.Header.Del("If-Match")
+	out.Header.Del("If-Unmodified-Since")
+	out.Header.Del("If-Range")
+	if v := e.header.Get("Etag"); v != "" {
+		out.Header.Set("If-None-Match", v)
+	}
+	if v := e.header.Get("Last-Modified"); v != "" {
+		out.Header.Set("If-Modified-Since", v)
+	}
+	return out
+}
+
+// revalidate serves e after the upstream confirmed it did not change. The stale
+// e is served when the upstream fails within stale-if-error, other responses
+// replace e.
+func (h *handler) revalidate(w http.ResponseWriter, r *http.Request, primary string, e *entry, age time.Duration) {
+	out := validate(r.Context(), r, e)
+	staleOnError := !e.mustRevalidate && e.stale(age) < e.sie
+	rec := newRecorder(w, h.c.maxBody, func(code int) bool {
+		return code == http.StatusNotModified || (staleOnError && code >= 500)
+	})
+	start := h.s.now()
+	h.next.ServeHTTP(rec, out)
+	rec.finish()
+	end := h.s.now()
+	switch {
+	case rec.held && rec.status == http.StatusNotModified:
+		x := h.store(out, primary, e.status, refresh(e.header, rec.header), e.body, start, end)
+		h.serve(w, r, x, x.age(end), api.AccessEntry_REVALIDATED)
+	case rec.held:
+		h.serve(w, r, e, age, api.AccessEntry_STALE)
+	default:
+		h.setStatus(r, api.AccessEntry_MISS)
+		if !rec.overflow {
+			h.store(out, primary, rec.status, rec.header, rec.body.Bytes(), start, end)
+		}
+	}
+}
+
+// revalidateAsync revalidates e in the background, only one revalidation of a
+// response runs at a time.
+func (h *handler) revalidateAsync(r *http.Request, primary, key string, e *entry) {
+	h.s.mu.Lock()
+	if h.s.revalidating[key] {
+		h.s.mu.Unlock()
+		return
+	}
+	h.s.revalidating[key] = true
+	h.s.mu.Unlock()
+	// the request is done by the time revalidation completes, its context
+	// carries the access entry that is released with it.
+	ctx, cancel := context.WithTimeout(context.Background(), revalidateTimeout)
+	out := validate(ctx, r, e)
+	go func() {
+		defer func() {
+			cancel()
+			h.s.mu.Lock()
+			delete(h.s.revalidating, key)
+			h.s.mu.Unlock()
+		}()
+		rec := newRecorder(discard{}, h.c.maxBody, func(int) bool { return true })
+		start := h.s.now()
+		h.next.ServeHTTP(rec, out)
+		rec.finish()
+		end := h.s.now()
+		switch {
+		case rec.status == http.StatusNotModified:
+			h.store(out, primary, e.status, refresh(e.header, rec.header), e.body, start, end)
+		case rec.status < 500 && !rec.overflow:
+			h.store(out, primary, rec.status, rec.header, rec.body.Bytes(), start, end)
+		}
+	}()
+}
+
+// refresh returns stored headers updated with headers of a 304 response, as in
+// RFC 9111 section 3.2.
+func refresh(stored, notModified http.Header) http.Header {
+	h := stored.Clone()
+	for k, v := range notModified {
+		switch k {
+		case "Content-Length", "Content-Encoding", "Content-Type", "Content-Range", "Transfer-Encoding":
+			continue
+		}
+		h[k] = v
+	}
+	return h
+}
+
+// serve answers r with the cached response e.
+func (h *handler) serve(w http.ResponseWriter, r *http.Request, e *entry, age time.Duration, status api.AccessEntry_CacheStatus) {
+	h.setStatus(r, status)
+	dst := w.Header()
+	for k, v := range e.header {
+		dst[k] = append([]string(nil), v...)
+	}
+	dst.Set("Age", strconv.FormatInt(int64(age/time.Second), 10))
+	if notModified(r, e) {
+		dst.Del("Content-Length")
+		dst.Del("Content-Type")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(e.status)
+	if r.Method != http.MethodHead {
+		w.Write(e.body)
+	}
+}
+
+func (h *handler) setStatus(r *http.Request, status api.AccessEntry_CacheStatus) {
+	totalRequests.WithLabelValues(h.c.route, strings.ToLower(status.String())).Inc()
+	if m := meta.GetMetics(r.Context()); m != nil {
+		m.Cache = status
+	}
+}
@@ -0,0 +1,242 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+)
+
+type testCache struct {
+	s     *Store
+	h     http.Handler
+	now   time.Time
+	calls atomic.Int32
+}
+
+func newTestCache(t *testing.T, o *api.Middleware_Cache, fn http.HandlerFunc) *testCache {
+	t.Helper()
+	c, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,
+		MaxCost:     1 << 20,
+		BufferItems: 64,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Close)
+	s := New(c)
+	x := &testCache{s: s, now: time.Unix(1000, 0)}
+	s.now = func() time.Time { return x.now }
+	x.h = s.Handler("cache", o)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		x.calls.Add(1)
+		fn(w, r)
+	}))
+	return x
+}
+
+func (x *testCache) do(t *testing.T, method, path string, header ...string) (*httptest.ResponseRecorder, api.AccessEntry_CacheStatus) {
+	t.Helper()
+	r := httptest.NewRequest(method, path, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	e := &api.AccessEntry{}
+	w := httptest.NewRecorder()
+	x.h.ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), e)))
+	x.s.cache.Wait()
+	return w, e.Cache
+}
+
+func (x *testCache) expect(t *testing.T, path string, status api.AccessEntry_CacheStatus, body string, header ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	w, got := x.do(t, http.MethodGet, path, header...)
+	if got != status || w.Body.String() != body {
+		t.Fatalf("expected %v %q got %v %q", status, body, got, w.Body)
+	}
+	return w
+}
+
+func TestCache(t *testing.T) {
+	var version atomic.Int32
+	x := newTestCache(t, &api.Middleware_Cache{}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/private":
+			w.Header().Set("Cache-Control", "private, max-age=60")
+		case "/vary":
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.Header().Set("Vary", "Accept-Language")
+			w.Write([]byte(r.Header.Get("Accept-Language")))
+			return
+		default:
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.Header().Set("Etag", `"v1"`)
+		}
+		if version.Load() > 0 {
+			w.Write([]byte("new"))
+			return
+		}
+		w.Write([]byte("hello"))
+	})
+
+	x.expect(t, "/", api.AccessEntry_MISS, "hello")
+	x.now = x.now.Add(10 * time.Second)
+	w := x.expect(t, "/", api.AccessEntry_HIT, "hello")
+	if age := w.Header().Get("Age"); age != "10" {
+		t.Errorf("expected age 10 got %q", age)
+	}
+	if w, _ := x.do(t, http.MethodGet, "/", "If-None-Match", `"v1"`); w.Code != http.StatusNotModified {
+		t.Errorf("expected 304 got %d", w.Code)
+	}
+	if w, status := x.do(t, http.MethodHead, "/"); status != api.AccessEntry_HIT || w.Body.Len() != 0 {
+		t.Errorf("expected HEAD to hit without body got %v %q", status, w.Body)
+	}
+	x.expect(t, "/", api.AccessEntry_BYPASS, "hello", "Cache-Control", "no-store")
+	x.expect(t, "/?q=1", api.AccessEntry_MISS, "hello")
+
+	x.expect(t, "/private", api.AccessEntry_MISS, "hello")
+	x.expect(t, "/private", api.AccessEntry_MISS, "hello")
+
+	x.expect(t, "/vary", api.AccessEntry_MISS, "en", "Accept-Language", "en")
+	x.expect(t, "/vary", api.AccessEntry_MISS, "fr", "Accept-Language", "fr")
+	x.expect(t, "/vary", api.AccessEntry_HIT, "en", "Accept-Language", "en")
+
+	version.Store(1)
+	if w, _ := x.do(t, http.MethodPost, "/"); w.Code != http.StatusOK {
+		t.Fatalf("expected 200 got %d", w.Code)
+	}
+	x.expect(t, "/", api.AccessEntry_MISS, "new")
+}
+
+func TestCacheRevalidate(t *testing.T) {
+	var fail atomic.Bool
+	x := newTestCache(t, &api.Middleware_Cache{
+		StaleIfError: ptypes.DurationProto(time.Minute),
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Cache-Control", "max-age=10")
+		w.Header().Set("Etag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte("hello"))
+	})
+	x.expect(t, "/", api.AccessEntry_MISS, "hello")
+	x.now = x.now.Add(20 * time.Second)
+	w := x.expect(t, "/", api.AccessEntry_REVALIDATED, "hello")
+	if age := w.Header().Get("Age"); age != "0" {
+		t.Errorf("expected revalidated response to be fresh got age %q", age)
+	}
+	x.expect(t, "/", api.AccessEntry_HIT, "hello")
+
+	fail.Store(true)
+	x.now = x.now.Add(20 * time.Second)
+	x.expect(t, "/", api.AccessEntry_STALE, "hello")
+	x.now = x.now.Add(time.Minute)
+	if w, status := x.do(t, http.MethodGet, "/"); w.Code != http.StatusBadGateway || status != api.AccessEntry_MISS {
+		t.Errorf("expected 502 after stale-if-error got %d %v", w.Code, status)
+	}
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	var version atomic.Int32
+	x := newTestCache(t, &api.Middleware_Cache{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=10, stale-while-revalidate=30")
+		if version.Load() > 0 {
+			w.Write([]byte("new"))
+			return
+		}
+		w.Write([]byte("hello"))
+	})
+	x.expect(t, "/", api.AccessEntry_MISS, "hello")
+	version.Store(1)
+	x.now = x.now.Add(20 * time.Second)
+	x.expect(t, "/", api.AccessEntry_STALE, "hello")
+	for i := 0; ; i++ {
+		x.s.mu.Lock()
+		n := len(x.s.revalidating)
+		x.s.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatal("revalidation did not complete")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	x.s.cache.Wait()
+	x.expect(t, "/", api.AccessEntry_HIT, "new")
+	if n := x.calls.Load(); n != 2 {
+		t.Errorf("expected 2 upstream requests got %d", n)
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	x := newTestCache(t, &api.Middleware_Cache{
+		Key: &api.Middleware_Cache_Key{IgnoreQuery: true},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Write([]byte("hello"))
+	})
+	x.expect(t, "/a", api.AccessEntry_MISS, "hello")
+	x.expect(t, "/b?q=1", api.AccessEntry_MISS, "hello")
+	x.expect(t, "/b?q=2", api.AccessEntry_HIT, "hello")
+
+	if err := x.s.Purge(nil, []string{"http://example.com/a"}); err != nil {
+		t.Fatal(err)
+	}
+	x.s.cache.Wait()
+	x.expect(t, "/a", api.AccessEntry_MISS, "hello")
+	x.expect(t, "/b", api.AccessEntry_HIT, "hello")
+
+	if err := x.s.Purge([]string{"cache"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	x.expect(t, "/b", api.AccessEntry_MISS, "hello")
+	if err := x.s.Purge([]string{"missing"}, nil); err == nil {
+		t.Error("expected an error purging a route without cache")
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	c := &config{defaultTTL: time.Minute}
+	end := time.Unix(1000, 0)
+	date := end.Add(-10 * time.Second).UTC().Format(http.TimeFormat)
+	sample := []struct {
+		desc     string
+		header   http.Header
+		status   int
+		lifetime time.Duration
+		age      time.Duration
+		ok       bool
+	}{
+		{"s-maxage", http.Header{"Cache-Control": {"max-age=10, s-maxage=20"}}, 200, 20 * time.Second, 0, true},
+		{"expires", http.Header{"Date": {date}, "Expires": {end.Add(20 * time.Second).UTC().Format(http.TimeFormat)}}, 200, 30 * time.Second, 10 * time.Second, true},
+		{"age", http.Header{"Cache-Control": {"max-age=10"}, "Age": {"5"}}, 200, 10 * time.Second, 5 * time.Second, true},
+		{"last modified", http.Header{"Date": {date}, "Last-Modified": {end.Add(-110 * time.Second).UTC().Format(http.TimeFormat)}}, 200, 10 * time.Second, 10 * time.Second, true},
+		{"default ttl", http.Header{}, 200, time.Minute, 0, true},
+		{"not heuristic", http.Header{}, 500, 0, 0, false},
+		{"no-store", http.Header{"Cache-Control": {"no-store, max-age=10"}}, 200, 10 * time.Second, 0, false},
+		{"set cookie", http.Header{"Cache-Control": {"max-age=10"}, "Set-Cookie": {"a=b"}}, 200, 10 * time.Second, 0, false},
+		{"vary all", http.Header{"Cache-Control": {"max-age=10"}, "Vary": {"*"}}, 200, 10 * time.Second, 0, false},
+	}
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			e, ok := c.newEntry(r, s.status, s.header, nil, end, end)
+			if ok != s.ok || e.lifetime != s.lifetime || e.age(end) != s.age {
+				t.Errorf("expected %v %v %v got %v %v %v", s.ok, s.lifetime, s.age, ok, e.lifetime, e.age(end))
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package httpcache
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxDeltaSeconds is the largest delta-seconds value, larger values are capped
+// as RFC 9111 section 1.2.2 requires.
+const maxDeltaSeconds = 1<<31 - 1
+
+// directives are parsed Cache-Control directives keyed by lower case name.
+type directives map[string]string
+
+func parseDirectives(h http.Header) directives {
+	d := directives{}
+	for _, line := range h.Values("Cache-Control") {
+		for _, part := range strings.Split(line, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			k, v, _ := strings.Cut(part, "=")
+			d[strings.ToLower(strings.TrimSpace(k))] = strings.Trim(strings.TrimSpace(v), `"`)
+		}
+	}
+	return d
+}
+
+func (d directives) has(name string) bool {
+	_, ok := d[name]
+	return ok
+}
+
+// seconds returns the delta-seconds value of directive name. Invalid values
+// are zero.
+func (d directives) seconds(name string) (time.Duration, bool) {
+	v, ok := d[name]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return 0, true
+	}
+	if n > maxDeltaSeconds {
+		n = maxDeltaSeconds
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+// heuristic are status codes of responses that can be cached without explicit
+// freshness.
+var heuristic = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusNoContent:            true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusPermanentRedirect:    true,
+	http.StatusNotFound:             true,
+	http.StatusMethodNotAllowed:     true,
+	http.StatusGone:                 true,
+	http.StatusRequestURITooLong:    true,
+	http.StatusNotImplemented:       true,
+}
+
+// entry is a cached response.
+type entry struct {
+	status int
+	header http.Header
+	body   []byte
+	// responseTime is when the response was received or last revalidated.
+	responseTime time.Time
+	// initialAge is the corrected initial age of the response.
+	initialAge time.Duration
+	lifetime   time.Duration
+	// stale responses are served while they are revalidated for swr and when
+	// revalidating fails for sie.
+	swr, sie time.Duration
+	// noCache responses are revalidated before they are served.
+	noCache bool
+	// mustRevalidate responses are never served stale.
+	mustRevalidate bool
+}
+
+// age returns the current age of e as in RFC 9111 section 4.2.3.
+func (e *entry) age(now time.Time) time.Duration {
+	return e.initialAge + now.Sub(e.responseTime)
+}
+
+// fresh returns true when e can be served at age without revalidation.
+func (e *entry) fresh(age time.Duration, req directives) bool {
+	if e.noCache || req.has("no-cache") {
+		return false
+	}
+	lifetime := e.lifetime
+	if v, ok := req.seconds("max-age"); ok && v < lifetime {
+		lifetime = v
+	}
+	if v, ok := req.seconds("min-fresh"); ok {
+		age += v
+	}
+	return age < lifetime
+}
+
+// stale returns how long e has been stale at age.
+func (e *entry) stale(age time.Duration) time.Duration {
+	return age - e.lifetime
+}
+
+func (e *entry) validators() bool {
+	return e.header.Get("Etag") != "" || e.header.Get("Last-Modified") != ""
+}
+
+// keep returns how long e is kept in the cache.
+func (e *entry) keep() time.Duration {
+	extra := e.swr
+	if e.sie > extra {
+		extra = e.sie
+	}
+	if e.mustRevalidate {
+		extra = 0
+	}
+	if e.validators() && extra < revalidateWindow {
+		extra = revalidateWindow
+	}
+	return e.lifetime + extra
+}
+
+func (e *entry) cost() int64 {
+	n := len(e.body)
+	for k, v := range e.header {
+		n += len(k)
+		for _, x := range v {
+			n += len(x)
+		}
+	}
+	return int64(n)
+}
+
+// newEntry returns the cache entry of a response to r received between start
+// and end. It returns false when the response can't be stored.
+func (c *config) newEntry(r *http.Request, status int, header http.Header, body []byte, start, end time.Time) (*entry, bool) {
+	res := parseDirectives(header)
+	e := &entry{
+		status:         status,
+		header:         header,
+		body:           body,
+		responseTime:   end,
+		swr:            c.swr,
+		sie:            c.sie,
+		noCache:        res.has("no-cache"),
+		mustRevalidate: res.has("must-revalidate") || res.has("proxy-revalidate") || res.has("s-maxage"),
+	}
+	date := end
+	if v, err := http.ParseTime(header.Get("Date")); err == nil {
+		date = v
+	}
+	apparent := end.Sub(date)
+	if apparent < 0 {
+		apparent = 0
+	}
+	age, _ := strconv.ParseInt(header.Get("Age"), 10, 64)
+	corrected := time.Duration(age)*time.Second + end.Sub(start)
+	if corrected < 0 {
+		corrected = 0
+	}
+	e.initialAge = apparent
+	if corrected > apparent {
+		e.initialAge = corrected
+	}
+	explicit := true
+	if v, ok := res.seconds("s-maxage"); ok {
+		e.lifetime = v
+	} else if v, ok := res.seconds("max-age"); ok {
+		e.lifetime = v
+	} else if v := header.Get("Expires"); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			e.lifetime = t.Sub(date)
+		}
+	} else {
+		explicit = false
+		if heuristic[status] || res.has("public") {
+			if lm, err := http.ParseTime(header.Get("Last-Modified")); err == nil && lm.Before(date) {
+				e.lifetime = date.Sub(lm) / 10
+			} else {
+				e.lifetime = c.defaultTTL
+			}
+		}
+	}
+	if e.lifetime < 0 {
+		e.lifetime = 0
+	}
+	if c.maxTTL > 0 && e.lifetime > c.maxTTL {
+		e.lifetime = c.maxTTL
+	}
+	if v, ok := res.seconds("stale-while-revalidate"); ok {
+		e.swr = v
+	}
+	if v, ok := res.seconds("stale-if-error"); ok {
+		e.sie = v
+	}
+	return e, c.storable(r, e, res, explicit)
+}
+
+// storable returns true when e can be stored by a shared cache, as in RFC 9111
+// section 3.
+func (c *config) storable(r *http.Request, e *entry, res directives, explicit bool) bool {
+	switch {
+	case r.Method != http.MethodGet,
+		e.status < 200,
+		e.status == http.StatusPartialContent,
+		e.status == http.StatusNotModified,
+		res.has("no-store"),
+		res.has("private"),
+		e.header.Get("Set-Cookie") != "",
+		e.header.Get("Vary") == "*":
+		return false
+	}
+	if r.Header.Get("Authorization") != "" &&
+		!res.has("public") && !res.has("must-revalidate") && !res.has("s-maxage") {
+		return false
+	}
+	if !explicit && !heuristic[e.status] && !res.has("public") {
+		return false
+	}
+	return e.lifetime > 0 || e.validators()
+}
+
+// notModified returns true when the conditional request r is answered with
+// 304 for e, as in RFC 9110 section 13.2.2.
+func notModified(r *http.Request, e *entry) bool {
+	if e.status != http.StatusOK {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		etag := strings.TrimPrefix(e.header.Get("Etag"), "W/")
+		if etag == "" {
+			return false
+		}
+		for _, v := range strings.Split(inm, ",") {
+			v = strings.TrimSpace(v)
+			if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lm, err := http.ParseTime(e.header.Get("Last-Modified"))
+	return err == nil && !lm.After(ims)
+}
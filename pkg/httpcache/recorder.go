@@ -0,0 +1,111 @@
+package httpcache
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// recorder records responses written to w so they can be cached. Responses the
+// hold function returns true for are only recorded, they are not written to w.
+type recorder struct {
+	w    http.ResponseWriter
+	hold func(code int) bool
+	// header of the response, the response is written to w with a copy.
+	header http.Header
+	status int
+	held   bool
+	body   bytes.Buffer
+	// bodies larger than max are not recorded.
+	max      int64
+	overflow bool
+}
+
+func newRecorder(w http.ResponseWriter, max int64, hold func(code int) bool) *recorder {
+	return &recorder{
+		w:      w,
+		hold:   hold,
+		header: make(http.Header),
+		max:    max,
+	}
+}
+
+func (rw *recorder) Header() http.Header {
+	if rw.status != 0 && !rw.held {
+		// trailers are set after the header was written.
+		return rw.w.Header()
+	}
+	return rw.header
+}
+
+func (rw *recorder) WriteHeader(code int) {
+	if rw.status != 0 {
+		return
+	}
+	if code < 200 {
+		// informational responses are passed through
+		if rw.hold == nil {
+			copyHeader(rw.w.Header(), rw.header)
+			rw.w.WriteHeader(code)
+		}
+		return
+	}
+	rw.status = code
+	rw.header = rw.header.Clone()
+	if rw.hold != nil && rw.hold(code) {
+		rw.held = true
+		return
+	}
+	copyHeader(rw.w.Header(), rw.header)
+	rw.w.WriteHeader(code)
+}
+
+func (rw *recorder) Write(b []byte) (int, error) {
+	if rw.status == 0 {
+		rw.WriteHeader(http.StatusOK)
+	}
+	if !rw.overflow {
+		if int64(rw.body.Len()+len(b)) > rw.max {
+			rw.overflow = true
+			rw.body = bytes.Buffer{}
+		} else {
+			rw.body.Write(b)
+		}
+	}
+	if rw.held {
+		return len(b), nil
+	}
+	return rw.w.Write(b)
+}
+
+func (rw *recorder) Flush() {
+	if rw.status == 0 || rw.held {
+		return
+	}
+	if f, ok := rw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rw *recorder) Unwrap() http.ResponseWriter {
+	return rw.w
+}
+
+// finish writes the header when the handler wrote nothing.
+func (rw *recorder) finish() {
+	if rw.status == 0 {
+		rw.WriteHeader(http.StatusOK)
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+// discard is a response writer dropping responses.
+type discard struct{}
+
+func (discard) Header() http.Header         { return http.Header{} }
+func (discard) Write(b []byte) (int, error) { return len(b), nil }
+func (discard) WriteHeader(int)             {}
@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+)
+
+// listeners keeps track of listeners opened with ListenBind and packet
+// connections opened with ListenPacketBind so they can be handed over to a new
+// process, and sockets inherited from a previous process that are waiting to be
+// picked up.
+var listeners = &registry{
+	active:          make(map[string]*tracked),
+	inherited:       make(map[string][]net.Listener),
+	activePackets:   make(map[string]*trackedPacket),
+	inheritedPacket: make(map[string]*net.UDPConn),
+}
+
+type registry struct {
+	mu              sync.Mutex
+	active          map[string]*tracked
+	inherited       map[string][]net.Listener
+	activePackets   map[string]*trackedPacket
+	inheritedPacket map[string]*net.UDPConn
+}
+
+func (r *registry) track(hostPort string, ln net.Listener) net.Listener {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := &tracked{Listener: ln, hostPort: hostPort}
+	r.active[hostPort] = t
+	return t
+}
+
+func (r *registry) take(hostPort string) []net.Listener {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ls := r.inherited[hostPort]
+	delete(r.inherited, hostPort)
+	return ls
+}
+
+func (r *registry) trackPacket(hostPort string, conn *net.UDPConn) *trackedPacket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := &trackedPacket{UDPConn: conn, hostPort: hostPort}
+	r.activePackets[hostPort] = t
+	return t
+}
+
+func (r *registry) takePacket(hostPort string) *net.UDPConn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conn := r.inheritedPacket[hostPort]
+	delete(r.inheritedPacket, hostPort)
+	return conn
+}
+
+func (r *registry) removePacket(t *trackedPacket) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.activePackets[t.hostPort] == t {
+		delete(r.activePackets, t.hostPort)
+	}
+}
+
+func (r *registry) remove(t *tracked) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active[t.hostPort] == t {
+		delete(r.active, t.hostPort)
+	}
+}
+
+// tracked removes the listener from the registry when closed.
+type tracked struct {
+	net.Listener
+	hostPort string
+	once     sync.Once
+}
+
+func (t *tracked) Close() error {
+	t.once.Do(func() {
+		listeners.remove(t)
+	})
+	return t.Listener.Close()
+}
+
+// trackedPacket removes the packet connection from the registry when closed.
+// It embeds *net.UDPConn so that users like quic-go can still use the
+// optimizations of UDP sockets.
+type trackedPacket struct {
+	*net.UDPConn
+	hostPort string
+	once     sync.Once
+}
+
+func (t *trackedPacket) Close() error {
+	t.once.Do(func() {
+		listeners.removePacket(t)
+	})
+	return t.UDPConn.Close()
+}
+
+// ListenerFiles returns duplicates of the sockets of all active listeners keyed
+// by the host:port they were opened with. Sockets of packet connections are
+// keyed by host:port with a udp: prefix. Unix socket files are no longer
+// removed when the listeners are closed, so that a new process can keep serving
+// on them.
+//
+// The caller is responsible for closing the returned files.
+func ListenerFiles() (map[string][]*os.File, error) {
+	listeners.mu.Lock()
+	defer listeners.mu.Unlock()
+	m := make(map[string][]*os.File)
+	closeAll := func() {
+		for _, fs := range m {
+			for _, f := range fs {
+				f.Close()
+			}
+		}
+	}
+	for k, v := range listeners.active {
+		files, err := socketFiles(v.Listener)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("proxy: failed to get socket of %s %v", k, err)
+		}
+		m[k] = files
+	}
+	for k, v := range listeners.activePackets {
+		f, err := v.UDPConn.File()
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("proxy: failed to get socket of udp %s %v", k, err)
+		}
+		m[udpPrefix+k] = []*os.File{f}
+	}
+	return m, nil
+}
+
+func socketFiles(ln net.Listener) ([]*os.File, error) {
+	switch e := ln.(type) {
+	case *tracked:
+		return socketFiles(e.Listener)
+	case *noDelayListener:
+		return socketFiles(e.Listener)
+	case *acceptors:
+		var files []*os.File
+		for _, l := range e.ls {
+			f, err := socketFiles(l)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, f...)
+		}
+		return files, nil
+	case *net.UnixListener:
+		e.SetUnlinkOnClose(false)
+		f, err := e.File()
+		if err != nil {
+			return nil, err
+		}
+		return []*os.File{f}, nil
+	case interface{ File() (*os.File, error) }:
+		f, err := e.File()
+		if err != nil {
+			return nil, err
+		}
+		return []*os.File{f}, nil
+	default:
+		return nil, fmt.Errorf("unsupported listener %T", ln)
+	}
+}
+
+// Inherit makes sockets passed from a previous process available to
+// ListenBind and ListenPacketBind. files are keyed by host:port as returned by
+// ListenerFiles.
+func Inherit(files map[string][]*os.File) error {
+	listeners.mu.Lock()
+	defer listeners.mu.Unlock()
+	for k, fs := range files {
+		if strings.HasPrefix(k, udpPrefix) {
+			if err := inheritPacket(strings.TrimPrefix(k, udpPrefix), fs); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, f := range fs {
+			ln, err := net.FileListener(f)
+			f.Close()
+			if err != nil {
+				return err
+			}
+			listeners.inherited[k] = append(listeners.inherited[k], ln)
+		}
+	}
+	return nil
+}
+
+func inheritPacket(hostPort string, files []*os.File) error {
+	for _, f := range files {
+		conn, err := net.FilePacketConn(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		udp, ok := conn.(*net.UDPConn)
+		if !ok {
+			conn.Close()
+			return fmt.Errorf("proxy: inherited socket of udp %s is not a udp socket", hostPort)
+		}
+		if old := listeners.inheritedPacket[hostPort]; old != nil {
+			old.Close()
+		}
+		listeners.inheritedPacket[hostPort] = udp
+	}
+	return nil
+}
+
+// CloseInherited closes inherited sockets that were not picked up by
+// ListenBind or ListenPacketBind, this happens when the bind was removed from
+// configuration.
+func CloseInherited() {
+	listeners.mu.Lock()
+	defer listeners.mu.Unlock()
+	for k, ls := range listeners.inherited {
+		for _, l := range ls {
+			l.Close()
+		}
+		delete(listeners.inherited, k)
+	}
+	for k, conn := range listeners.inheritedPacket {
+		conn.Close()
+		delete(listeners.inheritedPacket, k)
+	}
+}
@@ -0,0 +1,182 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/gernest/tt/api"
+)
+
+// ListenFunc returns a function with the same signature as net.Listen that
+// applies socket options o to the listeners it creates. It is used as the
+// default Proxy.ListenFunc for binds with options.
+func ListenFunc(o *api.Bind_ListenOptions) func(network, address string) (net.Listener, error) {
+	if o == nil {
+		return net.Listen
+	}
+	return func(network, address string) (net.Listener, error) {
+		isTCP := strings.HasPrefix(network, "tcp")
+		lc := net.ListenConfig{}
+		if isTCP {
+			lc.Control = control(o)
+		}
+		n := int(o.Acceptors)
+		if n < 1 || !isTCP {
+			n = 1
+		}
+		ls := make([]net.Listener, 0, n)
+		closeAll := func() {
+			for _, l := range ls {
+				l.Close()
+			}
+		}
+		for i := 0; i < n; i++ {
+			ln, err := lc.Listen(context.Background(), network, address)
+			if err != nil {
+				closeAll()
+				return nil, err
+			}
+			ls = append(ls, ln)
+			if o.Backlog > 0 {
+				if err := setBacklog(ln, int(o.Backlog)); err != nil {
+					closeAll()
+					return nil, err
+				}
+			}
+			// when listening on port 0 the remaining sockets must share the port
+			// picked for the first one.
+			address = ln.Addr().String()
+		}
+		return wrap(ls, o), nil
+	}
+}
+
+// listenPacket opens a UDP socket on address applying socket options o. Options
+// that only apply to TCP are ignored.
+func listenPacket(address string, o *api.Bind_ListenOptions) (*net.UDPConn, error) {
+	lc := net.ListenConfig{}
+	if o != nil {
+		lc.Control = control(o)
+	}
+	conn, err := lc.ListenPacket(context.Background(), "udp", address)
+	if err != nil {
+		return nil, err
+	}
+	return conn.(*net.UDPConn), nil
+}
+
+// wrap returns a single listener over sockets ls bound to the same address.
+func wrap(ls []net.Listener, o *api.Bind_ListenOptions) net.Listener {
+	if v := o.GetTcpNoDelay(); v != nil {
+		for i, l := range ls {
+			if _, ok := l.(*net.TCPListener); ok {
+				ls[i] = &noDelayListener{Listener: l, noDelay: v.Value}
+			}
+		}
+	}
+	if len(ls) > 1 {
+		return &acceptors{ls: ls, done: make(chan struct{})}
+	}
+	return ls[0]
+}
+
+// Sockets returns the listeners of the sockets of ln returned by ListenBind.
+// Binds with acceptors have a socket for each acceptor, serving each socket
+// from its own accept loop spreads accepting connections across goroutines
+// and the kernel balances connections between the sockets. Closing ln closes
+// all of them.
+func Sockets(ln net.Listener) []net.Listener {
+	switch e := ln.(type) {
+	case *tracked:
+		return Sockets(e.Listener)
+	case *acceptors:
+		return e.ls
+	default:
+		return []net.Listener{ln}
+	}
+}
+
+type noDelayListener struct {
+	net.Listener
+	noDelay bool
+}
+
+func (n *noDelayListener) Accept() (net.Conn, error) {
+	c, err := n.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if tc, ok := c.(*net.TCPConn); ok {
+		tc.SetNoDelay(n.noDelay)
+	}
+	return c, nil
+}
+
+type acceptResult struct {
+	conn net.Conn
+	err  error
+}
+
+// acceptors is a listener over multiple sockets bound to the same address.
+// Servers use Sockets to run an accept loop for each socket, Accept is for
+// users that can't and merges connections of all sockets.
+type acceptors struct {
+	ls    []net.Listener
+	conns chan acceptResult
+	done  chan struct{}
+	start sync.Once
+	once  sync.Once
+}
+
+func (a *acceptors) accept(l net.Listener) {
+	for {
+		c, err := l.Accept()
+		select {
+		case a.conns <- acceptResult{conn: c, err: err}:
+		case <-a.done:
+			if c != nil {
+				c.Close()
+			}
+			return
+		}
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+	}
+}
+
+func (a *acceptors) Accept() (net.Conn, error) {
+	a.start.Do(func() {
+		a.conns = make(chan acceptResult)
+		for _, l := range a.ls {
+			go a.accept(l)
+		}
+	})
+	select {
+	case r := <-a.conns:
+		return r.conn, r.err
+	case <-a.done:
+		return nil, &net.OpError{Op: "accept", Net: a.Addr().Network(), Addr: a.Addr(), Err: net.ErrClosed}
+	}
+}
+
+func (a *acceptors) Close() (err error) {
+	a.once.Do(func() {
+		close(a.done)
+		for _, l := range a.ls {
+			if e := l.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	})
+	return
+}
+
+func (a *acceptors) Addr() net.Addr {
+	return a.ls[0].Addr()
+}
@@ -5,17 +5,15 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
-	"io/fs"
 	"io/ioutil"
 	"os"
-	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/gernest/tt/api"
 	accesslog "github.com/gernest/tt/pkg/access_log"
 	"github.com/gernest/tt/pkg/metrics/tseries"
-	"github.com/golang/protobuf/jsonpb"
 	"github.com/urfave/cli"
 )
 
@@ -33,7 +31,18 @@ type Options struct {
 	Metrics               tseries.Config    `json:",omitempty"`
 	Wasm                  Wasm              `json:",omitempty"`
 	AccessLog             accesslog.Options `json:",omitempty"`
+	DrainTimeout          time.Duration     `json:",omitempty"`
+	RoutesDebounce        time.Duration     `json:",omitempty"`
+	MaxMatchingConns      int               `json:",omitempty"`
 	Routes                api.Config        `json:"-"`
+	Store                 Store             `json:"-"`
+}
+
+// Store reads values replicated to all nodes of the cluster.
+type Store interface {
+	Get(key string) ([]byte, error)
+	// Watch calls fn with keys that were set. fn must not block.
+	Watch(fn func(key string))
 }
 
 func (o *Options) Save(to string) error {
@@ -45,30 +54,15 @@ func (o *Options) setuproutes() error {
 	if o.RoutesPath == "" {
 		return nil
 	}
-	// o.Save("config.json")
-	var m jsonpb.Unmarshaler
-	return filepath.Walk(o.RoutesPath, func(path string, info fs.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() {
-			return nil
-		}
-		if filepath.Ext(path) != ".json" {
-			return nil
-		}
-		f, err := os.Open(path)
-		if err != nil {
-			return err
-		}
-		var r api.Route
-		err = m.Unmarshal(f, &r)
-		if err != nil {
-			return err
-		}
-		o.Routes.Routes = append(o.Routes.Routes, &r)
+	routes, err := LoadRoutes(o.RoutesPath)
+	if err != nil {
 		return err
-	})
+	}
+	if err := ValidateRoutes(routes); err != nil {
+		return err
+	}
+	o.Routes.Routes = append(o.Routes.Routes, routes...)
+	return nil
 }
 
 type Info struct {
@@ -152,6 +146,24 @@ func (o *Options) baseFlags() flagList {
 				Usage:  "path to the routes config file",
 				EnvVar: "TT_ROUTES_CONFIG",
 			},
+			cli.DurationFlag{
+				Name:   "routes-debounce",
+				Usage:  "Time to wait for changes to routes-path to settle before reloading routes",
+				EnvVar: "TT_ROUTES_DEBOUNCE",
+				Value:  time.Second,
+			},
+			cli.IntFlag{
+				Name:   "max-matching-conns",
+				Usage:  "Maximum number of tcp connections waiting to match a route, 0 means no limit",
+				EnvVar: "TT_MAX_MATCHING_CONNS",
+				Value:  10000,
+			},
+			cli.DurationFlag{
+				Name:   "drain-timeout",
+				Usage:  "Time to wait for active connections to finish when upgrading",
+				EnvVar: "TT_DRAIN_TIMEOUT",
+				Value:  30 * time.Second,
+			},
 		}
 	})
 }
@@ -205,6 +217,9 @@ func (o *Options) base() parser {
 		o.Join = ctx.GlobalString("join")
 		o.RoutesPath = ctx.GlobalString("routes-path")
 		o.WorkDir = ctx.GlobalString("work-dir")
+		o.DrainTimeout = ctx.GlobalDuration("drain-timeout")
+		o.RoutesDebounce = ctx.GlobalDuration("routes-debounce")
+		o.MaxMatchingConns = ctx.GlobalInt("max-matching-conns")
 		_, err := os.Stat(o.WorkDir)
 		if err != nil {
 			if os.IsNotExist(err) {
@@ -324,6 +339,38 @@ func (c Cache) Config() *ristretto.Config {
 	}
 }
 
+// Evicter is implemented by cached values that need to know when they leave
+// the cache.
+type Evicter interface {
+	Evicted()
+}
+
+// New returns the cache configured by c. One cache is shared by all proxies so
+// MaxCost bounds everything they cache.
+func (c Cache) New() (*ristretto.Cache, error) {
+	cfg := c.Config()
+	cfg.OnExit = func(v interface{}) {
+		if e, ok := v.(Evicter); ok {
+			e.Evicted()
+		}
+	}
+	return ristretto.NewCache(cfg)
+}
+
+type cacheKey struct{}
+
+// SetCache returns ctx with the cache shared by all proxies.
+func SetCache(ctx context.Context, c *ristretto.Cache) context.Context {
+	return context.WithValue(ctx, cacheKey{}, c)
+}
+
+// GetCache returns the cache shared by all proxies or nil when caching is
+// disabled.
+func GetCache(ctx context.Context) *ristretto.Cache {
+	c, _ := ctx.Value(cacheKey{}).(*ristretto.Cache)
+	return c
+}
+
 func (c *Cache) Parse(ctx *cli.Context) error {
 	c.Enabled = ctx.Bool("cache_enabled")
 	c.NumCounters = ctx.GlobalInt64("cache_num_counters")
@@ -400,9 +447,17 @@ type Proxy interface {
 	// Boot starts the proxy. This must be blocking and only returns when there
 	// is an error or when ctx has been cancelled.
 	Boot(ctx context.Context, config *Options) error
+	// Shutdown stops accepting new connections and waits for active connections
+	// to finish. Connections that are still active when ctx is done are closed.
+	Shutdown(ctx context.Context) error
 	Close() error
 }
 
+// Purger is implemented by proxies caching responses.
+type Purger interface {
+	Purge(ctx context.Context, req *api.PurgeRequest) error
+}
+
 // Config defines methods for dynamic configuration of the proxies.
 type Config interface {
 	Get(ctx context.Context) (*api.Config, error)
@@ -0,0 +1,355 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/headers"
+	"github.com/gernest/tt/pkg/rewrite"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// LoadRoutes reads routes from all .json files in path. path can be a single
+// file or a directory that is walked recursively.
+func LoadRoutes(path string) ([]*api.Route, error) {
+	var routes []*api.Route
+	var m jsonpb.Unmarshaler
+	err := filepath.Walk(path, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if filepath.Ext(path) != ".json" {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		var r api.Route
+		if err := m.Unmarshal(f, &r); err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		routes = append(routes, &r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// ValidateRoutes checks routes for errors that would prevent them from being
+// loaded.
+func ValidateRoutes(routes []*api.Route) error {
+	names := make(map[string]struct{})
+	for i, r := range routes {
+		if r.Name != "" {
+			if _, ok := names[r.Name]; ok {
+				return fmt.Errorf("route %q: duplicate name", r.Name)
+			}
+			names[r.Name] = struct{}{}
+		}
+		name := r.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i)
+		}
+		switch r.Protocol {
+		case api.Protocol_TCP:
+			if r.GetCondition().GetMatch() == nil {
+				return fmt.Errorf("route %q: tcp routes require a condition", name)
+			}
+		case api.Protocol_HTTP:
+			if r.GetRule().GetMatch() == nil {
+				return fmt.Errorf("route %q: http routes require a rule", name)
+			}
+			if len(r.LoadBalance) > 0 {
+				if _, err := balance.FromRoute(r); err != nil {
+					return fmt.Errorf("route %q: %v", name, err)
+				}
+			}
+			if err := validateUpstream(r); err != nil {
+				return fmt.Errorf("route %q: %v", name, err)
+			}
+		case api.Protocol_WEBSOCKET:
+			if r.GetRule().GetMatch() == nil {
+				return fmt.Errorf("route %q: websocket routes require a rule", name)
+			}
+			if len(r.LoadBalance) == 0 {
+				return fmt.Errorf("route %q: websocket routes require load balance", name)
+			}
+			if _, err := balance.FromRoute(r); err != nil {
+				return fmt.Errorf("route %q: %v", name, err)
+			}
+		case api.Protocol_QUIC:
+			if r.GetRule().GetMatch() == nil {
+				return fmt.Errorf("route %q: quic routes require a rule", name)
+			}
+			if len(r.GetBind().GetTls().GetCertificates()) == 0 {
+				return fmt.Errorf("route %q: quic routes require tls certificates", name)
+			}
+			if network, _ := SplitNetwork(BindToHostPort(r.Bind, "")); network != "tcp" {
+				return fmt.Errorf("route %q: quic routes can only bind to udp ports", name)
+			}
+			if len(r.LoadBalance) > 0 {
+				if _, err := balance.FromRoute(r); err != nil {
+					return fmt.Errorf("route %q: %v", name, err)
+				}
+			}
+		}
+		for _, w := range r.LoadBalance {
+			if w.GetAddr().GetAddress() == "" {
+				return fmt.Errorf("route %q: load balance target without address", name)
+			}
+		}
+		if r.Grpc != nil {
+			if err := validateGRPC(r); err != nil {
+				return fmt.Errorf("route %q: %v", name, err)
+			}
+		}
+		if r.HealthCheck != nil {
+			if err := validateHealthCheck(r); err != nil {
+				return fmt.Errorf("route %q: %v", name, err)
+			}
+		}
+		if err := validateMiddlewares(r); err != nil {
+			return fmt.Errorf("route %q: %v", name, err)
+		}
+		if f := r.GetBind().GetFallback(); f.GetAction() == api.Fallback_FORWARD && len(f.LoadBalance) == 0 {
+			return fmt.Errorf("route %q: forward fallback without load balance", name)
+		}
+	}
+	return nil
+}
+
+// validateUpstream checks that load balance addresses use the scheme required
+// by the upstream protocol of r.
+func validateUpstream(r *api.Route) error {
+	var scheme string
+	switch r.GetHttpUpstream().GetProtocol() {
+	case api.HTTPUpstream_H2:
+		scheme = "https://"
+	case api.HTTPUpstream_H2C:
+		scheme = "http://"
+	default:
+		return nil
+	}
+	for _, w := range r.LoadBalance {
+		if a := w.GetAddr().GetAddress(); !strings.HasPrefix(a, scheme) {
+			return fmt.Errorf("%s upstream %q must use %s", r.HttpUpstream.Protocol, a, scheme)
+		}
+	}
+	return nil
+}
+
+// validateGRPC checks routes proxying gRPC calls.
+func validateGRPC(r *api.Route) error {
+	if r.Protocol != api.Protocol_HTTP && r.Protocol != api.Protocol_QUIC {
+		return fmt.Errorf("grpc is not supported by %s routes", r.Protocol)
+	}
+	if len(r.LoadBalance) == 0 {
+		return errors.New("grpc routes require load balance")
+	}
+	if r.GetHttpUpstream().GetProtocol() == api.HTTPUpstream_HTTP1 {
+		return errors.New("grpc routes require HTTP/2 upstreams")
+	}
+	return nil
+}
+
+// validateHealthCheck checks health checks of load balance targets.
+func validateHealthCheck(r *api.Route) error {
+	switch r.Protocol {
+	case api.Protocol_HTTP, api.Protocol_WEBSOCKET, api.Protocol_QUIC:
+	default:
+		return fmt.Errorf("health checks are not supported by %s routes", r.Protocol)
+	}
+	if len(r.LoadBalance) == 0 {
+		return errors.New("health checks require load balance")
+	}
+	o := r.HealthCheck
+	if o.Path != "" && !strings.HasPrefix(o.Path, "/") {
+		return fmt.Errorf("health check path %q must start with /", o.Path)
+	}
+	for _, v := range o.ExpectedStatus {
+		if v < 100 || v > 599 {
+			return fmt.Errorf("health check status %d is not a valid status code", v)
+		}
+	}
+	_, err := balance.FromRoute(r)
+	return err
+}
+
+// validateMiddlewares checks middlewares that are built from configuration.
+func validateMiddlewares(r *api.Route) error {
+	for _, mw := range r.GetMiddlewares().GetList() {
+		if h := mw.GetHeaders(); h != nil {
+			if _, err := headers.New(r, h); err != nil {
+				return fmt.Errorf("headers middleware: %v", err)
+			}
+		}
+		if rw := mw.GetRewrite(); rw != nil {
+			if _, err := rewrite.New(r, rw); err != nil {
+				return err
+			}
+		}
+		if rd := mw.GetRedirect(); rd != nil {
+			if _, err := rewrite.NewRedirect(r, rd); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DiffRoutes returns names of routes that were added, removed or changed in
+// next compared to prev.
+func DiffRoutes(prev, next []*api.Route) (added, removed, changed []string) {
+	old := make(map[string]*api.Route)
+	for _, r := range prev {
+		old[r.Name] = r
+	}
+	for _, r := range next {
+		o, ok := old[r.Name]
+		switch {
+		case !ok:
+			added = append(added, r.Name)
+		case !proto.Equal(o, r):
+			changed = append(changed, r.Name)
+		}
+		delete(old, r.Name)
+	}
+	for _, r := range prev {
+		if _, ok := old[r.Name]; ok {
+			removed = append(removed, r.Name)
+		}
+	}
+	return
+}
+
+// MergeRoutes returns running with the changes from prev to next applied.
+// Routes of running that are not in prev, like routes added through the API,
+// are kept unless next replaces them. Routes with the same name in running are
+// merged into one.
+func MergeRoutes(running, prev, next []*api.Route) []*api.Route {
+	removed := make(map[string]bool)
+	for _, r := range prev {
+		removed[r.Name] = true
+	}
+	replace := make(map[string]*api.Route)
+	for _, r := range next {
+		delete(removed, r.Name)
+		replace[r.Name] = r
+	}
+	var o []*api.Route
+	seen := make(map[string]bool)
+	for _, r := range running {
+		if seen[r.Name] || removed[r.Name] {
+			continue
+		}
+		seen[r.Name] = true
+		if x, ok := replace[r.Name]; ok {
+			r = x
+		}
+		o = append(o, r)
+	}
+	for _, r := range next {
+		if !seen[r.Name] {
+			o = append(o, r)
+		}
+	}
+	return o
+}
+
+// WatchRoutes watches path for changes to route files until ctx is done. When
+// changes settle for debounce all routes are loaded, validated and passed to
+// apply. Errors are logged and apply is not called so the running routes are
+// kept.
+func WatchRoutes(ctx context.Context, path string, debounce time.Duration, apply func([]*api.Route) error) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	// editors and config management tools replace files instead of writing to
+	// them, watching the parent directory keeps track of the replaced file.
+	file := ""
+	if !info.IsDir() {
+		file = filepath.Clean(path)
+		if err := w.Add(filepath.Dir(path)); err != nil {
+			return err
+		}
+	} else if err := watchDirs(w, path); err != nil {
+		return err
+	}
+	log := zlg.Logger.Named("routes").With(zap.String("path", path))
+	log.Info("Watching routes")
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			log.Error("Watch error", zap.Error(err))
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if file != "" && filepath.Clean(e.Name) != file {
+				continue
+			}
+			if file == "" && e.Op&fsnotify.Create != 0 {
+				if fi, err := os.Stat(e.Name); err == nil && fi.IsDir() {
+					watchDirs(w, e.Name)
+				}
+			}
+			timer.Reset(debounce)
+		case <-timer.C:
+			routes, err := LoadRoutes(path)
+			if err == nil {
+				err = ValidateRoutes(routes)
+			}
+			if err != nil {
+				log.Error("Invalid routes, keeping running routes", zap.Error(err))
+				continue
+			}
+			if err := apply(routes); err != nil {
+				log.Error("Failed to apply routes", zap.Error(err))
+			}
+		}
+	}
+}
+
+func watchDirs(w *fsnotify.Watcher, root string) error {
+	return filepath.Walk(root, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return w.Add(path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,279 @@
+package proxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+)
+
+func writeRoute(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateRoutes(t *testing.T) {
+	sample := []struct {
+		desc   string
+		routes []*api.Route
+		ok     bool
+	}{
+		{"tcp with condition", []*api.Route{
+			{Name: "a", Condition: &api.RequestMatch{Match: &api.RequestMatch_Sni{Sni: "a.test"}}},
+		}, true},
+		{"tcp without condition", []*api.Route{{Name: "a"}}, false},
+		{"http without rule", []*api.Route{{Name: "a", Protocol: api.Protocol_HTTP}}, false},
+		{"duplicate names", []*api.Route{
+			{Name: "a", Condition: &api.RequestMatch{Match: &api.RequestMatch_Sni{Sni: "a.test"}}},
+			{Name: "a", Condition: &api.RequestMatch{Match: &api.RequestMatch_Sni{Sni: "b.test"}}},
+		}, false},
+		{"empty address", []*api.Route{
+			{
+				Name:        "a",
+				Condition:   &api.RequestMatch{Match: &api.RequestMatch_Sni{Sni: "a.test"}},
+				LoadBalance: []*api.WeightedAddr{{Addr: &api.Address{}}},
+			},
+		}, false},
+		{"h2c upstream with https address", []*api.Route{
+			{
+				Name:         "a",
+				Protocol:     api.Protocol_HTTP,
+				Rule:         &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance:  []*api.WeightedAddr{{Addr: &api.Address{Address: "https://a.test"}}},
+				HttpUpstream: &api.HTTPUpstream{Protocol: api.HTTPUpstream_H2C},
+			},
+		}, false},
+		{"h2c upstream with http address", []*api.Route{
+			{
+				Name:         "a",
+				Protocol:     api.Protocol_HTTP,
+				Rule:         &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance:  []*api.WeightedAddr{{Addr: &api.Address{Address: "http://a.test"}}},
+				HttpUpstream: &api.HTTPUpstream{Protocol: api.HTTPUpstream_H2C},
+			},
+		}, true},
+		{"websocket without load balance", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_WEBSOCKET,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+			},
+		}, false},
+		{"grpc over http1", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance: []*api.WeightedAddr{
+					{Addr: &api.Address{Address: "http://127.0.0.1:9000"}},
+				},
+				HttpUpstream: &api.HTTPUpstream{Protocol: api.HTTPUpstream_HTTP1},
+				Grpc:         &api.GRPC{},
+			},
+		}, false},
+		{"health check without load balance", []*api.Route{
+			{
+				Name:        "a",
+				Protocol:    api.Protocol_HTTP,
+				Rule:        &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				HealthCheck: &api.HealthCheck{Path: "/healthz"},
+			},
+		}, false},
+		{"health check with bad body regex", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance: []*api.WeightedAddr{
+					{Addr: &api.Address{Address: "http://127.0.0.1:9000"}},
+				},
+				HealthCheck: &api.HealthCheck{Path: "/healthz", BodyRegex: "("},
+			},
+		}, false},
+		{"health check with bad status", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance: []*api.WeightedAddr{
+					{Addr: &api.Address{Address: "http://127.0.0.1:9000"}},
+				},
+				HealthCheck: &api.HealthCheck{Path: "/healthz", ExpectedStatus: []int32{1000}},
+			},
+		}, false},
+		{"health check", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				LoadBalance: []*api.WeightedAddr{
+					{Addr: &api.Address{Address: "http://127.0.0.1:9000"}},
+				},
+				HealthCheck: &api.HealthCheck{Path: "/healthz", ExpectedStatus: []int32{200}},
+			},
+		}, true},
+		{"headers with unknown variable", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				Middlewares: &api.Middleware_List{List: []*api.Middleware{
+					{Match: &api.Middleware_Headers_{Headers: &api.Middleware_Headers{
+						Request: &api.Middleware_Headers_Operations{
+							Set: []*api.Middleware_Headers_Header{{Name: "X-Client", Value: "{client}"}},
+						},
+					}}},
+				}},
+			},
+		}, false},
+		{"headers", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				Middlewares: &api.Middleware_List{List: []*api.Middleware{
+					{Match: &api.Middleware_Headers_{Headers: &api.Middleware_Headers{
+						Request: &api.Middleware_Headers_Operations{
+							Set: []*api.Middleware_Headers_Header{{Name: "X-Client", Value: "{client_ip}"}},
+						},
+					}}},
+				}},
+			},
+		}, true},
+		{"rewrite with bad regex", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				Middlewares: &api.Middleware_List{List: []*api.Middleware{
+					{Match: &api.Middleware_Rewrite_{Rewrite: &api.Middleware_Rewrite{
+						Regex: &api.Middleware_Rewrite_Regex{Pattern: "(", Replacement: "/"},
+					}}},
+				}},
+			},
+		}, false},
+		{"redirect without target", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_HTTP,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				Middlewares: &api.Middleware_List{List: []*api.Middleware{
+					{Match: &api.Middleware_Redirect_{Redirect: &api.Middleware_Redirect{Permanent: true}}},
+				}},
+			},
+		}, false},
+		{"quic without certificates", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_QUIC,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+			},
+		}, false},
+		{"quic on unix socket", []*api.Route{
+			{
+				Name:     "a",
+				Protocol: api.Protocol_QUIC,
+				Rule:     &api.Rule{Match: &api.Rule_Http{Http: &api.Rule_HTTP{}}},
+				Bind: &api.Bind{
+					To:  &api.Bind_Unix_{Unix: &api.Bind_Unix{Path: "/tmp/tt.sock"}},
+					Tls: &api.TLS{Certificates: []*api.TLS_Certificate{{StoreKey: "certs/a"}}},
+				},
+			},
+		}, false},
+	}
+	for _, s := range sample {
+		err := ValidateRoutes(s.routes)
+		if s.ok && err != nil {
+			t.Errorf("%s: unexpected error %v", s.desc, err)
+		}
+		if !s.ok && err == nil {
+			t.Errorf("%s: expected an error", s.desc)
+		}
+	}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	a := &api.Route{Name: "a"}
+	b := &api.Route{Name: "b"}
+	c := &api.Route{Name: "c", Priority: 1}
+	added, removed, changed := DiffRoutes(
+		[]*api.Route{a, b, {Name: "c"}},
+		[]*api.Route{b, c, {Name: "d"}},
+	)
+	if len(added) != 1 || added[0] != "d" {
+		t.Errorf("expected d to be added got %v", added)
+	}
+	if len(removed) != 1 || removed[0] != "a" {
+		t.Errorf("expected a to be removed got %v", removed)
+	}
+	if len(changed) != 1 || changed[0] != "c" {
+		t.Errorf("expected c to be changed got %v", changed)
+	}
+}
+
+func TestMergeRoutes(t *testing.T) {
+	api1 := &api.Route{Name: "api"}
+	a := &api.Route{Name: "a"}
+	b := &api.Route{Name: "b"}
+	b2 := &api.Route{Name: "b", Priority: 1}
+	c := &api.Route{Name: "c"}
+	// every proxy reports the same routes
+	running := []*api.Route{a, api1, b, a, api1, b}
+	got := MergeRoutes(running, []*api.Route{a, b}, []*api.Route{b2, c})
+	var names []string
+	for _, r := range got {
+		names = append(names, r.Name)
+	}
+	if want := []string{"api", "b", "c"}; strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v got %v", want, names)
+	}
+	if got[1] != b2 {
+		t.Error("expected b to be replaced")
+	}
+}
+
+func TestWatchRoutes(t *testing.T) {
+	dir := t.TempDir()
+	writeRoute(t, filepath.Join(dir, "a.json"), `{"name":"a","condition":{"sni":"a.test"}}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	applied := make(chan []*api.Route, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- WatchRoutes(ctx, dir, 20*time.Millisecond, func(r []*api.Route) error {
+			applied <- r
+			return nil
+		})
+	}()
+	// give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+
+	// invalid routes are not applied
+	writeRoute(t, filepath.Join(dir, "b.json"), `{"name":"b"}`)
+	select {
+	case r := <-applied:
+		t.Fatalf("invalid routes were applied %v", r)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	writeRoute(t, filepath.Join(dir, "b.json"), `{"name":"b","condition":{"sni":"b.test"}}`)
+	select {
+	case r := <-applied:
+		if len(r) != 2 {
+			t.Errorf("expected 2 routes got %d", len(r))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("routes were not applied")
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+package proxy
+
+import (
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/gernest/tt/api"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/sys/unix"
+)
+
+// control returns a function that sets socket options o on a listening socket
+// before it is bound.
+func control(o *api.Bind_ListenOptions) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		var err error
+		cerr := c.Control(func(fd uintptr) {
+			err = setSockopts(int(fd), network, o)
+		})
+		if cerr != nil {
+			return cerr
+		}
+		return err
+	}
+}
+
+func setSockopts(fd int, network string, o *api.Bind_ListenOptions) error {
+	if o.Interface != "" {
+		if err := unix.BindToDevice(fd, o.Interface); err != nil {
+			return err
+		}
+	}
+	if o.Ipv6Only && (network == "tcp6" || network == "udp6") {
+		if err := unix.SetsockoptInt(fd, unix.IPPROTO_IPV6, unix.IPV6_V6ONLY, 1); err != nil {
+			return err
+		}
+	}
+	if !strings.HasPrefix(network, "tcp") {
+		return nil
+	}
+	if o.Acceptors > 1 {
+		if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEPORT, 1); err != nil {
+			return err
+		}
+	}
+	if o.TcpFastOpen > 0 {
+		if err := unix.SetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_FASTOPEN, int(o.TcpFastOpen)); err != nil {
+			return err
+		}
+	}
+	if o.DeferAccept != nil {
+		d, err := ptypes.Duration(o.DeferAccept)
+		if err != nil {
+			return err
+		}
+		if err := unix.SetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_DEFER_ACCEPT, int(d.Seconds())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setBacklog changes the length of the queue of pending connections of an
+// already listening socket.
+func setBacklog(ln net.Listener, backlog int) error {
+	sc, ok := ln.(syscall.Conn)
+	if !ok {
+		return nil
+	}
+	c, err := sc.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var lerr error
+	if err := c.Control(func(fd uintptr) {
+		lerr = unix.Listen(int(fd), backlog)
+	}); err != nil {
+		return err
+	}
+	return lerr
+}
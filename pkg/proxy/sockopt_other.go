@@ -0,0 +1,34 @@
+//go:build !linux
+// +build !linux
+
+package proxy
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/gernest/tt/api"
+)
+
+// ErrSockoptNotSupported is returned when listen options are used on a
+// platform that doesn't support them.
+var ErrSockoptNotSupported = errors.New("proxy: listen options are only supported on linux")
+
+func control(o *api.Bind_ListenOptions) func(network, address string, c syscall.RawConn) error {
+	return func(network, address string, c syscall.RawConn) error {
+		if o.Interface != "" || o.Ipv6Only {
+			return ErrSockoptNotSupported
+		}
+		if strings.HasPrefix(network, "tcp") &&
+			(o.Acceptors > 1 || o.TcpFastOpen > 0 || o.DeferAccept != nil) {
+			return ErrSockoptNotSupported
+		}
+		return nil
+	}
+}
+
+func setBacklog(ln net.Listener, backlog int) error {
+	return ErrSockoptNotSupported
+}
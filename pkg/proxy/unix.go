@@ -0,0 +1,158 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gernest/tt/api"
+)
+
+// ErrSocketInUse is returned when a unix socket file is still being served by
+// another process.
+var ErrSocketInUse = errors.New("proxy: unix socket is in use")
+
+// ListenBind announces on hostPort as returned by BindToHostPort using listen. For
+// unix sockets stale socket files are removed before listening and file mode
+// and ownership from b are applied after.
+//
+// Sockets inherited from a previous process are used instead of listening
+// again when they exist for hostPort.
+func ListenBind(listen func(network, address string) (net.Listener, error), hostPort string, b *api.Bind) (net.Listener, error) {
+	if ls := listeners.take(hostPort); len(ls) > 0 {
+		return listeners.track(hostPort, wrap(ls, b.GetOptions())), nil
+	}
+	network, address := SplitNetwork(hostPort)
+	if network != "unix" {
+		ln, err := listen(network, address)
+		if err != nil {
+			return nil, err
+		}
+		return listeners.track(hostPort, ln), nil
+	}
+	if err := removeStaleSocket(address); err != nil {
+		return nil, err
+	}
+	ln, err := listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := SetSocketPermissions(address, b.GetUnix()); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return listeners.track(hostPort, ln), nil
+}
+
+// ListenPacketBind announces on the UDP address of hostPort as returned by
+// BindToHostPort applying socket options of b. Only host:port binds can be used
+// with UDP.
+//
+// A socket inherited from a previous process is used instead of listening again
+// when it exists for hostPort.
+func ListenPacketBind(hostPort string, b *api.Bind) (net.PacketConn, error) {
+	if conn := listeners.takePacket(hostPort); conn != nil {
+		return listeners.trackPacket(hostPort, conn), nil
+	}
+	network, address := SplitNetwork(hostPort)
+	if network != "tcp" {
+		return nil, fmt.Errorf("proxy: can not listen for udp on %s", hostPort)
+	}
+	conn, err := listenPacket(address, b.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return listeners.trackPacket(hostPort, conn), nil
+}
+
+// IsAbstract returns true if path is a linux abstract socket address.
+func IsAbstract(path string) bool {
+	return strings.HasPrefix(path, "@")
+}
+
+// removeStaleSocket removes a socket file left behind by a process that didn't
+// shut down cleanly. Sockets that still accept connections are left alone.
+func removeStaleSocket(path string) error {
+	if IsAbstract(path) {
+		return nil
+	}
+	stat, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if stat.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("proxy: %s exists and is not a socket", path)
+	}
+	if c, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		c.Close()
+		return fmt.Errorf("%w %s", ErrSocketInUse, path)
+	}
+	return os.Remove(path)
+}
+
+// SetSocketPermissions applies file mode and ownership of u to the socket file
+// at path.
+func SetSocketPermissions(path string, u *api.Bind_Unix) error {
+	if u == nil || IsAbstract(path) {
+		return nil
+	}
+	if u.Mode != "" {
+		mode, err := strconv.ParseUint(u.Mode, 8, 32)
+		if err != nil {
+			return fmt.Errorf("proxy: invalid socket mode %q %v", u.Mode, err)
+		}
+		if err := os.Chmod(path, os.FileMode(mode)); err != nil {
+			return err
+		}
+	}
+	if u.User == "" && u.Group == "" {
+		return nil
+	}
+	uid, gid := -1, -1
+	if u.User != "" {
+		id, err := lookupID(u.User, func(name string) (string, error) {
+			x, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return x.Uid, nil
+		})
+		if err != nil {
+			return err
+		}
+		uid = id
+	}
+	if u.Group != "" {
+		id, err := lookupID(u.Group, func(name string) (string, error) {
+			x, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return x.Gid, nil
+		})
+		if err != nil {
+			return err
+		}
+		gid = id
+	}
+	return os.Lchown(path, uid, gid)
+}
+
+func lookupID(name string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(name); err == nil {
+		return id, nil
+	}
+	id, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(id)
+}
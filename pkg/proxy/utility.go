@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gernest/tt/api"
 )
@@ -16,9 +17,37 @@ func BindToHostPort(b *api.Bind, defaultHostPort string) string {
 		h = fmt.Sprintf(":%d", e.Port)
 	case *api.Bind_HostPort:
 		h = e.HostPort
+	case *api.Bind_Unix_:
+		if e.Unix.GetPath() != "" {
+			h = unixPrefix + e.Unix.Path
+		}
+	case *api.Bind_Internal:
+		if e.Internal != "" {
+			h = internalPrefix + e.Internal
+		}
 	}
 	if h != "" {
 		return h
 	}
 	return defaultHostPort
 }
+
+const (
+	unixPrefix     = "unix:"
+	internalPrefix = "internal:"
+	udpPrefix      = "udp:"
+)
+
+// SplitNetwork returns the network and address to listen on for hostPort
+// returned by BindToHostPort. Internal binds have network "internal" and the
+// name of the listener as address.
+func SplitNetwork(hostPort string) (network, address string) {
+	switch {
+	case strings.HasPrefix(hostPort, unixPrefix):
+		return "unix", strings.TrimPrefix(hostPort, unixPrefix)
+	case strings.HasPrefix(hostPort, internalPrefix):
+		return "internal", strings.TrimPrefix(hostPort, internalPrefix)
+	default:
+		return "tcp", hostPort
+	}
+}
@@ -0,0 +1,425 @@
+package reverse
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	totalGRPCCalls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "grpc_calls_total",
+			Help:      "Total number of proxied gRPC calls",
+		},
+		[]string{"route", "service", "method", "code"},
+	)
+	grpcCallDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tt",
+			Name:      "grpc_call_duration",
+			Help:      "Duration in milliseconds of proxied gRPC calls",
+		},
+		[]string{"route", "service", "method", "code"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		totalGRPCCalls,
+		grpcCallDuration,
+	)
+}
+
+const (
+	grpcContentType    = "application/grpc"
+	grpcWebContentType = "application/grpc-web"
+	grpcWebText        = "-text"
+)
+
+// GRPC proxies gRPC calls to the load_balance targets of a route. Calls are
+// sent over HTTP/2 and the target is picked for every call. gRPC-Web calls are
+// translated to native gRPC when enabled.
+type GRPC struct {
+	route          string
+	web            bool
+	defaultTimeout time.Duration
+	maxTimeout     time.Duration
+	proxy          *httputil.ReverseProxy
+}
+
+func NewGRPC(route *api.Route, ba balance.Balance) (*GRPC, error) {
+	transport, err := grpcTransport(route)
+	if err != nil {
+		return nil, err
+	}
+	transport = reportTo(ba, transport)
+	g := &GRPC{
+		route: route.Name,
+		web:   route.GetGrpc().GetWeb(),
+	}
+	if o := route.Grpc; o != nil {
+		g.defaultTimeout, _ = ptypes.Duration(o.DefaultTimeout)
+		g.maxTimeout, _ = ptypes.Duration(o.MaxTimeout)
+	}
+	g.proxy = &httputil.ReverseProxy{
+		Director:       DirectorFromLoadBalance(ba).Request,
+		Transport:      transport,
+		FlushInterval:  -1,
+		ModifyResponse: g.modifyResponse,
+		ErrorHandler:   g.errorHandler,
+	}
+	return g, nil
+}
+
+// grpcCall is the state of a call shared with hooks of the reverse proxy.
+type grpcCall struct {
+	web  bool
+	text bool
+	res  *http.Response
+	// trailer of calls that failed in the proxy or were translated from
+	// gRPC-Web, other calls use trailers of res.
+	trailer http.Header
+}
+
+type grpcCallKey struct{}
+
+func (g *GRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	call := &grpcCall{}
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(ct, grpcWebContentType):
+		if !g.web {
+			http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+			return
+		}
+		call.web = true
+		fromWeb(call, r)
+	case !strings.HasPrefix(ct, grpcContentType):
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+	ctx := r.Context()
+	if d := g.timeout(r.Header.Get("Grpc-Timeout")); d > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+		r.Header.Set("Grpc-Timeout", encodeTimeout(d))
+	}
+	g.proxy.ServeHTTP(w, r.WithContext(context.WithValue(ctx, grpcCallKey{}, call)))
+
+	service, method := splitMethod(r.URL.Path)
+	status, message := call.status()
+	labels := prometheus.Labels{
+		"route":   g.route,
+		"service": service,
+		"method":  method,
+		"code":    status.String(),
+	}
+	totalGRPCCalls.With(labels).Inc()
+	grpcCallDuration.With(labels).Observe(float64(time.Since(start).Milliseconds()))
+	if m := meta.GetMetics(r.Context()); m != nil && m.Grpc != nil {
+		m.Grpc.Service = service
+		m.Grpc.Method = method
+		m.Grpc.Status = int32(status)
+		m.Grpc.Message = message
+		m.Grpc.Web = call.web
+	}
+}
+
+// timeout returns the deadline of a call with grpc-timeout header v.
+func (g *GRPC) timeout(v string) time.Duration {
+	d, ok := parseTimeout(v)
+	if !ok {
+		d = g.defaultTimeout
+	}
+	if g.maxTimeout > 0 && (d == 0 || d > g.maxTimeout) {
+		d = g.maxTimeout
+	}
+	return d
+}
+
+// fromWeb turns the gRPC-Web request r into a native gRPC request.
+func fromWeb(call *grpcCall, r *http.Request) {
+	ct := strings.TrimPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+	if strings.HasPrefix(ct, grpcWebText) {
+		call.text = true
+		ct = strings.TrimPrefix(ct, grpcWebText)
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{base64.NewDecoder(base64.StdEncoding, r.Body), r.Body}
+		r.ContentLength = -1
+		r.Header.Del("Content-Length")
+	}
+	r.Header.Set("Content-Type", grpcContentType+ct)
+	r.Header.Set("Te", "trailers")
+}
+
+func (g *GRPC) modifyResponse(res *http.Response) error {
+	call, ok := res.Request.Context().Value(grpcCallKey{}).(*grpcCall)
+	if !ok {
+		return nil
+	}
+	call.res = res
+	if !call.web {
+		return nil
+	}
+	ct := grpcWebContentType
+	if call.text {
+		ct += grpcWebText
+	}
+	res.Header.Set("Content-Type", ct+strings.TrimPrefix(res.Header.Get("Content-Type"), grpcContentType))
+	res.Header.Del("Trailer")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	var body io.ReadCloser = &webBody{call: call, body: res.Body}
+	if call.text {
+		body = &textBody{body: body}
+	}
+	res.Body = body
+	return nil
+}
+
+func (g *GRPC) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	call := r.Context().Value(grpcCallKey{}).(*grpcCall)
+	code := codes.Unavailable
+	switch r.Context().Err() {
+	case context.DeadlineExceeded:
+		code = codes.DeadlineExceeded
+	case context.Canceled:
+		code = codes.Canceled
+	}
+	call.trailer = http.Header{
+		"Grpc-Status":  {strconv.Itoa(int(code))},
+		"Grpc-Message": {url.PathEscape(err.Error())},
+	}
+	ct := grpcContentType
+	if call.web {
+		ct = grpcWebContentType
+		if call.text {
+			ct += grpcWebText
+		}
+	}
+	// this is a trailers only response, the status is sent with headers.
+	h := w.Header()
+	h.Set("Content-Type", ct)
+	for k, v := range call.trailer {
+		h[k] = v
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// status returns the grpc-status and grpc-message of the call.
+func (c *grpcCall) status() (codes.Code, string) {
+	var headers []http.Header
+	if c.trailer != nil {
+		headers = append(headers, c.trailer)
+	}
+	if c.res != nil {
+		// calls failing before sending messages have the status in headers.
+		headers = append(headers, c.res.Trailer, c.res.Header)
+	}
+	for _, h := range headers {
+		if v := h.Get("Grpc-Status"); v != "" {
+			n, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return codes.Unknown, v
+			}
+			msg := h.Get("Grpc-Message")
+			if m, err := url.PathUnescape(msg); err == nil {
+				msg = m
+			}
+			return codes.Code(n), msg
+		}
+	}
+	if c.res != nil {
+		return httpStatusCode(c.res.StatusCode), ""
+	}
+	return codes.Unknown, ""
+}
+
+// httpStatusCode maps status codes of responses without grpc-status to gRPC
+// codes.
+func httpStatusCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+// webTrailer returns trailers of the call as a gRPC-Web trailer frame. The
+// trailers are removed from the response so they are not sent as HTTP
+// trailers.
+func (c *grpcCall) webTrailer() []byte {
+	c.trailer = c.res.Trailer
+	c.res.Trailer = nil
+	if len(c.trailer) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(c.trailer))
+	for k := range c.trailer {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b bytes.Buffer
+	b.Write([]byte{0x80, 0, 0, 0, 0})
+	for _, k := range keys {
+		for _, v := range c.trailer[k] {
+			fmt.Fprintf(&b, "%s: %s\r\n", strings.ToLower(k), v)
+		}
+	}
+	binary.BigEndian.PutUint32(b.Bytes()[1:5], uint32(b.Len()-5))
+	return b.Bytes()
+}
+
+// webBody appends trailers of a gRPC response to its body as a gRPC-Web
+// trailer frame.
+type webBody struct {
+	call    *grpcCall
+	body    io.ReadCloser
+	trailer *bytes.Reader
+}
+
+func (b *webBody) Read(p []byte) (int, error) {
+	if b.trailer == nil {
+		n, err := b.body.Read(p)
+		if err != io.EOF {
+			return n, err
+		}
+		b.trailer = bytes.NewReader(b.call.webTrailer())
+		if n > 0 {
+			return n, nil
+		}
+	}
+	return b.trailer.Read(p)
+}
+
+func (b *webBody) Close() error {
+	return b.body.Close()
+}
+
+// textBody encodes a body to base64 as it is read. Every chunk is padded so
+// chunks can be flushed to clients as soon as they are read.
+type textBody struct {
+	body io.ReadCloser
+	buf  [3 << 10]byte
+	out  []byte
+	err  error
+}
+
+func (b *textBody) Read(p []byte) (int, error) {
+	if len(b.out) == 0 {
+		if b.err != nil {
+			return 0, b.err
+		}
+		n, err := b.body.Read(b.buf[:])
+		b.err = err
+		b.out = []byte(base64.StdEncoding.EncodeToString(b.buf[:n]))
+		if n == 0 {
+			return 0, err
+		}
+	}
+	n := copy(p, b.out)
+	b.out = b.out[n:]
+	return n, nil
+}
+
+func (b *textBody) Close() error {
+	return b.body.Close()
+}
+
+// splitMethod returns the service and method of a call to path, which has the
+// form /package.Service/Method.
+func splitMethod(path string) (service, method string) {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.LastIndexByte(path, '/'); i != -1 {
+		return path[:i], path[i+1:]
+	}
+	return path, ""
+}
+
+var timeoutUnits = []struct {
+	unit time.Duration
+	name byte
+}{
+	{time.Nanosecond, 'n'},
+	{time.Microsecond, 'u'},
+	{time.Millisecond, 'm'},
+	{time.Second, 'S'},
+	{time.Minute, 'M'},
+	{time.Hour, 'H'},
+}
+
+// parseTimeout parses a grpc-timeout header value.
+func parseTimeout(v string) (time.Duration, bool) {
+	if len(v) < 2 || len(v) > 9 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v[:len(v)-1], 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	for _, u := range timeoutUnits {
+		if u.name == v[len(v)-1] {
+			if n > math.MaxInt64/int64(u.unit) {
+				return math.MaxInt64, true
+			}
+			return time.Duration(n) * u.unit, true
+		}
+	}
+	return 0, false
+}
+
+// encodeTimeout formats d as a grpc-timeout header value. The value is rounded
+// up to the smallest unit that fits in eight digits.
+func encodeTimeout(d time.Duration) string {
+	const max = 1e8
+	for _, u := range timeoutUnits {
+		if n := (d + u.unit - 1) / u.unit; n < max {
+			return strconv.FormatInt(int64(n), 10) + string(u.name)
+		}
+	}
+	return "99999999H"
+}
+
+// schemeTransport sends requests with the transport of their URL scheme.
+type schemeTransport map[string]http.RoundTripper
+
+func (s schemeTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t, ok := s[r.URL.Scheme]; ok {
+		return t.RoundTrip(r)
+	}
+	return nil, errors.New("reverse: unsupported protocol scheme " + r.URL.Scheme)
+}
@@ -0,0 +1,231 @@
+package reverse
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func grpcFrame(flag byte, payload []byte) []byte {
+	b := []byte{flag, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(b[1:], uint32(len(payload)))
+	return append(b, payload...)
+}
+
+// grpcServer is a fake gRPC service. Echo returns the request message, Fail
+// fails with NOT_FOUND and Slow waits for the call to be cancelled.
+var grpcServer = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.ProtoMajor != 2 || r.Header.Get("Content-Type") != "application/grpc+proto" {
+		http.Error(w, "not grpc", http.StatusBadRequest)
+		return
+	}
+	switch r.URL.Path {
+	case "/test.Echo/Echo":
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/grpc+proto")
+		w.Write(b)
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "0")
+	case "/test.Echo/Fail":
+		w.Header().Set("Content-Type", "application/grpc+proto")
+		w.WriteHeader(http.StatusOK)
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "5")
+		w.Header().Set(http.TrailerPrefix+"Grpc-Message", "no%20such%20thing")
+	case "/test.Echo/Slow":
+		<-r.Context().Done()
+	}
+})
+
+type testGRPC struct {
+	url   string
+	entry *api.AccessEntry
+}
+
+func startGRPC(t *testing.T, o *api.GRPC) (*testGRPC, func()) {
+	t.Helper()
+	back := httptest.NewServer(h2c.NewHandler(grpcServer, &http2.Server{}))
+	route := &api.Route{
+		Name:        "grpc",
+		Protocol:    api.Protocol_HTTP,
+		LoadBalance: []*api.WeightedAddr{{Addr: &api.Address{Address: back.URL}}},
+		Grpc:        o,
+	}
+	ba, err := balance.FromRoute(route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := NewGRPC(route, ba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &testGRPC{}
+	front := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		x.entry = &api.AccessEntry{
+			ReverseProxy: &api.AccessEntry_ReverseProxy{},
+			Grpc:         &api.AccessEntry_GRPC{},
+		}
+		g.ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), x.entry)))
+	}), &http2.Server{}))
+	x.url = front.URL
+	return x, func() {
+		front.Close()
+		back.Close()
+	}
+}
+
+func (x *testGRPC) call(t *testing.T, method string, h http.Header, body []byte) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, x.url+method, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header = h
+	c := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		},
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, b
+}
+
+func TestGRPC(t *testing.T) {
+	x, stop := startGRPC(t, &api.GRPC{
+		Web:        true,
+		MaxTimeout: ptypes.DurationProto(50 * time.Millisecond),
+	})
+	defer stop()
+	native := http.Header{"Content-Type": {"application/grpc+proto"}}
+	msg := grpcFrame(0, []byte("hello"))
+
+	t.Run("echo", func(t *testing.T) {
+		res, body := x.call(t, "/test.Echo/Echo", native, msg)
+		if !bytes.Equal(body, msg) {
+			t.Errorf("expected echoed message got %q", body)
+		}
+		if s := res.Trailer.Get("Grpc-Status"); s != "0" {
+			t.Errorf("expected grpc-status 0 got %q", s)
+		}
+		g := x.entry.Grpc
+		if g.Service != "test.Echo" || g.Method != "Echo" || g.Status != 0 {
+			t.Errorf("unexpected entry %v", g)
+		}
+	})
+	t.Run("status", func(t *testing.T) {
+		x.call(t, "/test.Echo/Fail", native, msg)
+		if g := x.entry.Grpc; g.Status != 5 || g.Message != "no such thing" {
+			t.Errorf("expected NOT_FOUND got %d %q", g.Status, g.Message)
+		}
+	})
+	t.Run("timeout", func(t *testing.T) {
+		h := native.Clone()
+		h.Set("Grpc-Timeout", "10S")
+		res, _ := x.call(t, "/test.Echo/Slow", h, msg)
+		if s := res.Header.Get("Grpc-Status"); s != "4" {
+			t.Errorf("expected grpc-status 4 got %q", s)
+		}
+		if g := x.entry.Grpc; g.Status != 4 {
+			t.Errorf("expected DEADLINE_EXCEEDED got %d", g.Status)
+		}
+	})
+	t.Run("web text", func(t *testing.T) {
+		h := http.Header{"Content-Type": {"application/grpc-web-text+proto"}}
+		res, body := x.call(t, "/test.Echo/Echo", h, []byte(base64.StdEncoding.EncodeToString(msg)))
+		if ct := res.Header.Get("Content-Type"); ct != "application/grpc-web-text+proto" {
+			t.Errorf("expected grpc-web-text content type got %q", ct)
+		}
+		b := decodeChunks(t, string(body))
+		if !bytes.HasPrefix(b, msg) {
+			t.Fatalf("expected echoed message got %q", b)
+		}
+		want := grpcFrame(0x80, []byte("grpc-status: 0\r\n"))
+		if trailer := b[len(msg):]; !bytes.Equal(trailer, want) {
+			t.Errorf("expected trailer frame %q got %q", want, trailer)
+		}
+		if !x.entry.Grpc.Web {
+			t.Error("expected web call")
+		}
+	})
+	t.Run("not grpc", func(t *testing.T) {
+		res, _ := x.call(t, "/test.Echo/Echo", http.Header{"Content-Type": {"application/json"}}, nil)
+		if res.StatusCode != http.StatusUnsupportedMediaType {
+			t.Errorf("expected 415 got %d", res.StatusCode)
+		}
+	})
+}
+
+// decodeChunks decodes concatenated padded base64 chunks.
+func decodeChunks(t *testing.T, s string) []byte {
+	t.Helper()
+	var b []byte
+	for s != "" {
+		i := strings.IndexByte(s, '=')
+		if i == -1 {
+			i = len(s)
+		}
+		for i < len(s) && s[i] == '=' {
+			i++
+		}
+		chunk, err := base64.StdEncoding.DecodeString(s[:i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		b = append(b, chunk...)
+		s = s[i:]
+	}
+	return b
+}
+
+func TestGRPCTimeout(t *testing.T) {
+	sample := []struct {
+		v string
+		d time.Duration
+	}{
+		{"1H", time.Hour},
+		{"100m", 100 * time.Millisecond},
+		{"99999999n", 99999999},
+	}
+	for _, s := range sample {
+		d, ok := parseTimeout(s.v)
+		if !ok || d != s.d {
+			t.Errorf("%s: expected %v got %v", s.v, s.d, d)
+		}
+	}
+	if _, ok := parseTimeout("10x"); ok {
+		t.Error("expected invalid unit to fail")
+	}
+	for d, v := range map[time.Duration]string{
+		time.Hour:               "3600000m",
+		1500 * time.Microsecond: "1500000n",
+		100000000:               "100000u",
+	} {
+		if got := encodeTimeout(d); got != v {
+			t.Errorf("%v: expected %s got %s", d, v, got)
+		}
+	}
+}
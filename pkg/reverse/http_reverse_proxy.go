@@ -1,14 +1,19 @@
 package reverse
 
 import (
+	"context"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gernest/tt/api"
 	"github.com/gernest/tt/pkg/balance"
 	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
 )
 
 type Director interface {
@@ -80,6 +85,12 @@ func joinURLPath(a, b *url.URL) (path, rawpath string) {
 func DirectorFromLoadBalance(ba balance.Balance) Director {
 	return DirectorFunc(func(r *http.Request) {
 		target := ba.Next()
+		if target == nil {
+			// no upstream is available, the reverse proxy fails the request
+			// because it has no host.
+			r.URL.Host = ""
+			return
+		}
 		if m := meta.GetMetics(r.Context()); m != nil {
 			m.ReverseProxy.Target = target.URL.String()
 		}
@@ -87,11 +98,73 @@ func DirectorFromLoadBalance(ba balance.Balance) Director {
 	})
 }
 
-func New(route *api.Route) (*httputil.ReverseProxy, error) {
-	ba, err := balance.FromRoute(route)
+// New returns a handler proxying requests of route to targets picked by ba.
+func New(route *api.Route, ba balance.Balance) (http.Handler, error) {
+	transport, err := Transport(route)
 	if err != nil {
 		return nil, err
 	}
+	transport = reportTo(ba, transport)
 	direct := DirectorFromLoadBalance(ba)
-	return &httputil.ReverseProxy{Director: direct.Request}, nil
+	rp := &httputil.ReverseProxy{
+		Director:     direct.Request,
+		Transport:    transport,
+		ErrorHandler: errorHandler,
+	}
+	var h http.Handler = rp
+	if route.GetRetries().GetEnabled() {
+		h = NewRetry(route, ba, rp)
+	}
+	if d, _ := ptypes.Duration(route.GetHttpUpstream().GetRequestTimeout()); d > 0 {
+		h = withTimeout(h, d)
+	}
+	return h, nil
+}
+
+// withTimeout cancels requests to next that take longer than d.
+func withTimeout(next http.Handler, d time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// errorHandler answers requests that could not be proxied. Upstream timeouts
+// are answered with 504, request bodies exceeding limits with 413 and other
+// failures with 502.
+func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	failure := upstreamFailure(r, err)
+	if m := meta.GetMetics(r.Context()); m != nil {
+		m.ReverseProxy.Failure = failure
+	}
+	switch failure {
+	case api.AccessEntry_ReverseProxy_CONNECT_TIMEOUT,
+		api.AccessEntry_ReverseProxy_RESPONSE_HEADER_TIMEOUT,
+		api.AccessEntry_ReverseProxy_REQUEST_TIMEOUT:
+		w.WriteHeader(http.StatusGatewayTimeout)
+	default:
+		w.WriteHeader(http.StatusBadGateway)
+	}
+}
+
+func upstreamFailure(r *http.Request, err error) api.AccessEntry_ReverseProxy_Failure {
+	var op *net.OpError
+	switch {
+	case errors.Is(err, errResponseHeaderTimeout):
+		return api.AccessEntry_ReverseProxy_RESPONSE_HEADER_TIMEOUT
+	case r.Context().Err() == context.DeadlineExceeded:
+		return api.AccessEntry_ReverseProxy_REQUEST_TIMEOUT
+	case errors.As(err, &op) && op.Op == "dial":
+		if op.Timeout() {
+			return api.AccessEntry_ReverseProxy_CONNECT_TIMEOUT
+		}
+		return api.AccessEntry_ReverseProxy_CONNECT_FAILED
+	}
+	return api.AccessEntry_ReverseProxy_UPSTREAM_ERROR
 }
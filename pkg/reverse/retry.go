@@ -0,0 +1,298 @@
+package reverse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var totalRetries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "tt",
+		Name:      "http_retries_total",
+		Help:      "Total number of retried requests to upstreams",
+	},
+	[]string{"route", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(totalRetries)
+}
+
+const (
+	defaultRetryRatio     = 0.2
+	defaultMinRetries     = 10
+	defaultRetryTTL       = 10 * time.Second
+	defaultMaxRetries     = 2
+	defaultMaxRetryBody   = 64 << 10
+	maxDistinctTargetPick = 3
+)
+
+// NewRetry returns proxy wrapped to retry requests according to the retries of
+// route.
+func NewRetry(route *api.Route, ba balance.Balance, proxy *httputil.ReverseProxy) *Retry {
+	o := route.Retries
+	t := &retryTransport{
+		route:  route.Name,
+		next:   proxy.Transport,
+		ba:     ba,
+		budget: newRetryBudget(o.Budget),
+		max:    defaultMaxRetries,
+		codes:  make(map[int]bool),
+	}
+	if t.next == nil {
+		t.next = http.DefaultTransport
+	}
+	if o.MaxRetries > 0 {
+		t.max = int(o.MaxRetries)
+	}
+	for _, c := range o.StatusCodes {
+		t.codes[int(c)] = true
+	}
+	proxy.Transport = t
+	rt := &Retry{
+		proxy:   proxy,
+		maxBody: defaultMaxRetryBody,
+	}
+	if o.MaxBodySize > 0 {
+		rt.maxBody = int64(o.MaxBodySize)
+	}
+	return rt
+}
+
+// Retry retries requests to a route on a different load_balance target. Retries
+// of all requests are limited by the route's budget.
+type Retry struct {
+	proxy   http.Handler
+	maxBody int64
+}
+
+// retryState is the state of a request shared with the retry transport.
+type retryState struct {
+	// url of the request before it was directed to a target.
+	url url.URL
+	// replay is true when the request can be sent again.
+	replay   bool
+	attempts int
+}
+
+type retryKey struct{}
+
+func (rt *Retry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	state := &retryState{url: *r.URL, replay: idempotent(r.Method)}
+	if state.replay {
+		state.replay = rt.buffer(r)
+	}
+	rt.proxy.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), retryKey{}, state)))
+	if m := meta.GetMetics(r.Context()); m != nil {
+		m.ReverseProxy.Attempts = int32(state.attempts)
+	}
+}
+
+// buffer reads the body of r up to the body limit so it can be sent again. It
+// returns false when the body is too large.
+func (rt *Retry) buffer(r *http.Request) bool {
+	if r.Body == nil || r.Body == http.NoBody {
+		return true
+	}
+	if r.ContentLength > rt.maxBody {
+		return false
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, rt.maxBody+1))
+	if err != nil || int64(len(b)) > rt.maxBody {
+		// what was read is still sent to the upstream.
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(b), r.Body), r.Body}
+		return false
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), nil
+	}
+	return true
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryTransport sends requests again to other targets when they failed.
+type retryTransport struct {
+	route  string
+	next   http.RoundTripper
+	ba     balance.Balance
+	budget *retryBudget
+	max    int
+	codes  map[int]bool
+}
+
+func (t *retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	state, ok := r.Context().Value(retryKey{}).(*retryState)
+	if !ok {
+		return t.next.RoundTrip(r)
+	}
+	t.budget.request()
+	for {
+		state.attempts++
+		res, err := t.next.RoundTrip(r)
+		if !t.retryable(state, res, err) {
+			return res, err
+		}
+		if !t.budget.withdraw() {
+			totalRetries.WithLabelValues(t.route, "budget_exhausted").Inc()
+			return res, err
+		}
+		next, ok := t.redirect(state, r)
+		if !ok {
+			return res, err
+		}
+		if res != nil {
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+		}
+		totalRetries.WithLabelValues(t.route, "retried").Inc()
+		r = next
+	}
+}
+
+func (t *retryTransport) retryable(state *retryState, res *http.Response, err error) bool {
+	if !state.replay || state.attempts > t.max {
+		return false
+	}
+	if err != nil {
+		var op *net.OpError
+		return errors.As(err, &op) && op.Op == "dial"
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return t.codes[res.StatusCode]
+}
+
+// redirect returns a copy of r sent to another target.
+func (t *retryTransport) redirect(state *retryState, r *http.Request) (*http.Request, bool) {
+	var target *balance.Target
+	for i := 0; i < maxDistinctTargetPick; i++ {
+		target = t.ba.Next()
+		if target == nil || target.URL.Host != r.URL.Host {
+			break
+		}
+	}
+	if target == nil {
+		return nil, false
+	}
+	next := r.Clone(r.Context())
+	u := state.url
+	next.URL = &u
+	Request(target.URL, next)
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, false
+		}
+		next.Body = body
+	}
+	if m := meta.GetMetics(r.Context()); m != nil {
+		m.ReverseProxy.Target = target.URL.String()
+	}
+	return next, true
+}
+
+// retryBudget limits retries to a ratio of requests within ttl, min retries are
+// always allowed every second. Requests and retries are counted in buckets of
+// one second.
+type retryBudget struct {
+	ratio   float64
+	min     int
+	mu      sync.Mutex
+	buckets []retryBucket
+	now     func() time.Time
+}
+
+type retryBucket struct {
+	second   int64
+	requests int
+	retries  int
+}
+
+func newRetryBudget(b *api.RetryBudget) *retryBudget {
+	ratio := defaultRetryRatio
+	if b.GetRetryRatio() > 0 {
+		ratio = float64(b.RetryRatio)
+	}
+	min := uint32(defaultMinRetries)
+	if b.GetMinRetriesPerSecond() > 0 {
+		min = b.MinRetriesPerSecond
+	}
+	ttl := defaultRetryTTL
+	if b.GetTtl() != nil {
+		if d, err := ptypes.Duration(b.Ttl); err == nil && d >= time.Second {
+			ttl = d
+		}
+	}
+	return &retryBudget{
+		ratio:   ratio,
+		min:     int(min),
+		buckets: make([]retryBucket, int(ttl/time.Second)),
+		now:     time.Now,
+	}
+}
+
+// bucket returns the bucket of the current second.
+func (b *retryBudget) bucket() *retryBucket {
+	s := b.now().Unix()
+	x := &b.buckets[int(s%int64(len(b.buckets)))]
+	if x.second != s {
+		*x = retryBucket{second: s}
+	}
+	return x
+}
+
+func (b *retryBudget) request() {
+	b.mu.Lock()
+	b.bucket().requests++
+	b.mu.Unlock()
+}
+
+// withdraw returns true and counts a retry if the budget allows one more.
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	x := b.bucket()
+	oldest := x.second - int64(len(b.buckets))
+	var requests, retries int
+	for _, v := range b.buckets {
+		if v.second > oldest {
+			requests += v.requests
+			retries += v.retries
+		}
+	}
+	if x.retries >= b.min && float64(retries) >= b.ratio*float64(requests) {
+		return false
+	}
+	x.retries++
+	return true
+}
@@ -0,0 +1,148 @@
+package reverse
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+)
+
+func TestRetry(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(b)
+	}))
+	defer good.Close()
+
+	serve := func(t *testing.T, h http.Handler, method, body string) (*httptest.ResponseRecorder, *api.AccessEntry) {
+		t.Helper()
+		e := &api.AccessEntry{ReverseProxy: &api.AccessEntry_ReverseProxy{}}
+		r := httptest.NewRequest(method, "/", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), e)))
+		return w, e
+	}
+	route := func(retries *api.Retries, targets ...string) http.Handler {
+		r := &api.Route{Name: "retry", Retries: retries}
+		for _, v := range targets {
+			r.LoadBalance = append(r.LoadBalance, &api.WeightedAddr{
+				Addr:   &api.Address{Address: v},
+				Weight: 1,
+			})
+		}
+		ba, err := balance.FromRoute(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, err := New(r, ba)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	t.Run("other target", func(t *testing.T) {
+		h := route(&api.Retries{Enabled: true}, bad.URL, good.URL)
+		var retried bool
+		for i := 0; i < 2; i++ {
+			w, e := serve(t, h, http.MethodPut, "hello")
+			if w.Code != http.StatusOK || w.Body.String() != "hello" {
+				t.Fatalf("expected hello got %d %q", w.Code, w.Body)
+			}
+			if e.ReverseProxy.Attempts == 2 {
+				retried = true
+				if e.ReverseProxy.Target != good.URL {
+					t.Errorf("expected target %s got %s", good.URL, e.ReverseProxy.Target)
+				}
+			}
+		}
+		if !retried {
+			t.Error("expected a retried request")
+		}
+	})
+	t.Run("max retries", func(t *testing.T) {
+		h := route(&api.Retries{Enabled: true, MaxRetries: 3}, bad.URL)
+		w, e := serve(t, h, http.MethodGet, "")
+		if w.Code != http.StatusServiceUnavailable || e.ReverseProxy.Attempts != 4 {
+			t.Errorf("expected 503 after 4 attempts got %d after %d", w.Code, e.ReverseProxy.Attempts)
+		}
+	})
+	t.Run("connect failure", func(t *testing.T) {
+		h := route(&api.Retries{Enabled: true}, "http://127.0.0.1:1", good.URL)
+		for i := 0; i < 2; i++ {
+			if w, _ := serve(t, h, http.MethodGet, ""); w.Code != http.StatusOK {
+				t.Fatalf("expected 200 got %d", w.Code)
+			}
+		}
+	})
+	t.Run("status codes", func(t *testing.T) {
+		teapot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}))
+		defer teapot.Close()
+		h := route(&api.Retries{Enabled: true, StatusCodes: []int32{http.StatusTeapot}}, teapot.URL)
+		if _, e := serve(t, h, http.MethodGet, ""); e.ReverseProxy.Attempts != 3 {
+			t.Errorf("expected 3 attempts got %d", e.ReverseProxy.Attempts)
+		}
+	})
+
+	sample := []struct {
+		desc   string
+		method string
+		body   string
+	}{
+		{"not idempotent", http.MethodPost, ""},
+		{"body too large", http.MethodPut, "too large"},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			h := route(&api.Retries{Enabled: true, MaxBodySize: 4}, bad.URL)
+			if _, e := serve(t, h, s.method, s.body); e.ReverseProxy.Attempts != 1 {
+				t.Errorf("expected 1 attempt got %d", e.ReverseProxy.Attempts)
+			}
+		})
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	now := time.Unix(100, 0)
+	b := newRetryBudget(&api.RetryBudget{
+		RetryRatio:          0.5,
+		MinRetriesPerSecond: 1,
+	})
+	b.now = func() time.Time { return now }
+	b.request()
+	b.request()
+	if !b.withdraw() {
+		t.Fatal("expected min retries per second to be allowed")
+	}
+	if b.withdraw() {
+		t.Fatal("expected retry ratio to be exceeded")
+	}
+	now = now.Add(time.Second)
+	if !b.withdraw() || b.withdraw() {
+		t.Fatal("expected one retry every second")
+	}
+	for i := 0; i < 4; i++ {
+		b.request()
+	}
+	if !b.withdraw() || b.withdraw() {
+		t.Error("expected one more retry within the ratio")
+	}
+	// retries expire after ttl
+	now = now.Add(defaultRetryTTL)
+	b.request()
+	if !b.withdraw() {
+		t.Error("expected expired retries to be ignored")
+	}
+}
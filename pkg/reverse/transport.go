@@ -0,0 +1,241 @@
+package reverse
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/proto"
+)
+
+var errResponseHeaderTimeout = errors.New("reverse: timeout awaiting response headers")
+
+// Transport returns the transport used to send requests to upstreams of
+// route. It returns nil when the default transport is used.
+func Transport(route *api.Route) (http.RoundTripper, error) {
+	t, err := transport(route)
+	if err != nil {
+		return nil, err
+	}
+	if d, _ := ptypes.Duration(route.GetHttpUpstream().GetResponseHeaderTimeout()); d > 0 {
+		if t == nil {
+			t = http.DefaultTransport
+		}
+		t = &headerTimeout{next: t, timeout: d}
+	}
+	return t, nil
+}
+
+func transport(route *api.Route) (http.RoundTripper, error) {
+	u := route.HttpUpstream
+	d := routeDialer(route)
+	switch u.GetProtocol() {
+	case api.HTTPUpstream_HTTP1:
+		t := httpTransport(d, u)
+		t.ForceAttemptHTTP2 = false
+		// a non nil map disables HTTP/2
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		return t, nil
+	case api.HTTPUpstream_H2:
+		t := &http2.Transport{
+			DialTLSContext: func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+				return (&tls.Dialer{NetDialer: d, Config: cfg}).DialContext(ctx, network, addr)
+			},
+		}
+		configureHTTP2(t, u)
+		return t, nil
+	case api.HTTPUpstream_H2C:
+		t := &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return d.DialContext(ctx, network, addr)
+			},
+		}
+		configureHTTP2(t, u)
+		return t, nil
+	default:
+		if d == dialer && !customPool(u) && u.GetReadIdleTimeout() == nil &&
+			u.GetMaxHeaderListSize() == 0 && !u.GetStrictMaxConcurrentStreams() {
+			return nil, nil
+		}
+		t := httpTransport(d, u)
+		if u == nil {
+			return t, nil
+		}
+		t2, err := http2.ConfigureTransports(t)
+		if err != nil {
+			return nil, err
+		}
+		configureHTTP2(t2, u)
+		return t, nil
+	}
+}
+
+// grpcTransport returns the transport used to send gRPC calls to upstreams of
+// route. Calls are sent over HTTP/2, cleartext for http upstreams, unless the
+// route picks H2 or H2C.
+func grpcTransport(route *api.Route) (http.RoundTripper, error) {
+	switch route.GetHttpUpstream().GetProtocol() {
+	case api.HTTPUpstream_H2, api.HTTPUpstream_H2C:
+		return Transport(route)
+	}
+	r := proto.Clone(route).(*api.Route)
+	if r.HttpUpstream == nil {
+		r.HttpUpstream = &api.HTTPUpstream{}
+	}
+	r.HttpUpstream.Protocol = api.HTTPUpstream_H2
+	h2, err := Transport(r)
+	if err != nil {
+		return nil, err
+	}
+	r.HttpUpstream.Protocol = api.HTTPUpstream_H2C
+	h2c, err := Transport(r)
+	if err != nil {
+		return nil, err
+	}
+	return schemeTransport{"https": h2, "http": h2c}, nil
+}
+
+var dialer = &net.Dialer{
+	Timeout:   30 * time.Second,
+	KeepAlive: 30 * time.Second,
+}
+
+// routeDialer returns the dialer of connections to upstreams of route.
+func routeDialer(route *api.Route) *net.Dialer {
+	timeout, _ := ptypes.Duration(route.Timeout)
+	keepAlive, _ := ptypes.Duration(route.KeepAlive)
+	if timeout == 0 && keepAlive == 0 {
+		return dialer
+	}
+	d := *dialer
+	if timeout != 0 {
+		d.Timeout = timeout
+	}
+	if keepAlive != 0 {
+		d.KeepAlive = keepAlive
+	}
+	return &d
+}
+
+func customPool(u *api.HTTPUpstream) bool {
+	return u.GetMaxIdleConns() != 0 || u.GetMaxIdleConnsPerHost() != 0 ||
+		u.GetMaxConnsPerHost() != 0 || u.GetIdleConnTimeout() != nil
+}
+
+// httpTransport returns a copy of the default transport dialing with d and
+// using the connection pool settings of u.
+func httpTransport(d *net.Dialer, u *api.HTTPUpstream) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.DialContext = d.DialContext
+	if u == nil {
+		return t
+	}
+	if u.MaxIdleConns != 0 {
+		t.MaxIdleConns = int(u.MaxIdleConns)
+	}
+	if u.MaxIdleConnsPerHost != 0 {
+		t.MaxIdleConnsPerHost = int(u.MaxIdleConnsPerHost)
+	}
+	t.MaxConnsPerHost = int(u.MaxConnsPerHost)
+	if u.IdleConnTimeout != nil {
+		t.IdleConnTimeout, _ = ptypes.Duration(u.IdleConnTimeout)
+	}
+	return t
+}
+
+func configureHTTP2(t *http2.Transport, u *api.HTTPUpstream) {
+	if u.ReadIdleTimeout != nil {
+		t.ReadIdleTimeout, _ = ptypes.Duration(u.ReadIdleTimeout)
+	}
+	if u.PingTimeout != nil {
+		t.PingTimeout, _ = ptypes.Duration(u.PingTimeout)
+	}
+	t.StrictMaxConcurrentStreams = u.StrictMaxConcurrentStreams
+	t.MaxHeaderListSize = u.MaxHeaderListSize
+}
+
+// reportTo returns t reporting results of requests to ba when it ejects failing
+// targets.
+func reportTo(ba balance.Balance, t http.RoundTripper) http.RoundTripper {
+	r, ok := ba.(balance.Reporter)
+	if !ok {
+		return t
+	}
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	return &reportTransport{next: t, r: r}
+}
+
+// reportTransport reports requests failing to connect or answered with a 5xx
+// status code as failed. Requests cancelled by clients are not reported.
+type reportTransport struct {
+	next http.RoundTripper
+	r    balance.Reporter
+}
+
+func (t *reportTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(r)
+	switch {
+	case err == nil:
+		t.r.Report(r.URL, res.StatusCode < 500)
+	case !errors.Is(r.Context().Err(), context.Canceled):
+		t.r.Report(r.URL, false)
+	}
+	return res, err
+}
+
+// headerTimeout fails requests whose response headers don't arrive in time.
+// Unlike http.Transport.ResponseHeaderTimeout it works with HTTP/2 transports
+// too.
+type headerTimeout struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (h *headerTimeout) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(r.Context())
+	var expired atomic.Bool
+	timer := time.AfterFunc(h.timeout, func() {
+		expired.Store(true)
+		cancel()
+	})
+	res, err := h.next.RoundTrip(r.WithContext(ctx))
+	if !timer.Stop() && err == nil {
+		// the timer fired after headers arrived, the body is already cancelled.
+		res.Body.Close()
+		err = errResponseHeaderTimeout
+	}
+	if err != nil {
+		cancel()
+		if expired.Load() {
+			return nil, errResponseHeaderTimeout
+		}
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelBody cancels the context of a request when its response body is
+// closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
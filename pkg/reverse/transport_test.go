@@ -0,0 +1,108 @@
+package reverse
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestTimeouts(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	back := httptest.NewServer(h2c.NewHandler(slow, &http2.Server{}))
+	defer back.Close()
+	ms := func(n int) *duration.Duration {
+		return ptypes.DurationProto(time.Duration(n) * time.Millisecond)
+	}
+	sample := []struct {
+		desc     string
+		target   string
+		upstream *api.HTTPUpstream
+		code     int
+		failure  api.AccessEntry_ReverseProxy_Failure
+	}{
+		{"response header", back.URL, &api.HTTPUpstream{
+			ResponseHeaderTimeout: ms(20),
+		}, http.StatusGatewayTimeout, api.AccessEntry_ReverseProxy_RESPONSE_HEADER_TIMEOUT},
+		{"h2c response header", back.URL, &api.HTTPUpstream{
+			Protocol:              api.HTTPUpstream_H2C,
+			ResponseHeaderTimeout: ms(20),
+		}, http.StatusGatewayTimeout, api.AccessEntry_ReverseProxy_RESPONSE_HEADER_TIMEOUT},
+		{"request", back.URL, &api.HTTPUpstream{
+			RequestTimeout: ms(20),
+		}, http.StatusGatewayTimeout, api.AccessEntry_ReverseProxy_REQUEST_TIMEOUT},
+		{"connect", "http://127.0.0.1:1", nil, http.StatusBadGateway, api.AccessEntry_ReverseProxy_CONNECT_FAILED},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			route := &api.Route{
+				Name:         "timeout",
+				LoadBalance:  []*api.WeightedAddr{{Addr: &api.Address{Address: s.target}}},
+				HttpUpstream: s.upstream,
+			}
+			ba, err := balance.FromRoute(route)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h, err := New(route, ba)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := &api.AccessEntry{ReverseProxy: &api.AccessEntry_ReverseProxy{}}
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), e)))
+			if w.Code != s.code || e.ReverseProxy.Failure != s.failure {
+				t.Errorf("expected %d %v got %d %v", s.code, s.failure, w.Code, e.ReverseProxy.Failure)
+			}
+		})
+	}
+}
+
+func TestUpstreamFailure(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	err := &net.OpError{Op: "dial", Net: "tcp", Err: os.ErrDeadlineExceeded}
+	if f := upstreamFailure(r, err); f != api.AccessEntry_ReverseProxy_CONNECT_TIMEOUT {
+		t.Errorf("expected CONNECT_TIMEOUT got %v", f)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	rt, err := Transport(&api.Route{
+		Timeout: ptypes.DurationProto(time.Second),
+		HttpUpstream: &api.HTTPUpstream{
+			MaxIdleConns:        10,
+			MaxIdleConnsPerHost: 5,
+			MaxConnsPerHost:     20,
+			IdleConnTimeout:     ptypes.DurationProto(time.Minute),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, ok := rt.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport got %T", rt)
+	}
+	if x.MaxIdleConns != 10 || x.MaxIdleConnsPerHost != 5 || x.MaxConnsPerHost != 20 || x.IdleConnTimeout != time.Minute {
+		t.Errorf("unexpected pool settings %d %d %d %v", x.MaxIdleConns, x.MaxIdleConnsPerHost, x.MaxConnsPerHost, x.IdleConnTimeout)
+	}
+	if rt, _ := Transport(&api.Route{}); rt != nil {
+		t.Errorf("expected the default transport got %T", rt)
+	}
+}
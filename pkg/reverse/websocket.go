@@ -0,0 +1,571 @@
+package reverse
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+	"golang.org/x/net/http/httpguts"
+)
+
+var (
+	websocketConns = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tt",
+			Name:      "websocket_connections",
+			Help:      "Number of open websocket connections",
+		},
+		[]string{"route"},
+	)
+	websocketDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tt",
+			Name:      "websocket_connection_duration",
+			Help:      "Duration in milliseconds of websocket connections",
+		},
+		[]string{"route"},
+	)
+	totalWebsocketMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "websocket_messages_total",
+			Help:      "Total number of websocket messages",
+		},
+		[]string{"route", "direction"},
+	)
+	totalWebsocketBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "websocket_bytes_total",
+			Help:      "Total number of bytes of websocket frames",
+		},
+		[]string{"route", "direction"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		websocketConns,
+		websocketDuration,
+		totalWebsocketMessages,
+		totalWebsocketBytes,
+	)
+}
+
+// websocket opcodes
+const (
+	opClose = 0x8
+	opPing  = 0x9
+)
+
+// pingFrame is an unmasked ping without payload, as sent by servers.
+var pingFrame = []byte{0x80 | opPing, 0}
+
+var errBadUpgrade = errors.New("reverse: upstream did not switch to websocket")
+
+// WebSocket proxies websocket connections to the load_balance targets of a
+// route. Frames are parsed while they are copied, to count messages and to
+// inject pings to clients.
+type WebSocket struct {
+	route        string
+	ba           balance.Balance
+	origins      []string
+	protocols    []string
+	dialTimeout  time.Duration
+	idleTimeout  time.Duration
+	pingInterval time.Duration
+	pingTimeout  time.Duration
+}
+
+func NewWebSocket(route *api.Route, ba balance.Balance) (*WebSocket, error) {
+	ws := &WebSocket{
+		route:     route.Name,
+		ba:        ba,
+		origins:   route.GetWebsocket().GetAllowedOrigins(),
+		protocols: route.GetWebsocket().GetSubprotocols(),
+	}
+	ws.dialTimeout, _ = ptypes.Duration(route.Timeout)
+	if o := route.Websocket; o != nil {
+		ws.idleTimeout, _ = ptypes.Duration(o.IdleTimeout)
+		ws.pingInterval, _ = ptypes.Duration(o.PingInterval)
+		ws.pingTimeout, _ = ptypes.Duration(o.PingTimeout)
+	}
+	if ws.pingTimeout == 0 {
+		ws.pingTimeout = ws.pingInterval
+	}
+	return ws, nil
+}
+
+func (ws *WebSocket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isWebSocketUpgrade(r) {
+		w.Header().Set("Connection", "Upgrade")
+		w.Header().Set("Upgrade", "websocket")
+		http.Error(w, http.StatusText(http.StatusUpgradeRequired), http.StatusUpgradeRequired)
+		return
+	}
+	if !matchOrigin(ws.origins, r.Header.Get("Origin")) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	offered := headerTokens(r.Header, "Sec-WebSocket-Protocol")
+	protocols := filterProtocols(ws.protocols, offered)
+	if len(offered) > 0 && len(protocols) == 0 {
+		http.Error(w, "unsupported websocket subprotocol", http.StatusBadRequest)
+		return
+	}
+	target := ws.ba.Next()
+	if target == nil {
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+	m := meta.GetMetics(r.Context())
+	if m != nil {
+		m.ReverseProxy.Target = target.URL.String()
+	}
+	up, res, err := ws.dial(r, target.URL, protocols)
+	if x, ok := ws.ba.(balance.Reporter); ok && !errors.Is(r.Context().Err(), context.Canceled) {
+		x.Report(target.URL, err == nil && res.StatusCode < 500)
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+	defer up.Close()
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		// the upstream refused the upgrade, pass its response to the client.
+		defer res.Body.Close()
+		copyHeader(w.Header(), res.Header)
+		w.WriteHeader(res.StatusCode)
+		io.Copy(w, res.Body)
+		return
+	}
+	chosen := res.Header.Get("Sec-WebSocket-Protocol")
+	if !httpguts.HeaderValuesContainsToken(res.Header["Upgrade"], "websocket") ||
+		(chosen != "" && !contains(protocols, chosen)) {
+		http.Error(w, errBadUpgrade.Error(), http.StatusBadGateway)
+		return
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket upgrades are only supported over HTTP/1.1", http.StatusInternalServerError)
+		return
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	brw.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+	res.Header.Write(brw)
+	brw.WriteString("\r\n")
+	if err := brw.Flush(); err != nil {
+		return
+	}
+	s := &wsSession{
+		ws:         ws,
+		downstream: &wsPeer{conn: conn, r: brw.Reader, w: brw.Writer},
+		upstream:   &wsPeer{conn: up, r: res.upstream, w: bufio.NewWriter(up)},
+	}
+	start := time.Now()
+	websocketConns.WithLabelValues(ws.route).Inc()
+	s.run()
+	websocketConns.WithLabelValues(ws.route).Dec()
+	websocketDuration.WithLabelValues(ws.route).Observe(float64(time.Since(start).Milliseconds()))
+	// frames from the downstream are copied by the upstream peer, and the other
+	// way around.
+	received, sent := s.upstream, s.downstream
+	totalWebsocketMessages.WithLabelValues(ws.route, "received").Add(float64(received.messages))
+	totalWebsocketMessages.WithLabelValues(ws.route, "sent").Add(float64(sent.messages))
+	totalWebsocketBytes.WithLabelValues(ws.route, "received").Add(float64(received.bytes))
+	totalWebsocketBytes.WithLabelValues(ws.route, "sent").Add(float64(sent.bytes))
+	if m != nil {
+		m.Response.StatusCode = http.StatusSwitchingProtocols
+		m.Websocket.Subprotocol = chosen
+		m.Websocket.MessagesReceived = received.messages
+		m.Websocket.MessagesSent = sent.messages
+		m.Websocket.BytesReceived = received.bytes
+		m.Websocket.BytesSent = sent.bytes
+		m.Websocket.CloseCode = s.closeCode.Load()
+	}
+}
+
+// upgradeResponse is the response of the upstream to the upgrade request.
+type upgradeResponse struct {
+	*http.Response
+	// upstream reads frames sent after the response.
+	upstream *bufio.Reader
+}
+
+// dial sends the upgrade request r to target.
+func (ws *WebSocket) dial(r *http.Request, target *url.URL, protocols []string) (net.Conn, *upgradeResponse, error) {
+	out := r.Clone(r.Context())
+	Request(target, out)
+	for _, h := range hopHeaders {
+		out.Header.Del(h)
+	}
+	out.Header.Set("Connection", "Upgrade")
+	out.Header.Set("Upgrade", "websocket")
+	out.Header.Del("Sec-WebSocket-Protocol")
+	if len(protocols) > 0 {
+		out.Header.Set("Sec-WebSocket-Protocol", strings.Join(protocols, ", "))
+	}
+	// a nil X-Forwarded-For is not added, as with the reverse proxy.
+	if prior, ok := out.Header["X-Forwarded-For"]; ok && prior == nil {
+		delete(out.Header, "X-Forwarded-For")
+	} else if ip, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if len(prior) > 0 {
+			ip = strings.Join(prior, ", ") + ", " + ip
+		}
+		out.Header.Set("X-Forwarded-For", ip)
+	}
+	conn, err := ws.dialTarget(r.Context(), target)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the upstream must answer the upgrade within the dial timeout.
+	conn.SetDeadline(time.Now().Add(ws.handshakeTimeout()))
+	if err := out.Write(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	br := bufio.NewReader(conn)
+	res, err := http.ReadResponse(br, out)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, &upgradeResponse{Response: res, upstream: br}, nil
+}
+
+func (ws *WebSocket) handshakeTimeout() time.Duration {
+	if ws.dialTimeout != 0 {
+		return ws.dialTimeout
+	}
+	return dialer.Timeout
+}
+
+func (ws *WebSocket) dialTarget(ctx context.Context, target *url.URL) (net.Conn, error) {
+	d := *dialer
+	d.Timeout = ws.handshakeTimeout()
+	host := target.Host
+	switch target.Scheme {
+	case "https", "wss":
+		if target.Port() == "" {
+			host = net.JoinHostPort(target.Hostname(), "443")
+		}
+		td := &tls.Dialer{
+			NetDialer: &d,
+			Config:    &tls.Config{ServerName: target.Hostname()},
+		}
+		return td.DialContext(ctx, "tcp", host)
+	default:
+		if target.Port() == "" {
+			host = net.JoinHostPort(target.Hostname(), "80")
+		}
+		return d.DialContext(ctx, "tcp", host)
+	}
+}
+
+// Hop-by-hop headers that are not passed to upstreams. Connection and Upgrade
+// are set again for the websocket upgrade.
+var hopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	return r.Method == http.MethodGet &&
+		httpguts.HeaderValuesContainsToken(r.Header["Connection"], "upgrade") &&
+		httpguts.HeaderValuesContainsToken(r.Header["Upgrade"], "websocket")
+}
+
+// matchOrigin returns true if origin is allowed by patterns. Patterns are exact
+// origins or have a wildcard for subdomains eg https://*.example.com
+func matchOrigin(patterns []string, origin string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	if origin == "" {
+		return false
+	}
+	origin = strings.ToLower(origin)
+	for _, p := range patterns {
+		p = strings.ToLower(p)
+		if p == origin {
+			return true
+		}
+		i := strings.Index(p, "*.")
+		if i == -1 {
+			continue
+		}
+		prefix, suffix := p[:i], p[i+1:]
+		if len(origin) > len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+			!strings.ContainsAny(origin[len(prefix):len(origin)-len(suffix)], "/:") {
+			return true
+		}
+	}
+	return false
+}
+
+// headerTokens returns comma separated values of header key.
+func headerTokens(h http.Header, key string) (ls []string) {
+	for _, v := range h[http.CanonicalHeaderKey(key)] {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				ls = append(ls, t)
+			}
+		}
+	}
+	return
+}
+
+// filterProtocols returns subprotocols in offered that are allowed.
+func filterProtocols(allowed, offered []string) []string {
+	if len(allowed) == 0 {
+		return offered
+	}
+	var ls []string
+	for _, v := range offered {
+		if contains(allowed, v) {
+			ls = append(ls, v)
+		}
+	}
+	return ls
+}
+
+func contains(ls []string, v string) bool {
+	for _, x := range ls {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, vv := range src {
+		for _, v := range vv {
+			dst.Add(k, v)
+		}
+	}
+}
+
+// wsPeer is one side of a websocket connection. It copies frames read from the
+// other side to conn.
+type wsPeer struct {
+	conn net.Conn
+	// r reads frames sent by conn.
+	r *bufio.Reader
+	// mu guards w, pings are written to downstream while frames are copied.
+	mu sync.Mutex
+	w  *bufio.Writer
+	// messages and bytes written to conn. They are only read after copying
+	// completed.
+	messages int64
+	bytes    int64
+}
+
+func (p *wsPeer) writePing() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, err := p.w.Write(pingFrame); err != nil {
+		return err
+	}
+	return p.w.Flush()
+}
+
+// wsSession proxies frames between a client and an upstream.
+type wsSession struct {
+	ws         *WebSocket
+	downstream *wsPeer
+	upstream   *wsPeer
+	// unix nano time of the last frame in either direction, and of the last
+	// frame from the downstream.
+	lastFrame, lastDownstream atomic.Int64
+	closeCode                 atomic.Int32
+	closing                   atomic.Bool
+}
+
+func (s *wsSession) run() {
+	now := time.Now().UnixNano()
+	s.lastFrame.Store(now)
+	s.lastDownstream.Store(now)
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			s.downstream.conn.Close()
+			s.upstream.conn.Close()
+		})
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer stop()
+		s.copy(s.upstream, s.downstream.r, true)
+	}()
+	go func() {
+		defer wg.Done()
+		defer stop()
+		s.copy(s.downstream, s.upstream.r, false)
+	}()
+	s.watch(done, stop)
+	wg.Wait()
+}
+
+// watch closes the session when it is idle or the downstream stopped
+// answering pings.
+func (s *wsSession) watch(done chan struct{}, stop func()) {
+	tick := s.ws.idleTimeout
+	for _, d := range []time.Duration{s.ws.pingInterval, s.ws.pingTimeout} {
+		if d != 0 && (tick == 0 || d < tick) {
+			tick = d
+		}
+	}
+	if tick == 0 {
+		<-done
+		return
+	}
+	t := time.NewTicker(tick / 4)
+	defer t.Stop()
+	var pingSent int64
+	var pinging atomic.Bool
+	for {
+		select {
+		case <-done:
+			return
+		case <-t.C:
+		}
+		now := time.Now().UnixNano()
+		if s.ws.idleTimeout != 0 && now-s.lastFrame.Load() >= int64(s.ws.idleTimeout) {
+			stop()
+			return
+		}
+		if s.ws.pingInterval == 0 || s.closing.Load() {
+			continue
+		}
+		answered := s.lastDownstream.Load() >= pingSent
+		if !answered && now-pingSent >= int64(s.ws.pingTimeout) {
+			stop()
+			return
+		}
+		if answered && now-pingSent >= int64(s.ws.pingInterval) && pinging.CAS(false, true) {
+			pingSent = now
+			// writing blocks while a frame is copied to the downstream.
+			go func() {
+				defer pinging.Store(false)
+				if s.downstream.writePing() != nil {
+					stop()
+				}
+			}()
+		}
+	}
+}
+
+// copy copies frames read from src to dst. fromDownstream is true when src
+// reads frames sent by the client.
+func (s *wsSession) copy(dst *wsPeer, src *bufio.Reader, fromDownstream bool) error {
+	var hdr [14]byte
+	for {
+		if _, err := io.ReadFull(src, hdr[:2]); err != nil {
+			return err
+		}
+		fin := hdr[0]&0x80 != 0
+		op := hdr[0] & 0x0f
+		n := 2
+		switch hdr[1] & 0x7f {
+		case 126:
+			n += 2
+		case 127:
+			n += 8
+		}
+		masked := hdr[1]&0x80 != 0
+		if masked {
+			n += 4
+		}
+		if _, err := io.ReadFull(src, hdr[2:n]); err != nil {
+			return err
+		}
+		var size uint64
+		switch hdr[1] & 0x7f {
+		case 126:
+			size = uint64(binary.BigEndian.Uint16(hdr[2:4]))
+		case 127:
+			size = binary.BigEndian.Uint64(hdr[2:10])
+		default:
+			size = uint64(hdr[1] & 0x7f)
+		}
+		now := time.Now().UnixNano()
+		s.lastFrame.Store(now)
+		if fromDownstream {
+			s.lastDownstream.Store(now)
+		}
+		if err := s.writeFrame(dst, src, hdr[:n], op, masked, size); err != nil {
+			return err
+		}
+		dst.bytes += int64(n) + int64(size)
+		if fin && op < opClose {
+			dst.messages++
+		}
+	}
+}
+
+func (s *wsSession) writeFrame(dst *wsPeer, src *bufio.Reader, hdr []byte, op byte, masked bool, size uint64) error {
+	dst.mu.Lock()
+	defer dst.mu.Unlock()
+	if _, err := dst.w.Write(hdr); err != nil {
+		return err
+	}
+	if op == opClose {
+		s.closing.Store(true)
+	}
+	if op == opClose && size >= 2 && size <= 125 {
+		var payload [125]byte
+		b := payload[:size]
+		if _, err := io.ReadFull(src, b); err != nil {
+			return err
+		}
+		code := [2]byte{b[0], b[1]}
+		if masked {
+			key := hdr[len(hdr)-4:]
+			code[0] ^= key[0]
+			code[1] ^= key[1]
+		}
+		s.closeCode.CAS(0, int32(binary.BigEndian.Uint16(code[:])))
+		if _, err := dst.w.Write(b); err != nil {
+			return err
+		}
+	} else if _, err := io.CopyN(dst.w, src, int64(size)); err != nil {
+		return err
+	}
+	if src.Buffered() == 0 {
+		return dst.w.Flush()
+	}
+	return nil
+}
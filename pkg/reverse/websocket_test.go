@@ -0,0 +1,256 @@
+package reverse
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func writeTestFrame(w io.Writer, op byte, payload []byte, mask bool) error {
+	b := []byte{0x80 | op, byte(len(payload))}
+	if mask {
+		key := []byte{1, 2, 3, 4}
+		b[1] |= 0x80
+		b = append(b, key...)
+		for i, c := range payload {
+			b = append(b, c^key[i%4])
+		}
+	} else {
+		b = append(b, payload...)
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readTestFrame(r *bufio.Reader) (op byte, payload []byte, err error) {
+	var hdr [2]byte
+	if _, err = io.ReadFull(r, hdr[:]); err != nil {
+		return
+	}
+	op = hdr[0] & 0x0f
+	size := int(hdr[1] & 0x7f)
+	var key [4]byte
+	if hdr[1]&0x80 != 0 {
+		if _, err = io.ReadFull(r, key[:]); err != nil {
+			return
+		}
+	}
+	payload = make([]byte, size)
+	if _, err = io.ReadFull(r, payload); err != nil {
+		return
+	}
+	if hdr[1]&0x80 != 0 {
+		for i := range payload {
+			payload[i] ^= key[i%4]
+		}
+	}
+	return
+}
+
+// echoWebSocket echoes messages of websocket clients.
+var echoWebSocket = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	conn, brw, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	accept := sha1.Sum([]byte(r.Header.Get("Sec-WebSocket-Key") + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"))
+	fmt.Fprintf(brw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n",
+		base64.StdEncoding.EncodeToString(accept[:]))
+	if p := headerTokens(r.Header, "Sec-WebSocket-Protocol"); len(p) > 0 {
+		fmt.Fprintf(brw, "Sec-WebSocket-Protocol: %s\r\n", p[0])
+	}
+	brw.WriteString("\r\n")
+	brw.Flush()
+	for {
+		op, payload, err := readTestFrame(brw.Reader)
+		if err != nil {
+			return
+		}
+		switch op {
+		case 0xa: // pong
+		case opClose:
+			writeTestFrame(conn, opClose, payload, false)
+			return
+		default:
+			writeTestFrame(conn, op, payload, false)
+		}
+	}
+})
+
+type testWebSocket struct {
+	url   string
+	entry *api.AccessEntry
+	done  chan struct{}
+}
+
+func startWebSocket(t *testing.T, o *api.WebSocket) (*testWebSocket, func()) {
+	t.Helper()
+	back := httptest.NewServer(echoWebSocket)
+	route := &api.Route{
+		Name:        "ws",
+		Protocol:    api.Protocol_WEBSOCKET,
+		LoadBalance: []*api.WeightedAddr{{Addr: &api.Address{Address: back.URL}}},
+		Websocket:   o,
+	}
+	ba, err := balance.FromRoute(route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws, err := NewWebSocket(route, ba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &testWebSocket{
+		entry: &api.AccessEntry{
+			ReverseProxy: &api.AccessEntry_ReverseProxy{},
+			Response:     &api.AccessEntry_Response{},
+			Websocket:    &api.AccessEntry_WebSocket{},
+		},
+		done: make(chan struct{}, 1),
+	}
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws.ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), x.entry)))
+		x.done <- struct{}{}
+	}))
+	x.url = front.URL
+	return x, func() {
+		front.Close()
+		back.Close()
+	}
+}
+
+// dial sends an upgrade request with header h and returns the response.
+func (x *testWebSocket) dial(t *testing.T, h string) (net.Conn, *bufio.Reader, *http.Response) {
+	t.Helper()
+	conn, err := net.Dial("tcp", strings.TrimPrefix(x.url, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Fprintf(conn, "GET /chat HTTP/1.1\r\nHost: example.com\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n"+
+		"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n%s\r\n", h)
+	br := bufio.NewReader(conn)
+	res, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, br, res
+}
+
+func TestWebSocket(t *testing.T) {
+	x, stop := startWebSocket(t, &api.WebSocket{
+		AllowedOrigins: []string{"https://*.example.com"},
+		Subprotocols:   []string{"chat"},
+	})
+	defer stop()
+
+	t.Run("echo", func(t *testing.T) {
+		conn, br, res := x.dial(t, "Origin: https://app.example.com\r\nSec-WebSocket-Protocol: other, chat\r\n")
+		defer conn.Close()
+		if res.StatusCode != http.StatusSwitchingProtocols {
+			t.Fatalf("expected 101 got %d", res.StatusCode)
+		}
+		if p := res.Header.Get("Sec-WebSocket-Protocol"); p != "chat" {
+			t.Errorf("expected chat subprotocol got %q", p)
+		}
+		for _, msg := range []string{"hello", "world"} {
+			if err := writeTestFrame(conn, 0x1, []byte(msg), true); err != nil {
+				t.Fatal(err)
+			}
+			_, payload, err := readTestFrame(br)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(payload) != msg {
+				t.Errorf("expected %q got %q", msg, payload)
+			}
+		}
+		code := make([]byte, 2)
+		binary.BigEndian.PutUint16(code, 1000)
+		writeTestFrame(conn, opClose, code, true)
+		if op, _, err := readTestFrame(br); err != nil || op != opClose {
+			t.Fatalf("expected close frame got %d %v", op, err)
+		}
+		<-x.done
+		w := x.entry.Websocket
+		if w.MessagesReceived != 2 || w.MessagesSent != 2 {
+			t.Errorf("expected 2 messages each way got %d received %d sent", w.MessagesReceived, w.MessagesSent)
+		}
+		if w.BytesReceived != 2*(6+5)+6+2 || w.BytesSent != 2*(2+5)+2+2 {
+			t.Errorf("unexpected byte counts %d received %d sent", w.BytesReceived, w.BytesSent)
+		}
+		if w.CloseCode != 1000 || w.Subprotocol != "chat" {
+			t.Errorf("expected close code 1000 and chat got %d %q", w.CloseCode, w.Subprotocol)
+		}
+		if x.entry.Response.StatusCode != http.StatusSwitchingProtocols {
+			t.Errorf("expected status 101 got %d", x.entry.Response.StatusCode)
+		}
+	})
+
+	sample := []struct {
+		desc   string
+		header string
+		status int
+	}{
+		{"origin not allowed", "Origin: https://example.org\r\n", http.StatusForbidden},
+		{"missing origin", "", http.StatusForbidden},
+		{"subprotocol not allowed", "Origin: https://app.example.com\r\nSec-WebSocket-Protocol: other\r\n", http.StatusBadRequest},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			conn, _, res := x.dial(t, s.header)
+			defer conn.Close()
+			<-x.done
+			if res.StatusCode != s.status {
+				t.Errorf("expected %d got %d", s.status, res.StatusCode)
+			}
+		})
+	}
+
+	t.Run("not an upgrade", func(t *testing.T) {
+		res, err := http.Get(x.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		<-x.done
+		if res.StatusCode != http.StatusUpgradeRequired {
+			t.Errorf("expected 426 got %d", res.StatusCode)
+		}
+	})
+}
+
+func TestWebSocketPingTimeout(t *testing.T) {
+	x, stop := startWebSocket(t, &api.WebSocket{
+		PingInterval: ptypes.DurationProto(50 * time.Millisecond),
+	})
+	defer stop()
+	conn, br, res := x.dial(t, "")
+	defer conn.Close()
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("expected 101 got %d", res.StatusCode)
+	}
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if op, _, err := readTestFrame(br); err != nil || op != opPing {
+		t.Fatalf("expected ping got %d %v", op, err)
+	}
+	// the ping is not answered so the connection is closed.
+	if _, _, err := readTestFrame(br); err != io.EOF {
+		t.Fatalf("expected connection to be closed got %v", err)
+	}
+	<-x.done
+}
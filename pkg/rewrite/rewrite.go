@@ -0,0 +1,166 @@
+// Package rewrite implements middlewares rewriting and redirecting requests of
+// HTTP routes.
+package rewrite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tmpl"
+	"github.com/justinas/alice"
+)
+
+type rewrite struct {
+	route       *api.Route
+	prefix      *api.Middleware_Rewrite_Prefix
+	regex       *regexp.Regexp
+	replacement string
+	path        *tmpl.Template
+	host        *tmpl.Template
+}
+
+// New returns a middleware rewriting paths and hosts of requests of route as
+// configured by o.
+func New(route *api.Route, o *api.Middleware_Rewrite) (alice.Constructor, error) {
+	x := &rewrite{route: route}
+	if p := o.GetPrefix(); p != nil {
+		if p.From == "" {
+			return nil, errors.New("rewrite prefix requires from")
+		}
+		x.prefix = p
+	}
+	if re := o.GetRegex(); re != nil {
+		var err error
+		if x.regex, err = regexp.Compile(re.Pattern); err != nil {
+			return nil, fmt.Errorf("rewrite regex: %v", err)
+		}
+		x.replacement = re.Replacement
+	}
+	if o.Path != "" {
+		var err error
+		if x.path, err = tmpl.Parse(o.Path); err != nil {
+			return nil, fmt.Errorf("rewrite path: %v", err)
+		}
+	}
+	if o.Host != "" {
+		var err error
+		if x.host, err = tmpl.Parse(o.Host); err != nil {
+			return nil, fmt.Errorf("rewrite host: %v", err)
+		}
+	}
+	return x.handler, nil
+}
+
+func (x *rewrite) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := r.URL.EscapedPath()
+		if x.prefix != nil && strings.HasPrefix(p, x.prefix.From) {
+			p = x.prefix.To + p[len(x.prefix.From):]
+		}
+		if x.regex != nil {
+			p = x.regex.ReplaceAllString(p, x.replacement)
+		}
+		if x.path != nil {
+			p = x.path.ExecutePath(x.route, r)
+		}
+		if !strings.HasPrefix(p, "/") {
+			p = "/" + p
+		}
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		setPath(r2.URL, p)
+		if hasDotSegment(r2.URL.Path) {
+			// encoded dots would let clients move out of the rewritten prefix.
+			http.Error(w, "invalid request path", http.StatusBadRequest)
+			return
+		}
+		if x.host != nil {
+			r2.Host = x.host.Execute(x.route, r)
+		}
+		next.ServeHTTP(w, r2)
+	})
+}
+
+// setPath sets the path of u to the escaped path p.
+func setPath(u *url.URL, p string) {
+	if v, err := url.PathUnescape(p); err == nil {
+		u.Path = v
+		u.RawPath = p
+		return
+	}
+	u.Path = p
+	u.RawPath = ""
+}
+
+// hasDotSegment returns true when path p has . or .. segments.
+func hasDotSegment(p string) bool {
+	for _, s := range strings.Split(p, "/") {
+		if s == "." || s == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+type redirect struct {
+	route     *api.Route
+	target    *tmpl.Template
+	permanent bool
+	https     bool
+}
+
+// NewRedirect returns a middleware redirecting requests of route as configured
+// by o.
+func NewRedirect(route *api.Route, o *api.Middleware_Redirect) (alice.Constructor, error) {
+	target := o.Target
+	if target == "" {
+		if !o.Https {
+			return nil, errors.New("redirect requires a target")
+		}
+		target = "https://{host}{uri}"
+	}
+	t, err := tmpl.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("redirect target: %v", err)
+	}
+	x := &redirect{
+		route:     route,
+		target:    t,
+		permanent: o.Permanent,
+		https:     o.Https,
+	}
+	return x.handler, nil
+}
+
+func (x *redirect) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if x.https && r.TLS != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		http.Redirect(w, r, x.target.Execute(x.route, r), x.code(r.Method))
+	})
+}
+
+// code returns the redirect status, methods other than GET and HEAD use codes
+// that don't allow clients to change the method.
+func (x *redirect) code(method string) int {
+	safe := method == http.MethodGet || method == http.MethodHead
+	switch {
+	case x.permanent && safe:
+		return http.StatusMovedPermanently
+	case x.permanent:
+		return http.StatusPermanentRedirect
+	case safe:
+		return http.StatusFound
+	default:
+		return http.StatusTemporaryRedirect
+	}
+}
@@ -0,0 +1,140 @@
+package rewrite
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/gorilla/mux"
+)
+
+func TestRewrite(t *testing.T) {
+	sample := []struct {
+		desc   string
+		o      *api.Middleware_Rewrite
+		path   string
+		expect string
+	}{
+		{"prefix", &api.Middleware_Rewrite{
+			Prefix: &api.Middleware_Rewrite_Prefix{From: "/api", To: "/v2"},
+		}, "/api/users", "/v2/users"},
+		{"prefix not matching", &api.Middleware_Rewrite{
+			Prefix: &api.Middleware_Rewrite_Prefix{From: "/api", To: "/v2"},
+		}, "/users", "/users"},
+		{"strip prefix", &api.Middleware_Rewrite{
+			Prefix: &api.Middleware_Rewrite_Prefix{From: "/api"},
+		}, "/api", "/"},
+		{"regex", &api.Middleware_Rewrite{
+			Regex: &api.Middleware_Rewrite_Regex{Pattern: `^/users/(?P<id>\d+)$`, Replacement: "/u/${id}"},
+		}, "/users/42", "/u/42"},
+		{"regex groups", &api.Middleware_Rewrite{
+			Regex: &api.Middleware_Rewrite_Regex{Pattern: `^/(\w+)/(\w+)`, Replacement: "/$2/$1"},
+		}, "/a/b/c", "/b/a/c"},
+		{"escaped", &api.Middleware_Rewrite{
+			Prefix: &api.Middleware_Rewrite_Prefix{From: "/a", To: "/b"},
+		}, "/a/x%2Fy", "/b/x%2Fy"},
+		{"path template", &api.Middleware_Rewrite{
+			Path: "/{route}{path}",
+		}, "/users", "/app/users"},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			c, err := New(&api.Route{Name: "app"}, s.o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.EscapedPath()
+			})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, s.path, nil))
+			if got != s.expect {
+				t.Errorf("expected %q got %q", s.expect, got)
+			}
+		})
+	}
+}
+
+func TestRewriteRouteVar(t *testing.T) {
+	c, err := New(&api.Route{}, &api.Middleware_Rewrite{
+		Path: "/v2/{var.path}",
+		Host: "{var.path}.internal",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path, host string
+	m := mux.NewRouter()
+	m.Path("/{path:[a-z]+}").Handler(c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, host = r.URL.Path, r.Host
+	})))
+	m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+	if path != "/v2/users" || host != "users.internal" {
+		t.Errorf("expected /v2/users users.internal got %q %q", path, host)
+	}
+}
+
+func TestRewriteDotSegments(t *testing.T) {
+	c, err := New(&api.Route{}, &api.Middleware_Rewrite{Path: "/v2/{var.path}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var path, escaped string
+	m := mux.NewRouter()
+	m.Path("/api/{path:.*}").Handler(c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, escaped = r.URL.Path, r.URL.EscapedPath()
+	})))
+	m.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/%252e%252e/admin", nil))
+	if path != "/v2/%2e%2e/admin" || escaped != "/v2/%252e%252e/admin" {
+		t.Errorf("expected encoded dots to stay literal got %q %q", path, escaped)
+	}
+
+	c, err = New(&api.Route{}, &api.Middleware_Rewrite{
+		Prefix: &api.Middleware_Rewrite_Prefix{From: "/api", To: "/v2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected request to be rejected got %q", r.URL.Path)
+	})).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/%2e%2e/admin", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 got %d", w.Code)
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	sample := []struct {
+		desc     string
+		o        *api.Middleware_Redirect
+		method   string
+		tls      bool
+		code     int
+		location string
+	}{
+		{"https", &api.Middleware_Redirect{Https: true, Permanent: true}, http.MethodGet, false, http.StatusMovedPermanently, "https://example.com/a?q=1"},
+		{"https post", &api.Middleware_Redirect{Https: true, Permanent: true}, http.MethodPost, false, http.StatusPermanentRedirect, "https://example.com/a?q=1"},
+		{"https over tls", &api.Middleware_Redirect{Https: true}, http.MethodGet, true, http.StatusOK, ""},
+		{"temporary", &api.Middleware_Redirect{Target: "https://new.example.com{path}"}, http.MethodGet, true, http.StatusFound, "https://new.example.com/a"},
+		{"temporary post", &api.Middleware_Redirect{Target: "/b?{query}"}, http.MethodPost, false, http.StatusTemporaryRedirect, "/b?q=1"},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			c, err := NewRedirect(&api.Route{}, s.o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := httptest.NewRequest(s.method, "http://example.com/a?q=1", nil)
+			if s.tls {
+				r.TLS = &tls.ConnectionState{}
+			}
+			w := httptest.NewRecorder()
+			c(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(w, r)
+			if w.Code != s.code || w.Header().Get("Location") != s.location {
+				t.Errorf("expected %d %q got %d %q", s.code, s.location, w.Code, w.Header().Get("Location"))
+			}
+		})
+	}
+}
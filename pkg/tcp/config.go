@@ -1,9 +1,24 @@
 package tcp
 
+import (
+	"time"
+
+	"github.com/gernest/tt/api"
+)
+
 // Config contains the proxying state for one listener.
 type Config struct {
 	Routes      []Route
 	AcmeTargets []Target // accumulates targets that should be probed for acme.
 	AllowACME   bool     // if true, AddSNIRoute doesn't add targets to acmeTargets.
-	Network     string
+	Network     string   // network of the listener, either tcp or unix.
+	Bind        *api.Bind
+	Fallback    Target // handles connections that don't match any route.
+	Fingerprint bool   // if true, TLS fingerprints are computed before matching routes.
+
+	// FirstByteTimeout and MatchTimeout bound how long matching routes waits
+	// for data, zero means no timeout.
+	FirstByteTimeout time.Duration
+	MatchTimeout     time.Duration
+	MaxPeek          int // size of the buffer used for matching routes.
 }
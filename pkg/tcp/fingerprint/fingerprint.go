@@ -0,0 +1,319 @@
+// Package fingerprint computes JA3 and JA4 fingerprints of TLS ClientHello
+// messages.
+package fingerprint
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// ErrNotClientHello is returned when parsing bytes that are not a TLS
+// ClientHello.
+var ErrNotClientHello = errors.New("fingerprint: not a tls client hello")
+
+const (
+	recordTypeHandshake   = 0x16
+	handshakeClientHello  = 0x01
+	extServerName         = 0x0000
+	extSupportedGroups    = 0x000a
+	extPointFormats       = 0x000b
+	extSignatureAlgs      = 0x000d
+	extALPN               = 0x0010
+	extSupportedVersions  = 0x002b
+	recordHeaderLen       = 5
+	emptyJA4Hash          = "000000000000"
+	maxJA4Count           = 99
+	ja4TruncatedHashBytes = 6
+)
+
+// ClientHello are the fields of a TLS ClientHello used for fingerprinting.
+// GREASE values are kept, they are skipped when computing fingerprints.
+type ClientHello struct {
+	Version             uint16
+	CipherSuites        []uint16
+	Extensions          []uint16
+	SupportedGroups     []uint16
+	PointFormats        []uint8
+	SignatureAlgorithms []uint16
+	SupportedVersions   []uint16
+	ALPN                []string
+	ServerName          string
+}
+
+// Parse parses a ClientHello from a TLS record. The ClientHello must fit in
+// the first record.
+func Parse(b []byte) (*ClientHello, error) {
+	if len(b) < recordHeaderLen || b[0] != recordTypeHandshake {
+		return nil, ErrNotClientHello
+	}
+	s := cryptobyte.String(b[recordHeaderLen:])
+	var typ uint8
+	var msg cryptobyte.String
+	if !s.ReadUint8(&typ) || typ != handshakeClientHello || !s.ReadUint24LengthPrefixed(&msg) {
+		return nil, ErrNotClientHello
+	}
+	h := &ClientHello{}
+	var session, ciphers, compression cryptobyte.String
+	if !msg.ReadUint16(&h.Version) ||
+		!msg.Skip(32) || // random
+		!msg.ReadUint8LengthPrefixed(&session) ||
+		!msg.ReadUint16LengthPrefixed(&ciphers) ||
+		!msg.ReadUint8LengthPrefixed(&compression) {
+		return nil, ErrNotClientHello
+	}
+	for !ciphers.Empty() {
+		var c uint16
+		if !ciphers.ReadUint16(&c) {
+			return nil, ErrNotClientHello
+		}
+		h.CipherSuites = append(h.CipherSuites, c)
+	}
+	if msg.Empty() {
+		// extensions are optional
+		return h, nil
+	}
+	var exts cryptobyte.String
+	if !msg.ReadUint16LengthPrefixed(&exts) {
+		return nil, ErrNotClientHello
+	}
+	for !exts.Empty() {
+		var typ uint16
+		var data cryptobyte.String
+		if !exts.ReadUint16(&typ) || !exts.ReadUint16LengthPrefixed(&data) {
+			return nil, ErrNotClientHello
+		}
+		h.Extensions = append(h.Extensions, typ)
+		if !h.extension(typ, data) {
+			return nil, fmt.Errorf("fingerprint: malformed extension %d", typ)
+		}
+	}
+	return h, nil
+}
+
+func (h *ClientHello) extension(typ uint16, data cryptobyte.String) bool {
+	switch typ {
+	case extServerName:
+		var list cryptobyte.String
+		if !data.ReadUint16LengthPrefixed(&list) {
+			return false
+		}
+		for !list.Empty() {
+			var nameType uint8
+			var name cryptobyte.String
+			if !list.ReadUint8(&nameType) || !list.ReadUint16LengthPrefixed(&name) {
+				return false
+			}
+			if nameType == 0 {
+				h.ServerName = string(name)
+			}
+		}
+	case extSupportedGroups:
+		return readUint16s(data, &h.SupportedGroups, false)
+	case extPointFormats:
+		var list cryptobyte.String
+		if !data.ReadUint8LengthPrefixed(&list) {
+			return false
+		}
+		h.PointFormats = append(h.PointFormats, list...)
+	case extSignatureAlgs:
+		return readUint16s(data, &h.SignatureAlgorithms, false)
+	case extSupportedVersions:
+		return readUint16s(data, &h.SupportedVersions, true)
+	case extALPN:
+		var list cryptobyte.String
+		if !data.ReadUint16LengthPrefixed(&list) {
+			return false
+		}
+		for !list.Empty() {
+			var proto cryptobyte.String
+			if !list.ReadUint8LengthPrefixed(&proto) {
+				return false
+			}
+			h.ALPN = append(h.ALPN, string(proto))
+		}
+	}
+	return true
+}
+
+func readUint16s(data cryptobyte.String, o *[]uint16, shortLength bool) bool {
+	var list cryptobyte.String
+	if shortLength {
+		if !data.ReadUint8LengthPrefixed(&list) {
+			return false
+		}
+	} else if !data.ReadUint16LengthPrefixed(&list) {
+		return false
+	}
+	for !list.Empty() {
+		var v uint16
+		if !list.ReadUint16(&v) {
+			return false
+		}
+		*o = append(*o, v)
+	}
+	return true
+}
+
+// isGREASE returns true for values reserved by RFC 8701.
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+func withoutGREASE(vs []uint16) []uint16 {
+	o := make([]uint16, 0, len(vs))
+	for _, v := range vs {
+		if !isGREASE(v) {
+			o = append(o, v)
+		}
+	}
+	return o
+}
+
+// JA3String returns the string that is hashed to compute the JA3 fingerprint.
+func (h *ClientHello) JA3String() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(int(h.Version)))
+	for _, list := range [][]uint16{h.CipherSuites, h.Extensions, h.SupportedGroups} {
+		b.WriteByte(',')
+		writeDecimal(&b, withoutGREASE(list))
+	}
+	b.WriteByte(',')
+	points := make([]uint16, len(h.PointFormats))
+	for i, v := range h.PointFormats {
+		points[i] = uint16(v)
+	}
+	writeDecimal(&b, points)
+	return b.String()
+}
+
+func writeDecimal(b *strings.Builder, vs []uint16) {
+	for i, v := range vs {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(strconv.Itoa(int(v)))
+	}
+}
+
+// JA3 returns the JA3 fingerprint, the md5 hash of JA3String in hex.
+func (h *ClientHello) JA3() string {
+	sum := md5.Sum([]byte(h.JA3String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// JA4 returns the JA4 fingerprint of a ClientHello received over TCP.
+func (h *ClientHello) JA4() string {
+	ciphers := withoutGREASE(h.CipherSuites)
+	exts := withoutGREASE(h.Extensions)
+
+	var b strings.Builder
+	b.WriteByte('t')
+	b.WriteString(ja4Version(h))
+	if h.ServerName != "" {
+		b.WriteByte('d')
+	} else {
+		b.WriteByte('i')
+	}
+	fmt.Fprintf(&b, "%02d%02d", min99(len(ciphers)), min99(len(exts)))
+	b.WriteString(ja4ALPN(h.ALPN))
+
+	b.WriteByte('_')
+	sorted := append([]uint16(nil), ciphers...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	b.WriteString(ja4Hash(hexList(sorted)))
+
+	b.WriteByte('_')
+	var rest []uint16
+	for _, e := range exts {
+		if e != extServerName && e != extALPN {
+			rest = append(rest, e)
+		}
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i] < rest[j] })
+	if len(rest) == 0 {
+		b.WriteString(emptyJA4Hash)
+		return b.String()
+	}
+	s := hexList(rest)
+	if algs := withoutGREASE(h.SignatureAlgorithms); len(algs) > 0 {
+		s += "_" + hexList(algs)
+	}
+	b.WriteString(ja4Hash(s))
+	return b.String()
+}
+
+func ja4Version(h *ClientHello) string {
+	v := h.Version
+	if vs := withoutGREASE(h.SupportedVersions); len(vs) > 0 {
+		// supported_versions replaces the legacy version
+		v = 0
+		for _, sv := range vs {
+			if sv > v {
+				v = sv
+			}
+		}
+	}
+	switch v {
+	case 0x0304:
+		return "13"
+	case 0x0303:
+		return "12"
+	case 0x0302:
+		return "11"
+	case 0x0301:
+		return "10"
+	case 0x0300:
+		return "s3"
+	case 0x0002:
+		return "s2"
+	}
+	return "00"
+}
+
+func ja4ALPN(protos []string) string {
+	if len(protos) == 0 || protos[0] == "" {
+		return "00"
+	}
+	p := protos[0]
+	first, last := p[0], p[len(p)-1]
+	if isAlnum(first) && isAlnum(last) {
+		return string([]byte{first, last})
+	}
+	x := hex.EncodeToString([]byte{first, last})
+	return string([]byte{x[0], x[len(x)-1]})
+}
+
+func isAlnum(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func min99(n int) int {
+	if n > maxJA4Count {
+		return maxJA4Count
+	}
+	return n
+}
+
+func hexList(vs []uint16) string {
+	parts := make([]string, len(vs))
+	for i, v := range vs {
+		parts[i] = fmt.Sprintf("%04x", v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func ja4Hash(s string) string {
+	if s == "" {
+		return emptyJA4Hash
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:ja4TruncatedHashBytes])
+}
@@ -0,0 +1,142 @@
+package fingerprint
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+func clientHello(t *testing.T) []byte {
+	t.Helper()
+	var msg cryptobyte.Builder
+	msg.AddUint16(0x0303)
+	msg.AddBytes(make([]byte, 32))
+	msg.AddUint8(0) // session id
+	msg.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range []uint16{0x0a0a, 0x1301, 0xc02f, 0x002f} {
+			b.AddUint16(c)
+		}
+	})
+	msg.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+	msg.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		ext := func(typ uint16, f func(*cryptobyte.Builder)) {
+			b.AddUint16(typ)
+			b.AddUint16LengthPrefixed(f)
+		}
+		ext(0x1a1a, func(*cryptobyte.Builder) {})
+		ext(extServerName, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint8(0)
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+					b.AddBytes([]byte("a.test"))
+				})
+			})
+		})
+		ext(extSupportedGroups, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(0x001d)
+				b.AddUint16(0x0017)
+			})
+		})
+		ext(extPointFormats, func(b *cryptobyte.Builder) {
+			b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+		})
+		ext(extSignatureAlgs, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(0x0403)
+				b.AddUint16(0x0804)
+			})
+		})
+		ext(extALPN, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				for _, p := range []string{"h2", "http/1.1"} {
+					b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes([]byte(p)) })
+				}
+			})
+		})
+		ext(extSupportedVersions, func(b *cryptobyte.Builder) {
+			b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(0x0304)
+				b.AddUint16(0x0303)
+			})
+		})
+	})
+	var hs cryptobyte.Builder
+	hs.AddUint8(handshakeClientHello)
+	hs.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(msg.BytesOrPanic()) })
+	var rec cryptobyte.Builder
+	rec.AddUint8(recordTypeHandshake)
+	rec.AddUint16(0x0301)
+	rec.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(hs.BytesOrPanic()) })
+	return rec.BytesOrPanic()
+}
+
+func truncatedSHA256(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:6])
+}
+
+func TestFingerprint(t *testing.T) {
+	h, err := Parse(clientHello(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ServerName != "a.test" {
+		t.Errorf("expected a.test got %q", h.ServerName)
+	}
+	ja3 := "771,4865-49199-47,0-10-11-13-16-43,29-23,0"
+	if got := h.JA3String(); got != ja3 {
+		t.Errorf("expected ja3 string %q got %q", ja3, got)
+	}
+	if got := h.JA3(); len(got) != 32 {
+		t.Errorf("expected md5 hex got %q", got)
+	}
+	ja4 := "t13d0306h2_" + truncatedSHA256("002f,1301,c02f") + "_" +
+		truncatedSHA256("000a,000b,000d,002b_0403,0804")
+	if got := h.JA4(); got != ja4 {
+		t.Errorf("expected ja4 %q got %q", ja4, got)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	b := clientHello(t)
+	for _, v := range [][]byte{nil, []byte("GET / HTTP/1.1\r\n\r\n"), b[:len(b)-3]} {
+		if _, err := Parse(v); err == nil {
+			t.Errorf("expected an error for %q", v)
+		}
+	}
+}
+
+// recordConn captures bytes written by a tls client.
+type recordConn struct {
+	net.Conn
+	buf bytes.Buffer
+}
+
+func (c *recordConn) Write(p []byte) (int, error)      { return c.buf.Write(p) }
+func (c *recordConn) Read(p []byte) (int, error)       { return 0, io.EOF }
+func (c *recordConn) SetDeadline(time.Time) error      { return nil }
+func (c *recordConn) SetWriteDeadline(time.Time) error { return nil }
+
+func TestParseGoClient(t *testing.T) {
+	rec := new(recordConn)
+	tls.Client(rec, &tls.Config{ServerName: "b.test", NextProtos: []string{"h2"}}).Handshake()
+	h, err := Parse(rec.buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ServerName != "b.test" {
+		t.Errorf("expected b.test got %q", h.ServerName)
+	}
+	if ja4 := h.JA4(); !strings.HasPrefix(ja4, "t13d") || !strings.Contains(ja4, "h2_") {
+		t.Errorf("unexpected ja4 %q", ja4)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/gernest/tt/api"
 	"go.uber.org/atomic"
 )
 
@@ -48,6 +49,8 @@ type ContextMeta struct {
 	ServerName atomic.String
 	// RouteName the name of the route if any
 	RouteName atomic.String
+	// JA3 and JA4 fingerprints of the TLS ClientHello if any
+	JA3, JA4 atomic.String
 	// Protocol The protocol which we are serving
 	Protocol   atomic.Uint32
 	Start, End time.Time
@@ -55,10 +58,18 @@ type ContextMeta struct {
 	Rate       Rate
 	// Labels these are labels that are attached to the request
 	Labels map[string]string
+	// Termination the reason the connection ended
+	Termination atomic.Int32
 
 	copyErrCount atomic.Int32
 }
 
+// Terminate records t as the reason the connection ended. Only the first
+// reason is kept.
+func (m *ContextMeta) Terminate(t api.AccessEntry_Session_Termination) {
+	m.Termination.CAS(int32(api.AccessEntry_Session_UNKNOWN), int32(t))
+}
+
 func (m ContextMeta) GetProtocol() Protocol {
 	return Protocol(m.Protocol.Load())
 }
@@ -151,6 +162,17 @@ func UpdateContext(ctx context.Context, fn func(*ContextMeta)) context.Context {
 	return context.WithValue(ctx, metakey{}, &m)
 }
 
+// WithContextMeta returns a copy of ctx carrying m.
+func WithContextMeta(ctx context.Context, m *ContextMeta) context.Context {
+	return context.WithValue(ctx, metakey{}, m)
+}
+
+// LookupContextMeta returns Meta in ctx and true if ctx has one.
+func LookupContextMeta(ctx context.Context) (*ContextMeta, bool) {
+	m, ok := ctx.Value(metakey{}).(*ContextMeta)
+	return m, ok
+}
+
 func GetContextMeta(ctx context.Context) *ContextMeta {
 	if m := ctx.Value(metakey{}); m != nil {
 		return m.(*ContextMeta)
@@ -21,6 +21,11 @@ func (c Chain) Then(t Target) Target {
 
 func BuildMiddlewares(r *api.Route) Chain {
 	c := Chain{}
+	if r.Name != "" {
+		c = append(c, func(t Target) Target {
+			return &routeNameTarget{target: t, name: r.Name}
+		})
+	}
 	if len(r.MetricsLabels) > 0 {
 		// Inject metrics labels to targets context meta
 		c = append(c, func(t Target) Target {
@@ -38,6 +43,17 @@ func BuildMiddlewares(r *api.Route) Chain {
 	return c
 }
 
+// routeNameTarget records the name of the route serving the connection.
+type routeNameTarget struct {
+	target Target
+	name   string
+}
+
+func (r *routeNameTarget) HandleConn(ctx context.Context, conn net.Conn) {
+	GetContextMeta(ctx).RouteName.Store(r.name)
+	r.target.HandleConn(ctx, conn)
+}
+
 // metricsLabelsTarget injects upstream labels
 type metricsLabelsTarget struct {
 	target Target
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"bufio"
+	"context"
+
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/tcp/fingerprint"
+)
+
+// Fingerprint computes JA3 and JA4 fingerprints of the TLS ClientHello in br
+// and stores them in the context meta, without consuming any bytes from br.
+// Nothing is stored for connections that are not TLS.
+func Fingerprint(ctx context.Context, br *bufio.Reader) {
+	meta := tcp.GetContextMeta(ctx)
+	if meta.JA4.Load() != "" {
+		return
+	}
+	b := peekClientHello(br)
+	if b == nil {
+		return
+	}
+	hello, err := fingerprint.Parse(b)
+	if err != nil {
+		return
+	}
+	meta.JA3.Store(hello.JA3())
+	meta.JA4.Store(hello.JA4())
+}
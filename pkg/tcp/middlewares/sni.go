@@ -38,6 +38,7 @@ var _ tcp.Route = (*SniMatch)(nil)
 
 func (m SniMatch) Match(ctx context.Context, br *bufio.Reader) (tcp.Target, string) {
 	sni := ClientHelloServerName(br)
+	Fingerprint(ctx, br)
 	zlg.Debug("read sni", zap.String("sni", sni), zap.String("component", "sni_match"))
 	if m.Matcher(ctx, sni) {
 		zlg.Debug("sni matched", zap.String("sni", sni), zap.String("component", "sni_match"))
@@ -129,18 +130,8 @@ func tryACME(ctx context.Context, ch chan<- tcp.Target, dest tcp.Target, sni str
 // without consuming any bytes from br.
 // On any error, the empty string is returned.
 func ClientHelloServerName(br *bufio.Reader) (sni string) {
-	const recordHeaderLen = 5
-	hdr, err := br.Peek(recordHeaderLen)
-	if err != nil {
-		return ""
-	}
-	const recordTypeHandshake = 0x16
-	if hdr[0] != recordTypeHandshake {
-		return ""
-	}
-	recLen := int(hdr[3])<<8 | int(hdr[4]) // ignoring version in hdr[1:3]
-	helloBytes, err := br.Peek(recordHeaderLen + recLen)
-	if err != nil {
+	helloBytes := peekClientHello(br)
+	if helloBytes == nil {
 		return ""
 	}
 	tls.Server(sniSniffConn{r: bytes.NewReader(helloBytes)}, &tls.Config{
@@ -156,6 +147,26 @@ func ClientHelloServerName(br *bufio.Reader) (sni string) {
 	return
 }
 
+// peekClientHello returns the first TLS record in br without consuming it. It
+// returns nil if br doesn't start with a handshake record.
+func peekClientHello(br *bufio.Reader) []byte {
+	const recordHeaderLen = 5
+	hdr, err := br.Peek(recordHeaderLen)
+	if err != nil {
+		return nil
+	}
+	const recordTypeHandshake = 0x16
+	if hdr[0] != recordTypeHandshake {
+		return nil
+	}
+	recLen := int(hdr[3])<<8 | int(hdr[4]) // ignoring version in hdr[1:3]
+	helloBytes, err := br.Peek(recordHeaderLen + recLen)
+	if err != nil {
+		return nil
+	}
+	return helloBytes
+}
+
 // sniSniffConn is a net.Conn that reads from r, fails on Writes,
 // and crashes otherwise.
 type sniSniffConn struct {
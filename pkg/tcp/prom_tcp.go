@@ -34,7 +34,7 @@ func (m *ContextMeta) Log() {
 	fields := []zapcore.Field{
 		zap.String("server_name", m.ServerName.String()),
 		zap.Bool("acme", m.ACME.Load()),
-		zap.Bool("fixed", m.ACME.Load()),
+		zap.Bool("fixed", m.Fixed.Load()),
 		zap.String("protocol", Protocol(m.Protocol.Load()).String()),
 		zap.Duration("duration", m.End.Sub(m.Start)),
 	}
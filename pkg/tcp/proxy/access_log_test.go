@@ -0,0 +1,102 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	accesslog "github.com/gernest/tt/pkg/access_log"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (s *syncBuffer) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buf.Write(p)
+}
+
+func (s *syncBuffer) Sync() error { return nil }
+
+func (s *syncBuffer) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.buf.String()
+}
+
+func TestProxyAccessLog(t *testing.T) {
+	front := newLocalListener(t)
+	defer front.Close()
+	back := newLocalListener(t)
+	defer back.Close()
+	echoOnce(t, back)
+
+	var out syncBuffer
+	logger := zap.New(zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		&out, zap.DebugLevel,
+	))
+	access := accesslog.New(accesslog.Options{InSize: 1, OutSize: 1}, &accesslog.Zap{Logger: logger})
+
+	p, cancel := testProxy(t, front)
+	defer cancel()
+	go access.Run(p.ctx)
+	p.ctx = accesslog.Set(p.ctx, access)
+	p.AddRoute(testFrontAddr, To(back.Addr().String()))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := net.Dial("tcp", front.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("hello")
+	if _, err := conn.Write(msg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(conn, make([]byte, len(msg))); err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+
+	var entry struct {
+		UpstreamAddress string `json:"upstream_address"`
+		BytesReceived   int64  `json:"bytes_received"`
+		BytesSent       int64  `json:"bytes_sent"`
+		Termination     string `json:"termination"`
+		NoMatch         bool   `json:"no_match"`
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for entry.Termination == "" {
+		if time.Now().After(deadline) {
+			t.Fatalf("no access log entry got %q", out.String())
+		}
+		time.Sleep(10 * time.Millisecond)
+		s := bufio.NewScanner(bytes.NewBufferString(out.String()))
+		for s.Scan() {
+			json.Unmarshal(s.Bytes(), &entry)
+		}
+	}
+	if entry.UpstreamAddress != back.Addr().String() {
+		t.Errorf("expected upstream %s got %s", back.Addr(), entry.UpstreamAddress)
+	}
+	if entry.BytesReceived != int64(len(msg)) || entry.BytesSent != int64(len(msg)) {
+		t.Errorf("expected %d bytes each way got received=%d sent=%d", len(msg), entry.BytesReceived, entry.BytesSent)
+	}
+	if entry.Termination != "DOWNSTREAM_CLOSE" {
+		t.Errorf("expected DOWNSTREAM_CLOSE got %s", entry.Termination)
+	}
+	if entry.NoMatch {
+		t.Error("expected a matching route")
+	}
+}
@@ -0,0 +1,158 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const defaultAffinityTTL = 10 * time.Minute
+
+var affinityEntries = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: "tt",
+		Name:      "tcp_affinity_entries",
+		Help:      "Number of clients pinned to an upstream endpoint",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(affinityEntries)
+}
+
+// sessions keeps the endpoints clients are pinned to in the cache shared by
+// all proxies.
+type sessions struct {
+	cache *ristretto.Cache
+}
+
+// pin is the cached endpoint of a client.
+type pin struct {
+	addr string
+}
+
+func (p *pin) Evicted() {
+	affinityEntries.Dec()
+}
+
+// newSessions returns sessions stored in c. It returns nil when the cache is
+// disabled.
+func newSessions(c *ristretto.Cache) *sessions {
+	if c == nil {
+		return nil
+	}
+	return &sessions{cache: c}
+}
+
+func (s *sessions) get(key string) string {
+	if v, ok := s.cache.Get(key); ok {
+		if p, ok := v.(*pin); ok {
+			return p.addr
+		}
+	}
+	return ""
+}
+
+func (s *sessions) set(key, addr string, ttl time.Duration) {
+	if s.cache.SetWithTTL(key, &pin{addr: addr}, 1, ttl) {
+		affinityEntries.Inc()
+	}
+}
+
+// middleware returns a middleware pinning clients of r to endpoints. It
+// returns nil if r doesn't use affinity.
+func (s *sessions) middleware(r *api.Route) tcp.MiddleareFunc {
+	a := r.GetAffinity()
+	if a == nil {
+		return nil
+	}
+	if s == nil {
+		zlg.Info("Session affinity requires the cache, balancing normally",
+			zap.String("route", r.Name),
+		)
+		return nil
+	}
+	ttl := defaultAffinityTTL
+	if a.Ttl != nil {
+		if v, err := ptypes.Duration(a.Ttl); err == nil && v > 0 {
+			ttl = v
+		}
+	}
+	prefix := "tcp_affinity/" + r.Name + "/" + proxyPkg.BindToHostPort(r.Bind, defaultIPPort) + "/"
+	return func(t tcp.Target) tcp.Target {
+		return &affinityTarget{
+			target: t,
+			s:      s,
+			by:     a.Key,
+			prefix: prefix,
+			ttl:    ttl,
+		}
+	}
+}
+
+type affinityKey struct{}
+
+// affinity is the endpoint preference of a connection.
+type affinity struct {
+	// pinned is the address of the endpoint the client is pinned to
+	pinned string
+	use    func(addr string)
+}
+
+func getAffinity(ctx context.Context) *affinity {
+	if a, ok := ctx.Value(affinityKey{}).(*affinity); ok {
+		return a
+	}
+	return nil
+}
+
+// affinityTarget passes the pinned endpoint of the client to the balancer and
+// pins the endpoint the balancer chose.
+type affinityTarget struct {
+	target tcp.Target
+	s      *sessions
+	by     api.Affinity_Key
+	prefix string
+	ttl    time.Duration
+}
+
+var _ tcp.Target = (*affinityTarget)(nil)
+
+func (a *affinityTarget) HandleConn(ctx context.Context, conn net.Conn) {
+	meta := tcp.GetContextMeta(ctx)
+	var client string
+	switch a.by {
+	case api.Affinity_SNI:
+		client = meta.ServerName.Load()
+	default:
+		client, _, _ = net.SplitHostPort(meta.D.A.R.Address)
+	}
+	if client == "" {
+		a.target.HandleConn(ctx, conn)
+		return
+	}
+	key := a.prefix + client
+	pinned := a.s.get(key)
+	ctx = context.WithValue(ctx, affinityKey{}, &affinity{
+		pinned: pinned,
+		use: func(addr string) {
+			// setting the pin every time keeps it alive while the client keeps
+			// connecting.
+			a.s.set(key, addr, a.ttl)
+		},
+	})
+	a.target.HandleConn(ctx, conn)
+	if api.AccessEntry_Session_Termination(meta.Termination.Load()) == api.AccessEntry_Session_UPSTREAM_CONNECT_FAILED {
+		// the client is balanced again on the next connection
+		a.s.cache.Del(key)
+	}
+}
@@ -0,0 +1,105 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+// nameServer writes name to every connection it accepts.
+func nameServer(t *testing.T, name string) net.Listener {
+	ln := newLocalListener(t)
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			io.WriteString(c, name)
+			c.Close()
+		}
+	}()
+	return ln
+}
+
+func TestProxyAffinity(t *testing.T) {
+	a := nameServer(t, "a")
+	defer a.Close()
+	b := nameServer(t, "b")
+	defer b.Close()
+	backends := map[string]net.Listener{"a": a, "b": b}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, err := proxyPkg.Cache{NumCounters: 1000, MaxCost: 100, BufferItems: 64}.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	s := newSessions(c)
+	const hostPort = "127.0.0.1:0"
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts:      &proxyPkg.Options{AllowedPorts: []int{0}},
+		sessions:  s,
+	}
+	r := &api.Route{
+		Name: "sticky",
+		Bind: &api.Bind{To: &api.Bind_HostPort{HostPort: hostPort}},
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Fixed{Fixed: &empty.Empty{}},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: a.Addr().String()}, Weight: 1},
+			{Addr: &api.Address{Address: b.Addr().String()}, Weight: 1},
+		},
+		Affinity: &api.Affinity{},
+	}
+	p.Route(r, s.middleware(r))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	addr := p.lns[hostPort].Addr().String()
+	read := func() string {
+		t.Helper()
+		c, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+		v, _ := ioutil.ReadAll(c)
+		// pins are stored asynchronously
+		s.cache.Wait()
+		return string(v)
+	}
+	first := read()
+	if first == "" {
+		t.Fatal("expected a response from a backend")
+	}
+	for i := 0; i < 4; i++ {
+		if got := read(); got != first {
+			t.Fatalf("expected all connections to reach %s got %s", first, got)
+		}
+	}
+
+	// the pinned backend is down, the client is balanced again
+	backends[first].Close()
+	other := "a"
+	if first == "a" {
+		other = "b"
+	}
+	// the connection that fails to reach the pinned backend is not dropped
+	for i := 0; i < 4; i++ {
+		if got := read(); got != other {
+			t.Fatalf("expected connections to reach %s got %q", other, got)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/tcp/middlewares"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// maxUnmatchedServerNames is the number of distinct server names tracked by
+// tcp_unmatched_total, the rest are counted as other.
+const maxUnmatchedServerNames = 100
+
+var totalUnmatched = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "tt",
+		Name:      "tcp_unmatched_total",
+		Help:      "Total number of tcp connections that did not match any route",
+	},
+	[]string{"listener", "server_name"},
+)
+
+func init() {
+	prometheus.MustRegister(totalUnmatched)
+}
+
+var unmatchedNames = struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// unmatchedLabel returns the server_name label for sni. Only the first
+// maxUnmatchedServerNames names get their own label to keep the cardinality
+// bounded.
+func unmatchedLabel(sni string) string {
+	if sni == "" {
+		return "none"
+	}
+	unmatchedNames.mu.Lock()
+	defer unmatchedNames.mu.Unlock()
+	if _, ok := unmatchedNames.names[sni]; ok {
+		return sni
+	}
+	if len(unmatchedNames.names) < maxUnmatchedServerNames {
+		unmatchedNames.names[sni] = struct{}{}
+		return sni
+	}
+	return "other"
+}
+
+// unmatched logs and records a connection that didn't match any route.
+func unmatched(ctx context.Context, br *bufio.Reader, meta *tcp.ContextMeta) {
+	sni := meta.ServerName.Load()
+	if sni == "" && br.Buffered() > 0 {
+		// sni routes have already peeked the hello, this doesn't block.
+		sni = middlewares.ClientHelloServerName(br)
+	}
+	listener := meta.D.A.L.Address
+	zlg.Info("No matching route",
+		zap.String("listener", listener),
+		zap.String("server_name", sni),
+		zap.String("remote", meta.D.A.R.Address),
+	)
+	totalUnmatched.With(prometheus.Labels{
+		"listener":    listener,
+		"server_name": unmatchedLabel(sni),
+	}).Inc()
+}
+
+// fallback returns the target for connections on a listener that don't match
+// any route. It returns nil when they should be closed.
+func fallback(ctx context.Context, f *api.Fallback) tcp.Target {
+	switch f.GetAction() {
+	case api.Fallback_REJECT:
+		status := int(f.HttpStatus)
+		if status != http.StatusServiceUnavailable {
+			status = http.StatusMisdirectedRequest
+		}
+		return reject{status: status}
+	case api.Fallback_FORWARD:
+		return target(ctx, &api.Route{
+			Name:            "fallback",
+			LoadBalance:     f.LoadBalance,
+			LoadBalanceAlgo: f.LoadBalanceAlgo,
+		})
+	}
+	return nil
+}
+
+// rejectTimeout bounds how long reject waits for the first bytes of a
+// connection to tell its protocol.
+const rejectTimeout = 5 * time.Second
+
+// unrecognizedName is a fatal TLS alert record with unrecognized_name(112)
+// description.
+var unrecognizedName = []byte{0x15, 0x03, 0x01, 0x00, 0x02, 0x02, 0x70}
+
+// reject tells TLS and HTTP clients that no route matched before closing the
+// connection.
+type reject struct {
+	status int
+}
+
+var _ tcp.Target = (*reject)(nil)
+
+func (r reject) HandleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(rejectTimeout))
+	br := bufio.NewReader(conn)
+	hdr, err := br.Peek(1)
+	if err != nil {
+		return
+	}
+	if hdr[0] == 0x16 {
+		// handshake record
+		conn.Write(unrecognizedName)
+		return
+	}
+	// methods are at most 7 bytes, short requests still have a request line
+	// so a failed peek doesn't matter.
+	method, _ := br.Peek(8)
+	if !isHTTP(method) {
+		return
+	}
+	text := http.StatusText(r.status)
+	fmt.Fprintf(conn, "HTTP/1.1 %d %s\r\nContent-Type: text/plain; charset=utf-8\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s\n",
+		r.status, text, len(text)+1, text,
+	)
+	// closing with unread data resets the connection which can discard the
+	// response before the client reads it.
+	if cw, ok := UnderlyingConn(conn).(interface{ CloseWrite() error }); ok {
+		cw.CloseWrite()
+	}
+	io.Copy(io.Discard, io.LimitReader(br, 64<<10))
+}
+
+var httpMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodConnect,
+	http.MethodOptions, http.MethodTrace,
+}
+
+func isHTTP(b []byte) bool {
+	for _, m := range httpMethods {
+		if len(b) > len(m) && string(b[:len(m)]) == m && b[len(m)] == ' ' {
+			return true
+		}
+	}
+	return false
+}
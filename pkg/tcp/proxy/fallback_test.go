@@ -0,0 +1,111 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+)
+
+func testFallback(t *testing.T, f *api.Fallback) (string, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	const hostPort = "127.0.0.1:0"
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts:      &proxyPkg.Options{AllowedPorts: []int{0}},
+	}
+	p.Route(&api.Route{
+		Bind: &api.Bind{
+			To:       &api.Bind_HostPort{HostPort: hostPort},
+			Fallback: f,
+		},
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Sni{Sni: "a.test"},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: "127.0.0.1:1"}, Weight: 1},
+		},
+	})
+	if err := p.Start(); err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	return p.lns[hostPort].Addr().String(), func() {
+		p.Close()
+		cancel()
+	}
+}
+
+func TestFallbackReject(t *testing.T) {
+	addr, stop := testFallback(t, &api.Fallback{Action: api.Fallback_REJECT})
+	defer stop()
+
+	_, err := tls.Dial("tcp", addr, &tls.Config{ServerName: "b.test"})
+	if err == nil || !strings.Contains(err.Error(), "unrecognized name") {
+		t.Errorf("expected unrecognized name alert got %v", err)
+	}
+
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	io.WriteString(c, "GET / HTTP/1.1\r\nHost: b.test\r\n\r\n")
+	res, err := http.ReadResponse(bufio.NewReader(c), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusMisdirectedRequest {
+		t.Errorf("expected %d got %d", http.StatusMisdirectedRequest, res.StatusCode)
+	}
+}
+
+func TestFallbackForward(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	echoOnce(t, back)
+	addr, stop := testFallback(t, &api.Fallback{
+		Action: api.Fallback_FORWARD,
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.Addr().String()}, Weight: 1},
+		},
+	})
+	defer stop()
+
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	msg := "hello"
+	io.WriteString(c, msg)
+	b := make([]byte, len(msg))
+	if _, err := io.ReadFull(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != msg {
+		t.Errorf("expected %q got %q", msg, b)
+	}
+}
+
+func TestUnmatchedLabel(t *testing.T) {
+	for i := 0; i < maxUnmatchedServerNames*2; i++ {
+		unmatchedLabel(strings.Repeat("x", i+1))
+	}
+	if got := unmatchedLabel("overflow.test"); got != "other" {
+		t.Errorf("expected other got %s", got)
+	}
+	if got := unmatchedLabel("x"); got != "x" {
+		t.Errorf("expected known names to keep their label got %s", got)
+	}
+}
@@ -0,0 +1,160 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/zlg"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// fingerprints is a set of JA3 and JA4 fingerprints.
+type fingerprints map[string]struct{}
+
+func newFingerprints(l *api.TLSFingerprint_List) fingerprints {
+	f := make(fingerprints)
+	for _, v := range l.GetJa3() {
+		f[strings.ToLower(v)] = struct{}{}
+	}
+	for _, v := range l.GetJa4() {
+		f[v] = struct{}{}
+	}
+	return f
+}
+
+func (f fingerprints) has(m *tcp.ContextMeta) bool {
+	if ja3 := m.JA3.Load(); ja3 != "" {
+		if _, ok := f[ja3]; ok {
+			return true
+		}
+	}
+	if ja4 := m.JA4.Load(); ja4 != "" {
+		if _, ok := f[ja4]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fingerprintRoute only matches connections with a fingerprint in set.
+type fingerprintRoute struct {
+	tcp.Route
+	set fingerprints
+}
+
+var _ tcp.Route = (*fingerprintRoute)(nil)
+
+func (f fingerprintRoute) Match(ctx context.Context, br *bufio.Reader) (tcp.Target, string) {
+	if !f.set.has(tcp.GetContextMeta(ctx)) {
+		return nil, ""
+	}
+	return f.Route.Match(ctx, br)
+}
+
+// fingerprintFilter returns middlewares that block and rate limit connections
+// by their fingerprint.
+func fingerprintFilter(f *api.TLSFingerprint) (c tcp.Chain) {
+	if f.GetBlock() != nil {
+		block := newFingerprints(f.Block)
+		c = append(c, func(t tcp.Target) tcp.Target {
+			return &fingerprintTarget{target: t, allow: func(m *tcp.ContextMeta) bool {
+				return !block.has(m)
+			}}
+		})
+	}
+	if r := f.GetRate(); r != nil && r.Average > 0 {
+		l := &fingerprintLimits{
+			limit:    rate.Limit(r.Average),
+			burst:    int(r.Burst),
+			limiters: make(map[string]*fingerprintLimiter),
+		}
+		if l.burst < 1 {
+			l.burst = 1
+		}
+		c = append(c, func(t tcp.Target) tcp.Target {
+			return &fingerprintTarget{target: t, allow: l.allow}
+		})
+	}
+	return
+}
+
+// fingerprintTarget closes connections that are not allowed.
+type fingerprintTarget struct {
+	target tcp.Target
+	allow  func(*tcp.ContextMeta) bool
+}
+
+var _ tcp.Target = (*fingerprintTarget)(nil)
+
+func (f *fingerprintTarget) HandleConn(ctx context.Context, conn net.Conn) {
+	meta := tcp.GetContextMeta(ctx)
+	if !f.allow(meta) {
+		zlg.Info("Rejected connection by tls fingerprint",
+			zap.String("ja3", meta.JA3.Load()),
+			zap.String("ja4", meta.JA4.Load()),
+			zap.String("remote", meta.D.A.R.Address),
+		)
+		meta.Terminate(api.AccessEntry_Session_REJECTED)
+		conn.Close()
+		return
+	}
+	f.target.HandleConn(ctx, conn)
+}
+
+// maxFingerprintLimiters bounds the number of fingerprints with a limiter.
+// Limiters that were not used recently are dropped when it is reached.
+const maxFingerprintLimiters = 10000
+
+type fingerprintLimiter struct {
+	*rate.Limiter
+	seen time.Time
+}
+
+type fingerprintLimits struct {
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[string]*fingerprintLimiter
+}
+
+func (l *fingerprintLimits) allow(m *tcp.ContextMeta) bool {
+	key := m.JA4.Load()
+	if key == "" {
+		// not tls
+		return true
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	x, ok := l.limiters[key]
+	if !ok {
+		if len(l.limiters) >= maxFingerprintLimiters {
+			l.sweep(now)
+		}
+		x = &fingerprintLimiter{Limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = x
+	}
+	x.seen = now
+	return x.AllowN(now, 1)
+}
+
+// sweep drops limiters that would have refilled since they were last used.
+func (l *fingerprintLimits) sweep(now time.Time) {
+	idle := time.Duration(float64(l.burst) / float64(l.limit) * float64(time.Second))
+	for k, v := range l.limiters {
+		if now.Sub(v.seen) > idle {
+			delete(l.limiters, k)
+		}
+	}
+	if len(l.limiters) >= maxFingerprintLimiters {
+		l.limiters = make(map[string]*fingerprintLimiter)
+	}
+}
@@ -0,0 +1,115 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/tcp/fingerprint"
+)
+
+func sniRoute(sni string, back net.Listener, f *api.TLSFingerprint) *api.Route {
+	return &api.Route{
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Sni{Sni: sni},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.Addr().String()}, Weight: 1},
+		},
+		TlsFingerprint: f,
+	}
+}
+
+func TestProxyFingerprintMatch(t *testing.T) {
+	hello := clientHelloRecord(t, "a.test")
+	h, err := fingerprint.Parse([]byte(hello))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := newLocalListener(t)
+	defer other.Close()
+	back := newLocalListener(t)
+	defer back.Close()
+	addr, stop := testRoutes(t,
+		sniRoute("a.test", other, &api.TLSFingerprint{
+			Match: &api.TLSFingerprint_List{Ja4: []string{"t13d0000h2_000000000000_000000000000"}},
+		}),
+		sniRoute("a.test", back, &api.TLSFingerprint{
+			Match: &api.TLSFingerprint_List{Ja4: []string{h.JA4()}},
+		}),
+	)
+	defer stop()
+
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	io.WriteString(c, hello)
+	fromProxy, err := back.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fromProxy.Close()
+	buf := make([]byte, len(hello))
+	if _, err := io.ReadFull(fromProxy, buf); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProxyFingerprintBlock(t *testing.T) {
+	hello := clientHelloRecord(t, "a.test")
+	h, err := fingerprint.Parse([]byte(hello))
+	if err != nil {
+		t.Fatal(err)
+	}
+	back := newLocalListener(t)
+	defer back.Close()
+	addr, stop := testRoutes(t,
+		sniRoute("a.test", back, &api.TLSFingerprint{
+			Block: &api.TLSFingerprint_List{Ja3: []string{h.JA3()}},
+		}),
+	)
+	defer stop()
+
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	io.WriteString(c, hello)
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("expected the connection to be closed got %v", err)
+	}
+}
+
+func TestFingerprintRate(t *testing.T) {
+	c := fingerprintFilter(&api.TLSFingerprint{
+		Rate: &api.TLSFingerprint_Rate{Average: 0.001, Burst: 2},
+	})
+	if len(c) != 1 {
+		t.Fatalf("expected a rate limit middleware got %d", len(c))
+	}
+	f := c.Then(noopTarget{}).(*fingerprintTarget)
+	var a, b tcp.ContextMeta
+	a.JA4.Store("a")
+	b.JA4.Store("b")
+	for i, want := range []bool{true, true, false} {
+		if got := f.allow(&a); got != want {
+			t.Errorf("%d: expected %v got %v", i, want, got)
+		}
+	}
+	if !f.allow(&b) {
+		t.Error("expected fingerprints to have separate limits")
+	}
+	var none tcp.ContextMeta
+	for i := 0; i < 4; i++ {
+		if !f.allow(&none) {
+			t.Fatal("expected connections without fingerprint to be allowed")
+		}
+	}
+}
@@ -0,0 +1,493 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/zlg"
+	"go.uber.org/zap"
+)
+
+// ErrDestinationNotAllowed is returned when a forward proxy client asks for a
+// destination that is not in the allow list.
+var ErrDestinationNotAllowed = errors.New("proxy: destination not allowed")
+
+var errAuth = errors.New("proxy: authentication failed")
+
+// errBadDestination is returned when a forward proxy client asks for a
+// destination without a host or a port.
+var errBadDestination = errors.New("proxy: invalid destination")
+
+// forwardHandshakeTimeout limits how long a client has to complete SOCKS5 or
+// HTTP CONNECT negotiation.
+const forwardHandshakeTimeout = 10 * time.Second
+
+const (
+	socks5Version = 0x05
+
+	socks5AuthNone     = 0x00
+	socks5AuthPassword = 0x02
+	socks5NoAcceptable = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5Succeeded          = 0x00
+	socks5GeneralFailure     = 0x01
+	socks5NotAllowed         = 0x02
+	socks5HostUnreachable    = 0x04
+	socks5CmdNotSupported    = 0x07
+	socks5AddrTypeNotSupport = 0x08
+)
+
+// Allow decides which destinations a forward proxy is allowed to dial. An
+// Allow without HostNames and Nets denies every destination.
+type Allow struct {
+	HostNames []string
+	Nets      []*net.IPNet
+	Ports     map[int]struct{}
+}
+
+// NewAllow builds Allow from its api representation. Invalid cidrs are logged
+// and ignored.
+func NewAllow(a *api.ForwardProxy_Allow) *Allow {
+	o := &Allow{}
+	if a == nil {
+		return o
+	}
+	for _, h := range a.HostNames {
+		o.HostNames = append(o.HostNames, strings.ToLower(h))
+	}
+	for _, c := range a.Cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			zlg.Error(err, "Skipping invalid forward proxy cidr", zap.String("cidr", c))
+			continue
+		}
+		o.Nets = append(o.Nets, n)
+	}
+	if len(a.Ports) > 0 {
+		o.Ports = make(map[int]struct{})
+		for _, p := range a.Ports {
+			o.Ports[int(p)] = struct{}{}
+		}
+	}
+	return o
+}
+
+func (a *Allow) port(port int) bool {
+	if len(a.Ports) == 0 {
+		return true
+	}
+	_, ok := a.Ports[port]
+	return ok
+}
+
+func (a *Allow) hostName(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, h := range a.HostNames {
+		if h == host {
+			return true
+		}
+		if strings.HasPrefix(h, "*.") && strings.HasSuffix(host, h[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Allow) ip(ip net.IP) bool {
+	for _, n := range a.Nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve checks if host:port is an allowed destination and returns the
+// address that should be dialed.
+//
+// When host is a name that is only allowed by cidrs, it is resolved and the
+// first allowed ip is returned. This way the ip that was checked is the one we
+// dial.
+func (a *Allow) Resolve(ctx context.Context, hostPort string) (string, error) {
+	host, portStr, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errBadDestination, err)
+	}
+	if host == "" || portStr == "" {
+		return "", fmt.Errorf("%w: %q", errBadDestination, hostPort)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errBadDestination, err)
+	}
+	if !a.port(port) {
+		return "", ErrDestinationNotAllowed
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if a.ip(ip) || a.hostName(host) {
+			return hostPort, nil
+		}
+		return "", ErrDestinationNotAllowed
+	}
+	if a.hostName(host) {
+		return hostPort, nil
+	}
+	if len(a.Nets) == 0 {
+		return "", ErrDestinationNotAllowed
+	}
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	for _, ip := range ips {
+		if a.ip(ip.IP) {
+			return net.JoinHostPort(ip.IP.String(), portStr), nil
+		}
+	}
+	return "", ErrDestinationNotAllowed
+}
+
+var _ tcp.Target = (*ForwardProxy)(nil)
+
+// ForwardProxy implements Target for egress proxying. Clients select the
+// destination with SOCKS5 or HTTP CONNECT, the destination is checked against
+// Allow and then proxied with the DialProxy returned by Dial.
+type ForwardProxy struct {
+	Mode api.ForwardProxy_Mode
+
+	// Users maps username to password. When empty no authentication is
+	// required.
+	Users map[string]string
+
+	Allow *Allow
+
+	// Dial returns DialProxy for the destination addr.
+	Dial func(addr string) *DialProxy
+}
+
+func forward(r *api.Route, f *api.ForwardProxy) *ForwardProxy {
+	fp := &ForwardProxy{
+		Mode:  f.Mode,
+		Allow: NewAllow(f.Allow),
+		Dial: func(addr string) *DialProxy {
+			return dialRoute(r, defaultNetwork, addr, r.MetricsLabels)
+		},
+	}
+	if len(f.Users) > 0 {
+		fp.Users = make(map[string]string)
+		for _, u := range f.Users {
+			fp.Users[u.Username] = u.Password
+		}
+	}
+	return fp
+}
+
+func (f *ForwardProxy) auth(username, password string) bool {
+	if len(f.Users) == 0 {
+		return true
+	}
+	p, ok := f.Users[username]
+	return subtle.ConstantTimeCompare([]byte(p), []byte(password)) == 1 && ok
+}
+
+// HandleConn implements the Target interface.
+func (f *ForwardProxy) HandleConn(ctx context.Context, conn net.Conn) {
+	conn.SetReadDeadline(time.Now().Add(forwardHandshakeTimeout))
+	br := bufio.NewReader(conn)
+	first, err := br.Peek(1)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	mode := f.Mode
+	if mode == api.ForwardProxy_ANY {
+		mode = api.ForwardProxy_HTTP_CONNECT
+		if first[0] == socks5Version {
+			mode = api.ForwardProxy_SOCKS5
+		}
+	}
+	switch mode {
+	case api.ForwardProxy_SOCKS5:
+		f.socks5(ctx, conn, br)
+	default:
+		f.connect(ctx, conn, br)
+	}
+}
+
+// buffered returns conn with any bytes that were read ahead by br.
+func buffered(conn net.Conn, br *bufio.Reader) net.Conn {
+	if n := br.Buffered(); n > 0 {
+		peeked, _ := br.Peek(n)
+		return &Conn{
+			Peeked: peeked,
+			Conn:   conn,
+		}
+	}
+	return conn
+}
+
+// handle dials addr through DialProxy. reply is called exactly once, with nil
+// when the upstream connection was established.
+func (f *ForwardProxy) handle(ctx context.Context, conn net.Conn, br *bufio.Reader, addr string, replyFn func(error)) {
+	var once sync.Once
+	reply := func(err error) {
+		once.Do(func() { replyFn(err) })
+	}
+	meta := tcp.GetContextMeta(ctx)
+	host, _, _ := net.SplitHostPort(addr)
+	meta.ServerName.Store(host)
+	dest, err := f.Allow.Resolve(ctx, addr)
+	if err != nil {
+		zlg.Info("Rejecting forward proxy destination",
+			zap.String("destination", addr),
+			zap.Error(err),
+		)
+		meta.Terminate(api.AccessEntry_Session_REJECTED)
+		reply(err)
+		conn.Close()
+		return
+	}
+	// The handshake is complete, the upstream connection manages its own
+	// deadlines from now on.
+	conn.SetReadDeadline(time.Time{})
+	dp := f.Dial(dest)
+	dial := dp.dialContext()
+	dp.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		c, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+		reply(nil)
+		return c, nil
+	}
+	onErr := dp.onDialError()
+	dp.OnDialError = func(src net.Conn, dstDialErr error) {
+		reply(dstDialErr)
+		onErr(src, dstDialErr)
+	}
+	dp.HandleConn(ctx, buffered(conn, br))
+}
+
+func (f *ForwardProxy) socks5(ctx context.Context, conn net.Conn, br *bufio.Reader) {
+	if err := f.socks5Auth(conn, br); err != nil {
+		conn.Close()
+		return
+	}
+	addr, code, err := socks5Request(br)
+	if err != nil {
+		if code != 0 {
+			socks5Reply(conn, code)
+		}
+		conn.Close()
+		return
+	}
+	f.handle(ctx, conn, br, addr, func(err error) {
+		switch {
+		case err == nil:
+			socks5Reply(conn, socks5Succeeded)
+		case errors.Is(err, ErrDestinationNotAllowed):
+			socks5Reply(conn, socks5NotAllowed)
+		default:
+			socks5Reply(conn, socks5HostUnreachable)
+		}
+	})
+}
+
+func (f *ForwardProxy) socks5Auth(conn net.Conn, br *bufio.Reader) error {
+	var hdr [2]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	}
+	if hdr[0] != socks5Version {
+		return fmt.Errorf("proxy: unsupported socks version %d", hdr[0])
+	}
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(br, methods); err != nil {
+		return err
+	}
+	want := byte(socks5AuthNone)
+	if len(f.Users) > 0 {
+		want = socks5AuthPassword
+	}
+	var found bool
+	for _, m := range methods {
+		if m == want {
+			found = true
+			break
+		}
+	}
+	if !found {
+		conn.Write([]byte{socks5Version, socks5NoAcceptable})
+		return errAuth
+	}
+	if _, err := conn.Write([]byte{socks5Version, want}); err != nil {
+		return err
+	}
+	if want == socks5AuthNone {
+		return nil
+	}
+	// RFC 1929 username/password authentication
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	}
+	username := make([]byte, hdr[1])
+	if _, err := io.ReadFull(br, username); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(br, hdr[:1]); err != nil {
+		return err
+	}
+	password := make([]byte, hdr[0])
+	if _, err := io.ReadFull(br, password); err != nil {
+		return err
+	}
+	if !f.auth(string(username), string(password)) {
+		conn.Write([]byte{0x01, 0x01})
+		return errAuth
+	}
+	_, err := conn.Write([]byte{0x01, 0x00})
+	return err
+}
+
+// socks5Request reads a SOCKS5 request and returns the requested host:port.
+// When the request can't be served a non zero reply code is returned together
+// with the error.
+func socks5Request(br *bufio.Reader) (addr string, code byte, err error) {
+	var hdr [4]byte
+	if _, err = io.ReadFull(br, hdr[:]); err != nil {
+		return
+	}
+	if hdr[0] != socks5Version {
+		return "", socks5GeneralFailure, fmt.Errorf("proxy: unsupported socks version %d", hdr[0])
+	}
+	if hdr[1] != socks5CmdConnect {
+		return "", socks5CmdNotSupported, fmt.Errorf("proxy: unsupported socks command %d", hdr[1])
+	}
+	var host string
+	switch hdr[3] {
+	case socks5AddrIPv4:
+		ip := make(net.IP, net.IPv4len)
+		if _, err = io.ReadFull(br, ip); err != nil {
+			return
+		}
+		host = ip.String()
+	case socks5AddrIPv6:
+		ip := make(net.IP, net.IPv6len)
+		if _, err = io.ReadFull(br, ip); err != nil {
+			return
+		}
+		host = ip.String()
+	case socks5AddrDomain:
+		var n byte
+		if n, err = br.ReadByte(); err != nil {
+			return
+		}
+		if n == 0 {
+			return "", socks5GeneralFailure, errBadDestination
+		}
+		b := make([]byte, n)
+		if _, err = io.ReadFull(br, b); err != nil {
+			return
+		}
+		host = string(b)
+	default:
+		return "", socks5AddrTypeNotSupport, fmt.Errorf("proxy: unsupported socks address type %d", hdr[3])
+	}
+	var port [2]byte
+	if _, err = io.ReadFull(br, port[:]); err != nil {
+		return
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:])))), 0, nil
+}
+
+func socks5Reply(w io.Writer, code byte) error {
+	// We don't expose the bound address, clients don't need it for CONNECT.
+	_, err := w.Write([]byte{socks5Version, code, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+func (f *ForwardProxy) connect(ctx context.Context, conn net.Conn, br *bufio.Reader) {
+	req, err := http.ReadRequest(br)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	if req.Method != http.MethodConnect {
+		connectReply(conn, http.StatusMethodNotAllowed, nil)
+		conn.Close()
+		return
+	}
+	if len(f.Users) > 0 {
+		username, password, ok := proxyAuth(req.Header.Get("Proxy-Authorization"))
+		if !ok || !f.auth(username, password) {
+			h := make(http.Header)
+			h.Set("Proxy-Authenticate", `Basic realm="tt"`)
+			connectReply(conn, http.StatusProxyAuthRequired, h)
+			conn.Close()
+			return
+		}
+	}
+	f.handle(ctx, conn, br, req.Host, func(err error) {
+		switch {
+		case err == nil:
+			connectReply(conn, http.StatusOK, nil)
+		case errors.Is(err, ErrDestinationNotAllowed):
+			connectReply(conn, http.StatusForbidden, nil)
+		case errors.Is(err, errBadDestination):
+			connectReply(conn, http.StatusBadRequest, nil)
+		default:
+			connectReply(conn, http.StatusBadGateway, nil)
+		}
+	})
+}
+
+func connectReply(w io.Writer, code int, h http.Header) error {
+	if _, err := fmt.Fprintf(w, "HTTP/1.1 %d %s\r\n", code, http.StatusText(code)); err != nil {
+		return err
+	}
+	if h != nil {
+		if err := h.Write(w); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "\r\n")
+	return err
+}
+
+// proxyAuth parses basic Proxy-Authorization header.
+func proxyAuth(auth string) (username, password string, ok bool) {
+	const prefix = "Basic "
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return
+	}
+	c, err := base64.StdEncoding.DecodeString(auth[len(prefix):])
+	if err != nil {
+		return
+	}
+	cs := string(c)
+	s := strings.IndexByte(cs, ':')
+	if s < 0 {
+		return
+	}
+	return cs[:s], cs[s+1:], true
+}
@@ -0,0 +1,205 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gernest/tt/api"
+)
+
+func testForward(t *testing.T, fp *ForwardProxy) net.Listener {
+	front := newLocalListener(t)
+	p, cancel := testProxy(t, front)
+	t.Cleanup(cancel)
+	fp.Dial = func(addr string) *DialProxy {
+		return To(addr)
+	}
+	p.AddRoute(testFrontAddr, fp)
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return front
+}
+
+func echoOnce(t *testing.T, back net.Listener) {
+	go func() {
+		c, err := back.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		io.Copy(c, c)
+	}()
+}
+
+func TestForwardSOCKS5(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	echoOnce(t, back)
+	host, portStr, _ := net.SplitHostPort(back.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	front := testForward(t, &ForwardProxy{
+		Mode:  api.ForwardProxy_ANY,
+		Users: map[string]string{"user": "pass"},
+		Allow: NewAllow(&api.ForwardProxy_Allow{
+			Cidrs: []string{host + "/32"},
+			Ports: []uint32{uint32(port)},
+		}),
+	})
+	defer front.Close()
+
+	c, err := net.Dial("tcp", front.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	br := bufio.NewReader(c)
+	c.Write([]byte{5, 1, 2})
+	read := func(n int) []byte {
+		b := make([]byte, n)
+		if _, err := io.ReadFull(br, b); err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	if got := read(2); got[1] != socks5AuthPassword {
+		t.Fatalf("expected password auth got %v", got)
+	}
+	c.Write([]byte{1, 4, 'u', 's', 'e', 'r', 4, 'p', 'a', 's', 's'})
+	if got := read(2); got[1] != 0 {
+		t.Fatalf("expected auth success got %v", got)
+	}
+	req := []byte{5, 1, 0, 1}
+	req = append(req, net.ParseIP(host).To4()...)
+	req = append(req, 0, 0)
+	binary.BigEndian.PutUint16(req[len(req)-2:], uint16(port))
+	c.Write(req)
+	if got := read(10); got[1] != socks5Succeeded {
+		t.Fatalf("expected success got %v", got)
+	}
+	const msg = "hello"
+	io.WriteString(c, msg)
+	if got := string(read(len(msg))); got != msg {
+		t.Errorf("expected %q got %q", msg, got)
+	}
+}
+
+func TestForwardConnect(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	echoOnce(t, back)
+	_, portStr, _ := net.SplitHostPort(back.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	front := testForward(t, &ForwardProxy{
+		Allow: NewAllow(&api.ForwardProxy_Allow{
+			HostNames: []string{"localhost"},
+			Ports:     []uint32{uint32(port)},
+		}),
+	})
+	defer front.Close()
+
+	connect := func(addr string) (net.Conn, *bufio.Reader, int) {
+		c, err := net.Dial("tcp", front.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(c, "CONNECT "+addr+" HTTP/1.1\r\nHost: "+addr+"\r\n\r\n")
+		br := bufio.NewReader(c)
+		res, err := http.ReadResponse(br, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c, br, res.StatusCode
+	}
+	t.Run("denied", func(t *testing.T) {
+		c, _, code := connect("example.com:" + portStr)
+		defer c.Close()
+		if code != http.StatusForbidden {
+			t.Errorf("expected %d got %d", http.StatusForbidden, code)
+		}
+	})
+	t.Run("no port", func(t *testing.T) {
+		c, _, code := connect("localhost")
+		defer c.Close()
+		if code != http.StatusBadRequest {
+			t.Errorf("expected %d got %d", http.StatusBadRequest, code)
+		}
+	})
+	t.Run("allowed", func(t *testing.T) {
+		c, br, code := connect("localhost:" + portStr)
+		defer c.Close()
+		if code != http.StatusOK {
+			t.Fatalf("expected %d got %d", http.StatusOK, code)
+		}
+		const msg = "hello"
+		io.WriteString(c, msg)
+		b := make([]byte, len(msg))
+		if _, err := io.ReadFull(br, b); err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != msg {
+			t.Errorf("expected %q got %q", msg, b)
+		}
+	})
+}
+
+func TestForwardSOCKS5EmptyDomain(t *testing.T) {
+	front := testForward(t, &ForwardProxy{
+		Mode: api.ForwardProxy_SOCKS5,
+		Allow: NewAllow(&api.ForwardProxy_Allow{
+			HostNames: []string{"localhost"},
+		}),
+	})
+	defer front.Close()
+
+	c, err := net.Dial("tcp", front.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Write([]byte{5, 1, 0})
+	c.Write([]byte{5, 1, 0, socks5AddrDomain, 0, 0, 80})
+	b := make([]byte, 12)
+	if _, err := io.ReadFull(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if b[3] != socks5GeneralFailure {
+		t.Errorf("expected general failure got %v", b[2:])
+	}
+}
+
+func TestAllowResolve(t *testing.T) {
+	empty := NewAllow(nil)
+	for _, addr := range []string{
+		"127.0.0.1:80",
+		"169.254.169.254:80",
+		"0.0.0.0:80",
+		"[::1]:80",
+		"localhost:80",
+	} {
+		if _, err := empty.Resolve(context.Background(), addr); !errors.Is(err, ErrDestinationNotAllowed) {
+			t.Errorf("%s: expected %v got %v", addr, ErrDestinationNotAllowed, err)
+		}
+	}
+	a := NewAllow(&api.ForwardProxy_Allow{
+		HostNames: []string{"localhost"},
+	})
+	for _, addr := range []string{
+		"localhost",
+		":80",
+		"localhost:",
+	} {
+		if _, err := a.Resolve(context.Background(), addr); !errors.Is(err, errBadDestination) {
+			t.Errorf("%s: expected %v got %v", addr, errBadDestination, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/zlg"
+	"go.uber.org/zap"
+)
+
+// internal are in process listeners that HTTP routes bind to with
+// Bind.internal. TCP routes pass connections to them with Route.http_listener
+// without going through the network.
+var internal = struct {
+	mu sync.Mutex
+	m  map[string]*internalListener
+}{
+	m: make(map[string]*internalListener),
+}
+
+// ListenInternal returns a listener that accepts connections passed from TCP
+// routes with http_listener set to name. It replaces any existing listener
+// with the same name.
+func ListenInternal(name string) net.Listener {
+	ln := &internalListener{
+		TargetListener: &TargetListener{Address: "internal:" + name},
+		name:           name,
+	}
+	internal.mu.Lock()
+	internal.m[name] = ln
+	internal.mu.Unlock()
+	return ln
+}
+
+func lookupInternal(name string) *internalListener {
+	internal.mu.Lock()
+	defer internal.mu.Unlock()
+	return internal.m[name]
+}
+
+type internalListener struct {
+	*TargetListener
+	name string
+}
+
+func (i *internalListener) Close() error {
+	internal.mu.Lock()
+	if internal.m[i.name] == i {
+		delete(internal.m, i.name)
+	}
+	internal.mu.Unlock()
+	return i.TargetListener.Close()
+}
+
+// httpTarget passes connections to the internal listener named name.
+type httpTarget struct {
+	name string
+}
+
+var _ tcp.Target = (*httpTarget)(nil)
+
+// HandleConn implements tcp.Target. It blocks until the connection is closed
+// by the server that accepted it.
+func (h *httpTarget) HandleConn(ctx context.Context, c net.Conn) {
+	ln := lookupInternal(h.name)
+	if ln == nil {
+		zlg.Info("No internal listener", zap.String("name", h.name))
+		c.Close()
+		return
+	}
+	meta := tcp.GetContextMeta(ctx)
+	meta.Protocol.Store(uint32(tcp.HTTP))
+	ic := &internalConn{Conn: c, ctx: ctx, done: make(chan struct{})}
+	ln.HandleConn(ctx, ic)
+	<-ic.done
+}
+
+// internalConn is a connection passed to an internal listener. It keeps the
+// context of the TCP connection so it can be carried into HTTP requests.
+type internalConn struct {
+	net.Conn
+	ctx  context.Context
+	once sync.Once
+	done chan struct{}
+}
+
+func (c *internalConn) Close() error {
+	c.once.Do(func() {
+		close(c.done)
+	})
+	return c.Conn.Close()
+}
+
+// ConnContext returns ctx with ContextMeta of c when c was passed from a TCP
+// route. It is used as http.Server.ConnContext for servers accepting from
+// internal listeners, servers terminating TLS pass the *tls.Conn wrapping c.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	if tc, ok := c.(*tls.Conn); ok {
+		c = tc.NetConn()
+	}
+	if ic, ok := c.(*internalConn); ok {
+		if m, ok := tcp.LookupContextMeta(ic.ctx); ok {
+			return tcp.WithContextMeta(ctx, m)
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+)
+
+func TestProxyHTTPListener(t *testing.T) {
+	ln := ListenInternal("web")
+	defer ln.Close()
+	svr := &http.Server{
+		ConnContext: ConnContext,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m, ok := tcp.LookupContextMeta(r.Context())
+			if !ok {
+				http.Error(w, "missing meta", http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprintf(w, "%s %v", m.RouteName.Load(), m.ID.Load() != 0)
+		}),
+	}
+	go svr.Serve(ln)
+	defer svr.Close()
+
+	front := newLocalListener(t)
+	defer front.Close()
+	p, cancel := testProxy(t, front)
+	defer cancel()
+	p.AddRoute(testFrontAddr, buildTarget(context.Background(), &api.Route{
+		Name:         "tcp-web",
+		HttpListener: "web",
+	}))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return net.Dial("tcp", front.Addr().String())
+			},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("http://example.com/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if got, want := string(b), "tcp-web true"; got != want {
+			t.Errorf("expected %q got %q", want, got)
+		}
+	}
+}
+
+func TestProxyHTTPListenerTLS(t *testing.T) {
+	ln := ListenInternal("web-tls")
+	defer ln.Close()
+	svr := &http.Server{
+		ConnContext: ConnContext,
+		TLSConfig:   &tls.Config{Certificates: []tls.Certificate{cert(t, "example.com")}},
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m, ok := tcp.LookupContextMeta(r.Context())
+			if !ok {
+				http.Error(w, "missing meta", http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprintf(w, "%s %v", m.RouteName.Load(), m.ID.Load() != 0)
+		}),
+	}
+	go svr.ServeTLS(ln, "", "")
+	defer svr.Close()
+
+	front := newLocalListener(t)
+	defer front.Close()
+	p, cancel := testProxy(t, front)
+	defer cancel()
+	p.AddRoute(testFrontAddr, buildTarget(context.Background(), &api.Route{
+		Name:         "tcp-web-tls",
+		HttpListener: "web-tls",
+	}))
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return net.Dial("tcp", front.Addr().String())
+			},
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	res, err := client.Get("https://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if got, want := string(b), "tcp-web-tls true"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
@@ -0,0 +1,139 @@
+//go:build linux
+// +build linux
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"golang.org/x/sys/unix"
+)
+
+func TestProxyListenOptions(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	go func() {
+		for {
+			c, err := back.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				io.Copy(c, c)
+			}()
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	const hostPort = "127.0.0.1:0"
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts:      &proxyPkg.Options{AllowedPorts: []int{0}},
+	}
+	p.Route(&api.Route{
+		Bind: &api.Bind{
+			To: &api.Bind_HostPort{HostPort: hostPort},
+			Options: &api.Bind_ListenOptions{
+				Acceptors:   4,
+				Backlog:     128,
+				TcpNoDelay:  &wrappers.BoolValue{Value: false},
+				TcpFastOpen: 16,
+				DeferAccept: ptypes.DurationProto(time.Second),
+			},
+		},
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Fixed{Fixed: &empty.Empty{}},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.Addr().String()}, Weight: 1},
+		},
+	})
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	addr := p.lns[hostPort].Addr().String()
+	if n := len(proxyPkg.Sockets(p.lns[hostPort])); n != 4 {
+		t.Errorf("expected an accept loop for each of 4 sockets got %d", n)
+	}
+	for i := 0; i < 8; i++ {
+		c, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := fmt.Sprintf("hello %d", i)
+		io.WriteString(c, msg)
+		b := make([]byte, len(msg))
+		if _, err := io.ReadFull(c, b); err != nil {
+			t.Fatal(err)
+		}
+		c.Close()
+		if string(b) != msg {
+			t.Errorf("expected %q got %q", msg, b)
+		}
+	}
+}
+
+// TestListenSocketOptions checks that every acceptor socket has the socket
+// options applied.
+func TestListenSocketOptions(t *testing.T) {
+	ln, err := proxyPkg.ListenFunc(&api.Bind_ListenOptions{
+		Acceptors:   4,
+		TcpFastOpen: 16,
+		DeferAccept: ptypes.DurationProto(time.Second),
+	})("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	ls := proxyPkg.Sockets(ln)
+	if len(ls) != 4 {
+		t.Fatalf("expected 4 acceptors got %d", len(ls))
+	}
+	for _, l := range ls {
+		c, err := l.(syscall.Conn).SyscallConn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Control(func(u uintptr) { checkSockopts(t, int(u)) })
+	}
+}
+
+func checkSockopts(t *testing.T, fd int) {
+	t.Helper()
+	if v, err := unix.GetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEPORT); err != nil || v != 1 {
+		t.Errorf("expected SO_REUSEPORT got %d %v", v, err)
+	}
+	if v, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_FASTOPEN); err != nil || v != 16 {
+		t.Errorf("expected TCP_FASTOPEN 16 got %d %v", v, err)
+	}
+	// the kernel rounds the timeout to retransmission intervals.
+	if v, err := unix.GetsockoptInt(fd, unix.IPPROTO_TCP, unix.TCP_DEFER_ACCEPT); err != nil || v == 0 {
+		t.Errorf("expected TCP_DEFER_ACCEPT got %d %v", v, err)
+	}
+}
+
+func TestAcceptorsClosed(t *testing.T) {
+	ln, err := proxyPkg.ListenFunc(&api.Bind_ListenOptions{Acceptors: 2})("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln.Close()
+	if _, err := ln.Accept(); !ErrIsNetClosed(err) {
+		t.Errorf("expected closed listener error got %v", err)
+	}
+}
@@ -74,10 +74,10 @@ func (tl *TargetListener) Close() error {
 func (tl *TargetListener) HandleConn(ctx context.Context, c net.Conn) {
 	tl.lock()
 	defer tl.mu.Unlock()
-	for ctx.Done() == nil && tl.nextConn != nil && !tl.closed {
+	for ctx.Err() == nil && tl.nextConn != nil && !tl.closed {
 		tl.cond.Wait()
 	}
-	if tl.closed || ctx.Done() != nil {
+	if tl.closed || ctx.Err() != nil {
 		c.Close()
 		return
 	}
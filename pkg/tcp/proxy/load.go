@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/discovery"
 	proxyPkg "github.com/gernest/tt/pkg/proxy"
 	"github.com/gernest/tt/pkg/tcp"
 	"github.com/gernest/tt/pkg/tcp/middlewares"
@@ -22,7 +23,11 @@ func (m configMap) get(ipPort string) *tcp.Config {
 	if c, ok := m[ipPort]; ok {
 		return c
 	}
-	c := &tcp.Config{}
+	c := &tcp.Config{
+		FirstByteTimeout: defaultFirstByteTimeout,
+		MatchTimeout:     defaultMatchTimeout,
+		MaxPeek:          defaultMaxPeek,
+	}
 	m[ipPort] = c
 	return c
 }
@@ -46,11 +51,11 @@ func (m configMap) AddSNIMatchRoute(ipPort string, matcher middlewares.Matcher,
 	cfg := m.get(ipPort)
 	if cfg.AllowACME {
 		if len(cfg.AcmeTargets) == 0 {
-			cfg.Routes = append(cfg.Routes, &middlewares.AcmeMatch{cfg})
+			cfg.Routes = append(cfg.Routes, &middlewares.AcmeMatch{Cfg: cfg})
 		}
 		cfg.AcmeTargets = append(cfg.AcmeTargets, dest)
 	}
-	cfg.Routes = append(cfg.Routes, middlewares.SniMatch{matcher, dest})
+	cfg.Routes = append(cfg.Routes, middlewares.SniMatch{Matcher: matcher, Target: dest})
 }
 
 // AddSNIRoute appends a route to the ipPort listener that routes to
@@ -90,69 +95,97 @@ func (m configMap) AddAllowACMESearch(ipPort string) {
 const defaultIPPort = "dream"
 const defaultNetwork = "tcp"
 
-// Route generates configuration based on r
-func (m configMap) Route(r *api.Route) {
+// Route generates configuration based on r. mw are applied to the connection
+// after the route middlewares.
+func (m configMap) Route(r *api.Route, mw ...tcp.MiddleareFunc) {
+	m.RouteContext(context.Background(), r, mw...)
+}
+
+// RouteContext is like Route, endpoints discovered for load_balance targets of
+// r are resolved again when their TTL expires until ctx is done.
+func (m configMap) RouteContext(ctx context.Context, r *api.Route, mw ...tcp.MiddleareFunc) {
 	var labels []zapcore.Field
 	for k, v := range r.MetricsLabels {
 		labels = append(labels, zap.String(k, v))
 	}
 	ipPort := proxyPkg.BindToHostPort(r.Bind, defaultIPPort)
-	network := defaultNetwork
+	network, _ := proxyPkg.SplitNetwork(ipPort)
 	labels = append(labels, zap.String("ip:port", ipPort))
 	zlg.Info("Loading route", labels...)
 	m.get(ipPort).AllowACME = r.AllowAcme
 	m.get(ipPort).Network = network
-	switch e := r.Condition.Match.(type) {
+	if r.Bind != nil {
+		m.get(ipPort).Bind = r.Bind
+		if f := r.Bind.Fallback; f != nil {
+			m.get(ipPort).Fallback = fallback(ctx, f)
+		}
+		if o := r.Bind.MatchOptions; o != nil {
+			m.matchOptions(ipPort, o)
+		}
+	}
+	if f := r.TlsFingerprint; f != nil {
+		m.get(ipPort).Fingerprint = true
+		mw = append(mw, fingerprintFilter(f)...)
+	}
+	switch e := r.GetCondition().GetMatch().(type) {
 	case *api.RequestMatch_Sni:
 		zlg.Info("Adding sni route",
 			zap.String("host", e.Sni),
 		)
-		m.AddSNIRoute(ipPort, e.Sni, buildTarget(r))
+		m.AddSNIRoute(ipPort, e.Sni, buildTarget(ctx, r, mw...))
 	case *api.RequestMatch_Fixed:
 		zlg.Info("Adding fixed route")
-		m.AddRoute(ipPort, buildTarget(r))
+		m.AddRoute(ipPort, buildTarget(ctx, r, mw...))
+	}
+	if f := r.GetTlsFingerprint().GetMatch(); f != nil {
+		m.matchFingerprint(ipPort, newFingerprints(f))
 	}
 }
 
-func buildTarget(r *api.Route) tcp.Target {
-	return tcp.BuildMiddlewares(r).Then(target(r))
+// matchFingerprint restricts the last route added to ipPort to connections
+// with a fingerprint in set.
+func (m configMap) matchFingerprint(ipPort string, set fingerprints) {
+	cfg := m.get(ipPort)
+	if n := len(cfg.Routes); n > 0 {
+		cfg.Routes[n-1] = fingerprintRoute{Route: cfg.Routes[n-1], set: set}
+	}
 }
 
-func target(r *api.Route) tcp.Target {
-	if r.LoadBalance != nil {
-		switch r.LoadBalanceAlgo {
-		case api.Route_RoundRobinWeighted:
-			w := &weighted.RRW{}
-			for _, v := range r.LoadBalance {
-				t := toDial(v, r)
-				w.Add(t, int(v.Weight))
-			}
-			return &balance{
-				ba: balanceFn(func() tcp.Target {
-					return w.Next().(tcp.Target)
-				}),
-			}
-		case api.Route_SmoothWeighted:
-			w := &weighted.SW{}
-			for _, v := range r.LoadBalance {
-				t := toDial(v, r)
-				w.Add(t, int(v.Weight))
-			}
-			return &balance{
-				ba: balanceFn(func() tcp.Target {
-					return w.Next().(tcp.Target)
-				}),
-			}
+func buildTarget(ctx context.Context, r *api.Route, mw ...tcp.MiddleareFunc) tcp.Target {
+	return tcp.BuildMiddlewares(r).Then(tcp.Chain(mw).Then(target(ctx, r)))
+}
 
-		case api.Route_RandomWeighted:
-			w := &weighted.RandW{}
-			for _, v := range r.LoadBalance {
-				t := toDial(v, r)
-				w.Add(t, int(v.Weight))
+func target(ctx context.Context, r *api.Route) tcp.Target {
+	if f := r.GetForwardProxy(); f != nil {
+		return forward(r, f)
+	}
+	if r.HttpListener != "" {
+		return &httpTarget{name: r.HttpListener}
+	}
+	if r.LoadBalance != nil {
+		if discovery.Dynamic(r.LoadBalance...) {
+			b := &balance{
+				set: discovery.NewSet(func(a *api.WeightedAddr) string {
+					return a.GetAddr().GetAddress()
+				}, r.LoadBalance...),
+				build: func(eps []discovery.Endpoint) balancer {
+					return weightedBalancer(r.LoadBalanceAlgo, func(w weighted.W) {
+						for _, e := range eps {
+							w.Add(dialEndpoint(e, r), e.Weight)
+						}
+					})
+				},
 			}
+			go b.set.Run(ctx)
+			return b
+		}
+		switch r.LoadBalanceAlgo {
+		case api.Route_RoundRobinWeighted, api.Route_SmoothWeighted, api.Route_RandomWeighted:
 			return &balance{
-				ba: balanceFn(func() tcp.Target {
-					return w.Next().(tcp.Target)
+				ba: weightedBalancer(r.LoadBalanceAlgo, func(w weighted.W) {
+					for _, v := range r.LoadBalance {
+						w.Add(toDial(v, r), int(v.Weight))
+					}
 				}),
 			}
 		}
@@ -167,6 +200,20 @@ func toDial(a *api.WeightedAddr, r *api.Route) *DialProxy {
 		ipPort = a.Addr.Address
 		network = a.Addr.Network
 	}
+	return dialRoute(r, network, ipPort, a.MetricLabels)
+}
+
+// dialEndpoint returns DialProxy to a discovered endpoint e.
+func dialEndpoint(e discovery.Endpoint, r *api.Route) *DialProxy {
+	network := defaultNetwork
+	if n := e.Addr.GetAddr().GetNetwork(); n != "" {
+		network = n
+	}
+	return dialRoute(r, network, e.Address, e.Addr.MetricLabels)
+}
+
+// dialRoute returns DialProxy to network/ipPort with settings from r.
+func dialRoute(r *api.Route, network, ipPort string, labels map[string]string) *DialProxy {
 	timeout, _ := ptypes.Duration(r.Timeout)
 	keepAlive, _ := ptypes.Duration(r.KeepAlive)
 	if r.EnableOptimizedCopy {
@@ -181,7 +228,7 @@ func toDial(a *api.WeightedAddr, r *api.Route) *DialProxy {
 		Addr:            ipPort,
 		DialTimeout:     timeout,
 		KeepAlivePeriod: keepAlive,
-		MetricsLabels:   a.MetricLabels,
+		MetricsLabels:   labels,
 		UpstreamSpeed:   up,
 		DownstreamSpeed: down,
 	}
@@ -189,12 +236,54 @@ func toDial(a *api.WeightedAddr, r *api.Route) *DialProxy {
 
 type balancer interface {
 	Next() tcp.Target
+	// Get returns the target dialing addr or nil if the balancer has none.
+	Get(addr string) tcp.Target
+}
+
+// weightedTargets balances with w and keeps targets added to it by address.
+type weightedTargets struct {
+	w       weighted.W
+	targets map[string]tcp.Target
+}
+
+func (w *weightedTargets) Next() tcp.Target {
+	if t, ok := w.w.Next().(tcp.Target); ok {
+		return t
+	}
+	return nil
+}
+
+func (w *weightedTargets) Get(addr string) tcp.Target {
+	return w.targets[addr]
+}
+
+// recordTargets records targets added to W by their address.
+type recordTargets struct {
+	weighted.W
+	targets map[string]tcp.Target
 }
 
-type balanceFn func() tcp.Target
+func (r recordTargets) Add(item interface{}, weight int) {
+	r.W.Add(item, weight)
+	if d, ok := item.(*DialProxy); ok {
+		r.targets[d.Addr] = d
+	}
+}
 
-func (fn balanceFn) Next() tcp.Target {
-	return fn()
+// weightedBalancer returns a balancer using algo over targets added by add.
+func weightedBalancer(algo api.Route_LoadBalanceAlgo, add func(weighted.W)) balancer {
+	var w weighted.W
+	switch algo {
+	case api.Route_SmoothWeighted:
+		w = &weighted.SW{}
+	case api.Route_RandomWeighted:
+		w = &weighted.RandW{}
+	default:
+		w = &weighted.RRW{}
+	}
+	targets := make(map[string]tcp.Target)
+	add(recordTargets{W: w, targets: targets})
+	return &weightedTargets{w: w, targets: targets}
 }
 
 var _ tcp.Target = (*balance)(nil)
@@ -202,11 +291,73 @@ var _ tcp.Target = (*balance)(nil)
 type balance struct {
 	ba balancer
 	mu sync.Mutex
+
+	// set provides endpoints for routes using discovery. ba is rebuilt with
+	// build when the endpoints change.
+	set     *discovery.Set
+	version uint64
+	build   func([]discovery.Endpoint) balancer
 }
 
 func (b *balance) HandleConn(ctx context.Context, conn net.Conn) {
+	var eps []discovery.Endpoint
+	var version uint64
+	if b.set != nil {
+		eps, version = b.set.Endpoints(ctx)
+	}
 	b.mu.Lock()
-	t := b.ba.Next()
+	if b.set != nil && (b.ba == nil || version != b.version) {
+		b.ba = b.build(eps)
+		b.version = version
+	}
+	var t tcp.Target
+	a := getAffinity(ctx)
+	if a != nil && a.pinned != "" {
+		t = b.ba.Get(a.pinned)
+	}
+	if d, ok := t.(*DialProxy); ok {
+		b.mu.Unlock()
+		dst, err := d.dial(ctx)
+		if err == nil {
+			a.use(d.Addr)
+			d.serve(ctx, conn, dst)
+			return
+		}
+		zlg.Info("Pinned endpoint is unavailable, balancing again",
+			zap.String("upstream", d.Addr),
+			zap.Error(err),
+		)
+		b.mu.Lock()
+		t = b.next(d.Addr)
+	}
+	if t == nil {
+		t = b.ba.Next()
+	}
 	b.mu.Unlock()
+	if t == nil {
+		zlg.Info("No upstream endpoints available")
+		tcp.GetContextMeta(ctx).Terminate(api.AccessEntry_Session_UPSTREAM_CONNECT_FAILED)
+		conn.Close()
+		return
+	}
+	if d, ok := t.(*DialProxy); ok && a != nil {
+		a.use(d.Addr)
+	}
 	t.HandleConn(ctx, conn)
 }
+
+// maxSkips bounds how many times next asks the balancer for another target.
+const maxSkips = 16
+
+// next returns the next target of the balancer that doesn't dial addr. It
+// returns the target dialing addr when the balancer keeps picking it.
+func (b *balance) next(addr string) tcp.Target {
+	t := b.ba.Next()
+	for i := 0; i < maxSkips; i++ {
+		if d, ok := t.(*DialProxy); !ok || d.Addr != addr {
+			break
+		}
+		t = b.ba.Next()
+	}
+	return t
+}
@@ -0,0 +1,177 @@
+package proxy
+
+import (
+	"net"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	defaultFirstByteTimeout = 10 * time.Second
+	defaultMatchTimeout     = 30 * time.Second
+	// defaultMaxPeek fits the largest TLS record, which is enough for any
+	// ClientHello.
+	defaultMaxPeek = 16384 + 5
+	// minPeek is the smallest buffer bufio allows.
+	minPeek = 16
+)
+
+var (
+	totalMatchTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "tcp_match_timeouts_total",
+			Help:      "Total number of tcp connections that timed out while matching routes",
+		},
+		[]string{"listener", "phase"},
+	)
+	totalPeekLimit = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "tcp_peek_limit_total",
+			Help:      "Total number of tcp connections that filled the peek buffer without matching a route",
+		},
+		[]string{"listener"},
+	)
+	matchingConns = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "tt",
+			Name:      "tcp_matching_connections",
+			Help:      "Number of tcp connections waiting to match a route",
+		},
+	)
+	totalMatchingRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tt",
+			Name:      "tcp_matching_rejected_total",
+			Help:      "Total number of tcp connections closed because too many connections were matching routes",
+		},
+		[]string{"listener"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		totalMatchTimeouts,
+		totalPeekLimit,
+		matchingConns,
+		totalMatchingRejected,
+	)
+}
+
+// matchOptions sets limits of matching routes on ipPort from o. Listeners
+// without options use the defaults.
+func (m configMap) matchOptions(ipPort string, o *api.MatchOptions) {
+	cfg := m.get(ipPort)
+	if o.FirstByteTimeout != nil {
+		cfg.FirstByteTimeout, _ = ptypes.Duration(o.FirstByteTimeout)
+	}
+	if o.Timeout != nil {
+		cfg.MatchTimeout, _ = ptypes.Duration(o.Timeout)
+	}
+	if o.MaxPeekBytes > 0 {
+		cfg.MaxPeek = int(o.MaxPeekBytes)
+		if cfg.MaxPeek < minPeek {
+			cfg.MaxPeek = minPeek
+		}
+	}
+}
+
+// matchLimit limits the number of connections matching routes across all
+// listeners.
+type matchLimit chan struct{}
+
+// newMatchLimit returns a limit of n connections, it returns nil which has no
+// limit when n is not positive.
+func newMatchLimit(n int) matchLimit {
+	if n <= 0 {
+		return nil
+	}
+	return make(matchLimit, n)
+}
+
+// acquire returns false if there are too many connections matching routes.
+func (l matchLimit) acquire() bool {
+	if l == nil {
+		matchingConns.Inc()
+		return true
+	}
+	select {
+	case l <- struct{}{}:
+		matchingConns.Inc()
+		return true
+	default:
+		return false
+	}
+}
+
+func (l matchLimit) release() {
+	matchingConns.Dec()
+	if l != nil {
+		<-l
+	}
+}
+
+// matchConn reads from a connection that is matching routes. The deadline is
+// moved from the first byte deadline to the match deadline once the first byte
+// is read.
+type matchConn struct {
+	net.Conn
+	first    bool
+	deadline time.Time
+	// timeout is true when a read timed out
+	timeout bool
+}
+
+// newMatchConn sets read deadlines on c for matching routes with cfg.
+func newMatchConn(c net.Conn, cfg *tcp.Config, start time.Time) *matchConn {
+	m := &matchConn{Conn: c}
+	if cfg.MatchTimeout > 0 {
+		m.deadline = start.Add(cfg.MatchTimeout)
+	}
+	first := m.deadline
+	if cfg.FirstByteTimeout > 0 {
+		if d := start.Add(cfg.FirstByteTimeout); first.IsZero() || d.Before(first) {
+			first = d
+		}
+	}
+	if !first.IsZero() {
+		c.SetReadDeadline(first)
+	}
+	return m
+}
+
+func (m *matchConn) Read(p []byte) (int, error) {
+	n, err := m.Conn.Read(p)
+	if n > 0 && !m.first {
+		m.first = true
+		m.Conn.SetReadDeadline(m.deadline)
+	}
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+		m.timeout = true
+	}
+	return n, err
+}
+
+// timedOut returns the phase of matching that timed out or an empty string.
+func (m *matchConn) timedOut() string {
+	switch {
+	case !m.timeout:
+		return ""
+	case m.first:
+		return "match"
+	case !m.deadline.IsZero() && !time.Now().Before(m.deadline):
+		return "match"
+	default:
+		return "first_byte"
+	}
+}
+
+// done clears deadlines set for matching.
+func (m *matchConn) done() {
+	m.Conn.SetReadDeadline(time.Time{})
+}
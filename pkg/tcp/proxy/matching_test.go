@@ -0,0 +1,93 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// expectClosed fails if c is not closed by the proxy within d.
+func expectClosed(t *testing.T, c net.Conn, d time.Duration) {
+	t.Helper()
+	c.SetReadDeadline(time.Now().Add(d))
+	_, err := c.Read(make([]byte, 1))
+	if e, ok := err.(net.Error); err == nil || ok && e.Timeout() {
+		// closing with unread data resets the connection instead of EOF
+		t.Errorf("expected the connection to be closed got %v", err)
+	}
+}
+
+func TestProxyMatchTimeouts(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	r := sniRoute("a.test", back, nil)
+	r.Bind = &api.Bind{
+		MatchOptions: &api.MatchOptions{
+			FirstByteTimeout: ptypes.DurationProto(100 * time.Millisecond),
+			Timeout:          ptypes.DurationProto(300 * time.Millisecond),
+		},
+	}
+	addr, stop := testRoutes(t, r)
+	defer stop()
+
+	idle, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idle.Close()
+	expectClosed(t, idle, 2*time.Second)
+
+	slow, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer slow.Close()
+	hello := clientHelloRecord(t, "a.test")
+	io.WriteString(slow, hello[:3])
+	expectClosed(t, slow, 2*time.Second)
+}
+
+func TestProxyMaxPeek(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	r := sniRoute("a.test", back, nil)
+	r.Bind = &api.Bind{
+		MatchOptions: &api.MatchOptions{MaxPeekBytes: 64},
+	}
+	addr, stop := testRoutes(t, r)
+	defer stop()
+
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	io.WriteString(c, clientHelloRecord(t, "a.test"))
+	expectClosed(t, c, 2*time.Second)
+}
+
+func TestMatchLimit(t *testing.T) {
+	l := newMatchLimit(1)
+	if !l.acquire() {
+		t.Fatal("expected the first connection to be allowed")
+	}
+	if l.acquire() {
+		t.Fatal("expected the limit to be reached")
+	}
+	l.release()
+	if !l.acquire() {
+		t.Fatal("expected released slots to be reused")
+	}
+	l.release()
+	var none matchLimit
+	for i := 0; i < 4; i++ {
+		if !none.acquire() {
+			t.Fatal("expected no limit")
+		}
+		none.release()
+	}
+}
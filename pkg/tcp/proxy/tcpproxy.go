@@ -26,8 +26,10 @@ import (
 	"time"
 
 	"github.com/gernest/tt/api"
+	accesslog "github.com/gernest/tt/pkg/access_log"
 	proxyPkg "github.com/gernest/tt/pkg/proxy"
 	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/tcp/middlewares"
 	"github.com/gernest/tt/pkg/zlg"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -52,16 +54,29 @@ type Proxy struct {
 
 	lns    map[string]net.Listener
 	cancel context.CancelFunc
-	mu     sync.RWMutex
+	// cancelBuild stops background work of targets of the last build.
+	cancelBuild context.CancelFunc
+	mu          sync.RWMutex
+
+	// active tracks accepted connections so they can be drained on shutdown.
+	active  sync.WaitGroup
+	connsMu sync.Mutex
+	conns   map[net.Conn]struct{}
 	// ListenFunc optionally specifies an alternate listen
-	// function. If nil, net.Dial is used.
-	// The provided net is always "tcp".
+	// function. If nil, proxy.ListenFunc with the options of the bind is used.
+	// The provided net is either "tcp" or "unix".
 	ListenFunc func(net, laddr string) (net.Listener, error)
 
 	ctx context.Context
 
 	// The host:ip on which this host is listening from.
 	opts *proxyPkg.Options
+
+	// sessions keeps endpoints of routes with affinity, it is nil when the
+	// cache is disabled.
+	sessions *sessions
+	// matching limits connections matching routes across listeners.
+	matching matchLimit
 }
 
 // goodPort returns true if port is good and should be ok to listen on.
@@ -84,7 +99,7 @@ func New(ctx context.Context, opts *proxyPkg.Options) *Proxy {
 	x := conf.get(opts.Listen.TCP.HostPort)
 	x.Routes = append(x.Routes, noopRoute{})
 	for _, r := range opts.Routes.Routes {
-		conf.Route(r)
+		conf.RouteContext(ctx, r, wasmMiddlewares(ctx, opts, r)...)
 	}
 	return &Proxy{
 		configMap: conf,
@@ -101,18 +116,37 @@ func (p *Proxy) Boot(ctx context.Context, opts *proxyPkg.Options) error {
 }
 
 func (p *Proxy) setup(ctx context.Context, opts *proxyPkg.Options) {
+	p.ctx = ctx
+	p.opts = opts
+	p.matching = newMatchLimit(opts.MaxMatchingConns)
+	p.sessions = newSessions(proxyPkg.GetCache(ctx))
+	p.configMap = p.build(opts.Routes.Routes)
+	p.config = &opts.Routes
+	p.lns = make(map[string]net.Listener)
+}
+
+// build returns configuration for tcp routes in routes. The default tcp
+// listener is always included. Discovered endpoints of routes of the previous
+// build are no longer resolved in the background.
+func (p *Proxy) build(routes []*api.Route) configMap {
+	if p.cancelBuild != nil {
+		p.cancelBuild()
+	}
+	ctx, cancel := context.WithCancel(p.ctx)
+	p.cancelBuild = cancel
 	conf := make(configMap)
-	x := conf.get(opts.Listen.TCP.HostPort)
+	x := conf.get(p.opts.Listen.TCP.HostPort)
 	x.Routes = append(x.Routes, noopRoute{})
-	for _, r := range opts.Routes.Routes {
+	for _, r := range routes {
 		if r.Protocol == api.Protocol_TCP {
-			conf.Route(r)
+			mw := wasmMiddlewares(p.ctx, p.opts, r)
+			if a := p.sessions.middleware(r); a != nil {
+				mw = append(mw, a)
+			}
+			conf.RouteContext(ctx, r, mw...)
 		}
 	}
-	p.configMap = conf
-	p.lns = make(map[string]net.Listener)
-	p.ctx = ctx
-	p.opts = opts
+	return conf
 }
 
 // RPC returns rpc server used to dynamically update the state of the proxy
@@ -125,11 +159,7 @@ func (p *Proxy) RPC() *Updates {
 func (p *Proxy) Configure(x *api.Config) error {
 	// avoid wasteful reloads by making sure that the configuration changed
 	if !proto.Equal(p.config, x) {
-		m := make(configMap)
-		for _, r := range x.Routes {
-			m.Route(r)
-		}
-		if err := p.Reload(m); err != nil {
+		if err := p.Reload(p.build(x.Routes)); err != nil {
 			// restore old apis because we can't load the new ones
 			if n := p.TriggerReload(); n != nil {
 				// TODO we are in a broken state log/error or something
@@ -193,22 +223,18 @@ func (p *Proxy) Config() proxyPkg.Config {
 }
 
 func (p *Proxy) TriggerReload() error {
-	m := make(configMap)
-	for _, r := range p.config.Routes {
-		m.Route(r)
-	}
-	return p.Reload(m)
+	return p.Reload(p.build(p.config.GetRoutes()))
 }
 
 func (p *Proxy) GetConfig() (*api.Config, error) {
 	return p.config, nil
 }
 
-func (p *Proxy) netListen() func(net, laddr string) (net.Listener, error) {
+func (p *Proxy) netListen(b *api.Bind) func(net, laddr string) (net.Listener, error) {
 	if p.ListenFunc != nil {
 		return p.ListenFunc
 	}
-	return net.Listen
+	return proxyPkg.ListenFunc(b.GetOptions())
 }
 
 type fixedTarget struct {
@@ -297,22 +323,25 @@ func (p *Proxy) Start() (err error) {
 
 	for hostPort := range set {
 		if _, ok := p.lns[hostPort]; !ok {
-			var port string
-			_, port, err = net.SplitHostPort(hostPort)
-			if err != nil {
-				zlg.Error(err, "Failed to split ip:port", zap.String("ip:port", hostPort))
-				return
-			}
-			px, _ := strconv.Atoi(port)
-			if !p.goodPort(px) {
-				zlg.Error(ErrPortNotAllowed, "Trying to open blacklisted port",
-					zap.Int("port", px),
-					zap.String("ip:port", hostPort),
-				)
-				continue
+			cfg := p.configMap[hostPort]
+			if network, _ := proxyPkg.SplitNetwork(hostPort); network == defaultNetwork {
+				var port string
+				_, port, err = net.SplitHostPort(hostPort)
+				if err != nil {
+					zlg.Error(err, "Failed to split ip:port", zap.String("ip:port", hostPort))
+					return
+				}
+				px, _ := strconv.Atoi(port)
+				if !p.goodPort(px) {
+					zlg.Error(ErrPortNotAllowed, "Trying to open blacklisted port",
+						zap.Int("port", px),
+						zap.String("ip:port", hostPort),
+					)
+					continue
+				}
 			}
 			var ln net.Listener
-			ln, err = p.netListen()("tcp", hostPort)
+			ln, err = proxyPkg.ListenBind(p.netListen(cfg.Bind), hostPort, cfg.Bind)
 			if err != nil {
 				return
 			}
@@ -350,7 +379,10 @@ func (p *Proxy) base(ctx context.Context, ls net.Listener) context.Context {
 
 func (p *Proxy) start(ctx context.Context) {
 	for x, ln := range p.lns {
-		go p.serveListener(ctx, ln, x)
+		// binds with acceptors are served by an accept loop for each socket.
+		for _, s := range proxyPkg.Sockets(ln) {
+			go p.serveListener(ctx, s, x)
+		}
 	}
 }
 
@@ -368,19 +400,68 @@ func (p *Proxy) serveListener(ctx context.Context, ln net.Listener, hostPort str
 			}
 			return
 		}
-		zlg.Info(fmt.Sprintf("%s --> %s", c.RemoteAddr().String(), c.LocalAddr().String()))
+		zlg.Debug("Accepted connection",
+			zap.String("remote", c.RemoteAddr().String()),
+			zap.String("local", c.LocalAddr().String()),
+		)
 		base := p.base(ctx, ln)
 		base = tcp.UpdateContext(base, func(m *tcp.ContextMeta) {
 			m.D.A.L.Address = c.LocalAddr().String()
-			m.D.A.R.Address = c.RemoteAddr().Network()
+			m.D.A.R.Address = c.RemoteAddr().String()
 		})
-		go serveConn(base, c, useConfig.Routes)
+		p.trackConn(c, true)
+		go func() {
+			defer p.trackConn(c, false)
+			serveConn(base, c, useConfig, p.matching)
+		}()
+	}
+}
+
+func (p *Proxy) trackConn(c net.Conn, add bool) {
+	p.connsMu.Lock()
+	defer p.connsMu.Unlock()
+	if p.conns == nil {
+		p.conns = make(map[net.Conn]struct{})
+	}
+	if add {
+		p.conns[c] = struct{}{}
+		p.active.Add(1)
+	} else {
+		delete(p.conns, c)
+		p.active.Done()
+	}
+}
+
+// Shutdown closes all listeners and waits for active connections to finish.
+// Connections that are still active when ctx is done are closed.
+func (p *Proxy) Shutdown(ctx context.Context) error {
+	for _, c := range p.lns {
+		c.Close()
+	}
+	done := make(chan struct{})
+	go func() {
+		p.active.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		p.connsMu.Lock()
+		for c := range p.conns {
+			c.Close()
+		}
+		p.connsMu.Unlock()
+		return ctx.Err()
 	}
 }
 
 // ErrIsNetClosed returns true if err is an error returned when using a closed
 // network connection
 func ErrIsNetClosed(err error) bool {
+	if errors.Is(err, net.ErrClosed) {
+		return true
+	}
 	var e *net.OpError
 	if errors.As(err, &e) {
 		return e.Err.Error() == "use of closed network connection"
@@ -396,30 +477,78 @@ func (noopRoute) Match(context.Context, *bufio.Reader) (tcp.Target, string) {
 	return nil, ""
 }
 
-// serveConn runs in its own goroutine and matches c against routes.
-// It returns whether it matched purely for testing.
-func serveConn(ctx context.Context, c net.Conn, routes []tcp.Route) {
-	br := bufio.NewReader(c)
+// serveConn runs in its own goroutine and matches c against routes of cfg.
+// Connections that don't match any route are passed to cfg.Fallback.
+func serveConn(ctx context.Context, c net.Conn, cfg *tcp.Config, limit matchLimit) {
 	meta := tcp.GetContextMeta(ctx)
 	defer func() {
 		c.Close()
 		meta.Complete()
+		e := accesslog.NewEntry()
+		e.UpdateConn(meta)
+		accesslog.Get(ctx).Record(e)
 	}()
-	for _, route := range routes {
+	listener := meta.D.A.L.Address
+	if !limit.acquire() {
+		totalMatchingRejected.WithLabelValues(listener).Inc()
+		meta.Terminate(api.AccessEntry_Session_REJECTED)
+		return
+	}
+	mc := newMatchConn(c, cfg, meta.Start)
+	size := cfg.MaxPeek
+	if size == 0 {
+		size = defaultMaxPeek
+	}
+	br := bufio.NewReaderSize(mc, size)
+	matched := func() {
+		mc.done()
+		limit.release()
+	}
+	if cfg.Fingerprint {
+		middlewares.Fingerprint(ctx, br)
+	}
+	for _, route := range cfg.Routes {
 		if target, hostName := route.Match(ctx, br); target != nil {
-			if n := br.Buffered(); n > 0 {
-				peeked, _ := br.Peek(br.Buffered())
-				c = &Conn{
-					HostName: hostName,
-					Peeked:   peeked,
-					Conn:     c,
-				}
-			}
-			target.HandleConn(ctx, c)
+			matched()
+			handle(ctx, c, br, hostName, target)
 			return
 		}
 	}
 	meta.NoMatch.Store(true)
+	phase := mc.timedOut()
+	if phase != "" {
+		totalMatchTimeouts.WithLabelValues(listener, phase).Inc()
+		meta.Terminate(api.AccessEntry_Session_MATCH_TIMEOUT)
+	} else if br.Buffered() == br.Size() {
+		totalPeekLimit.WithLabelValues(listener).Inc()
+	}
+	// deadlines are kept so logging unmatched connections doesn't block.
+	unmatched(ctx, br, meta)
+	matched()
+	if phase == "first_byte" {
+		return
+	}
+	if cfg.Fallback == nil {
+		meta.Terminate(api.AccessEntry_Session_NO_ROUTE)
+		return
+	}
+	if _, ok := cfg.Fallback.(reject); ok {
+		meta.Terminate(api.AccessEntry_Session_NO_ROUTE)
+	}
+	handle(ctx, c, br, "", cfg.Fallback)
+}
+
+// handle passes c to target with bytes peeked from br.
+func handle(ctx context.Context, c net.Conn, br *bufio.Reader, hostName string, target tcp.Target) {
+	if n := br.Buffered(); n > 0 {
+		peeked, _ := br.Peek(br.Buffered())
+		c = &Conn{
+			HostName: hostName,
+			Peeked:   peeked,
+			Conn:     c,
+		}
+	}
+	target.HandleConn(ctx, c)
 }
 
 func (p *Proxy) Reload(m configMap) error {
@@ -532,6 +661,31 @@ func UnderlyingConn(c net.Conn) net.Conn {
 
 // HandleConn implements the Target interface.
 func (dp *DialProxy) HandleConn(ctx context.Context, src net.Conn) {
+	dst, err := dp.dial(ctx)
+	if err != nil {
+		tcp.GetContextMeta(ctx).Terminate(api.AccessEntry_Session_UPSTREAM_CONNECT_FAILED)
+		dp.onDialError()(src, err)
+		return
+	}
+	dp.serve(ctx, src, dst)
+}
+
+// dial connects to the upstream.
+func (dp *DialProxy) dial(ctx context.Context) (net.Conn, error) {
+	if dp.DialTimeout >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dp.dialTimeout())
+		defer cancel()
+	}
+	network := defaultNetwork
+	if dp.Network != "" {
+		network = dp.Network
+	}
+	return dp.dialContext()(ctx, network, dp.Addr)
+}
+
+// serve proxies data between src and the upstream connection dst.
+func (dp *DialProxy) serve(ctx context.Context, src, dst net.Conn) {
 	meta := tcp.GetContextMeta(ctx)
 	// we update sppeds that were set on this dial
 	up, _ := dp.UpstreamSpeed.Limit()
@@ -540,25 +694,12 @@ func (dp *DialProxy) HandleConn(ctx context.Context, src net.Conn) {
 	down, _ := dp.DownstreamSpeed.Limit()
 	//TOD log error
 	meta.Speed.Downstream.Store(down)
-	var cancel context.CancelFunc
-	if dp.DialTimeout >= 0 {
-		ctx, cancel = context.WithTimeout(ctx, dp.dialTimeout())
-	}
-	network := defaultNetwork
-	if dp.Network != "" {
-		network = dp.Network
-	}
-	dst, err := dp.dialContext()(ctx, network, dp.Addr)
-	if cancel != nil {
-		cancel()
-	}
-	if err != nil {
-		dp.onDialError()(src, err)
-		return
-	}
 	defer dst.Close()
+	meta.U.A.L.Address = dst.LocalAddr().String()
+	meta.U.A.R.Address = dst.RemoteAddr().String()
 
-	if err = dp.sendProxyHeader(dst, src); err != nil {
+	if err := dp.sendProxyHeader(dst, src); err != nil {
+		meta.Terminate(api.AccessEntry_Session_CONNECTION_ERROR)
 		dp.onDialError()(src, err)
 		return
 	}
@@ -574,7 +715,7 @@ func (dp *DialProxy) HandleConn(ctx context.Context, src net.Conn) {
 			c.SetKeepAlivePeriod(ka)
 		}
 	}
-	errc := make(chan error, 1)
+	errc := make(chan copyResult, 2)
 	{
 		// upstream => downstream
 		from := dst
@@ -596,7 +737,7 @@ func (dp *DialProxy) HandleConn(ctx context.Context, src net.Conn) {
 				},
 			}
 		}
-		go proxyCopy(ctx, errc, to, from)
+		go proxyCopy(ctx, errc, to, from, true)
 	}
 	{
 		// downstream => upstream
@@ -619,9 +760,33 @@ func (dp *DialProxy) HandleConn(ctx context.Context, src net.Conn) {
 				},
 			}
 		}
-		go proxyCopy(ctx, errc, to, from)
+		go proxyCopy(ctx, errc, to, from, false)
+	}
+	first := <-errc
+	switch {
+	case first.err != nil:
+		meta.Terminate(api.AccessEntry_Session_CONNECTION_ERROR)
+	case first.upstream:
+		meta.Terminate(api.AccessEntry_Session_UPSTREAM_CLOSE)
+	default:
+		meta.Terminate(api.AccessEntry_Session_DOWNSTREAM_CLOSE)
+	}
+	// unblock the other direction so its bytes are accounted for before the
+	// connection is logged.
+	src.Close()
+	dst.Close()
+	second := <-errc
+	for _, r := range []copyResult{first, second} {
+		// rate limited copies count bytes as they go
+		switch {
+		case r.upstream && down == 0:
+			meta.U.R.Add(r.n)
+			meta.D.W.Add(r.n)
+		case !r.upstream && up == 0:
+			meta.D.R.Add(r.n)
+			meta.U.W.Add(r.n)
+		}
 	}
-	<-errc
 }
 
 func (dp *DialProxy) sendProxyHeader(w io.Writer, src net.Conn) error {
@@ -657,12 +822,21 @@ func hashConn(conn net.Conn) string {
 	return conn.LocalAddr().String() + "<>" + conn.RemoteAddr().String()
 }
 
+// copyResult is sent by proxyCopy when it is done copying.
+type copyResult struct {
+	// upstream is true when copying from upstream to downstream.
+	upstream bool
+	// n is the number of bytes copied.
+	n   int64
+	err error
+}
+
 // proxyCopy is the function that copies bytes around.
 // It's a named function instead of a func literal so users get
 // named goroutines in debug goroutine stack dumps.
 func proxyCopy(
-	ctx context.Context, errc chan error,
-	dst, src net.Conn,
+	ctx context.Context, errc chan copyResult,
+	dst, src net.Conn, upstream bool,
 ) {
 	nl := zlg.Logger.With(
 		zap.String("component", "proxyCopy"),
@@ -673,13 +847,16 @@ func proxyCopy(
 	defer func() {
 		nl.Debug("Done copying")
 	}()
+	var n int64
 	// Before we unwrap src and/or dst, copy any buffered data.
 	if wc, ok := src.(*Conn); ok && len(wc.Peeked) > 0 {
-		if _, err := dst.Write(wc.Peeked); err != nil {
+		w, err := dst.Write(wc.Peeked)
+		if err != nil {
 			nl.Error(err.Error() + "Failed to write to connection")
-			errc <- err
+			errc <- copyResult{upstream: upstream, n: int64(w), err: err}
 			return
 		}
+		n += int64(w)
 		wc.Peeked = nil
 	}
 
@@ -687,8 +864,8 @@ func proxyCopy(
 	// 1.11's splice optimization kicks in.
 	src = UnderlyingConn(src)
 	dst = UnderlyingConn(dst)
-	_, err := io.Copy(dst, src)
-	errc <- err
+	w, err := io.Copy(dst, src)
+	errc <- copyResult{upstream: upstream, n: n + w, err: err}
 }
 
 func (dp *DialProxy) keepAlivePeriod() time.Duration {
@@ -34,6 +34,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gernest/tt/api"
 	proxyPkg "github.com/gernest/tt/pkg/proxy"
 	"github.com/gernest/tt/pkg/tcp/middlewares"
 )
@@ -127,7 +128,39 @@ func testProxy(t *testing.T, front net.Listener) (*Proxy, context.CancelFunc) {
 		opts:       &proxyPkg.Options{},
 	}
 	p.opts.AllowedPorts = []int{567}
-	return p, cancel
+	// closing the proxy removes its listeners from the inherit registry.
+	return p, func() {
+		p.Close()
+		cancel()
+	}
+}
+
+// testRoutes starts a proxy for routes bound to 127.0.0.1:0 and returns the
+// address it listens on.
+func testRoutes(t *testing.T, routes ...*api.Route) (string, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	const hostPort = "127.0.0.1:0"
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts:      &proxyPkg.Options{AllowedPorts: []int{0}},
+	}
+	for _, r := range routes {
+		if r.Bind == nil {
+			r.Bind = &api.Bind{}
+		}
+		r.Bind.To = &api.Bind_HostPort{HostPort: hostPort}
+		p.Route(r)
+	}
+	if err := p.Start(); err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	return p.lns[hostPort].Addr().String(), func() {
+		p.Close()
+		cancel()
+	}
 }
 
 func TestProxyAlwaysMatch(t *testing.T) {
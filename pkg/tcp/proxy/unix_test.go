@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+func TestProxyUnix(t *testing.T) {
+	dir := t.TempDir()
+	frontPath := filepath.Join(dir, "front.sock")
+	backPath := filepath.Join(dir, "back.sock")
+
+	back, err := net.Listen("unix", backPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer back.Close()
+	echoOnce(t, back)
+
+	// a stale socket file left behind by a previous process must not prevent
+	// listening.
+	stale, err := net.Listen("unix", frontPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts:      &proxyPkg.Options{},
+	}
+	p.Route(&api.Route{
+		Bind: &api.Bind{
+			To: &api.Bind_Unix_{
+				Unix: &api.Bind_Unix{Path: frontPath, Mode: "0600"},
+			},
+		},
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Fixed{Fixed: &empty.Empty{}},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Network: "unix", Address: backPath}, Weight: 1},
+		},
+	})
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	stat, err := os.Stat(frontPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := stat.Mode().Perm(); mode != 0600 {
+		t.Errorf("expected mode 0600 got %o", mode)
+	}
+
+	c, err := net.Dial("unix", frontPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	const msg = "hello"
+	io.WriteString(c, msg)
+	b := make([]byte, len(msg))
+	if _, err := io.ReadFull(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != msg {
+		t.Errorf("expected %q got %q", msg, b)
+	}
+}
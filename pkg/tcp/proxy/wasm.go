@@ -0,0 +1,75 @@
+package proxy
+
+import (
+	"context"
+	"net"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/tcp"
+	"github.com/gernest/tt/pkg/zlg"
+	handlerv1 "github.com/gernest/tt/wasm/v1/handler"
+	"go.uber.org/zap"
+)
+
+// wasmMiddlewares returns network filters for wasm middlewares of r. Only v1
+// modules support network filters, other middlewares are skipped.
+func wasmMiddlewares(ctx context.Context, opts *proxyPkg.Options, r *api.Route) (c tcp.Chain) {
+	for _, mw := range r.GetMiddlewares().GetList() {
+		ws := mw.GetWasm()
+		if ws == nil {
+			continue
+		}
+		if ws.Version != api.Middleware_V1 {
+			zlg.Info("Skipping wasm middleware, network filters require v1",
+				zap.String("middleware", ws.Name),
+			)
+			continue
+		}
+		h, err := handlerv1.New(ctx, opts.Wasm.Dir, ws)
+		if err != nil {
+			zlg.Error(err, "Failed to load wasm middleware",
+				zap.String("middleware", ws.Name),
+			)
+			continue
+		}
+		c = append(c, func(t tcp.Target) tcp.Target {
+			return &wasmTarget{h: h, target: t}
+		})
+	}
+	return
+}
+
+// wasmTarget passes connection data through a proxy-wasm network filter.
+type wasmTarget struct {
+	h      *handlerv1.H
+	target tcp.Target
+}
+
+func (w *wasmTarget) HandleConn(ctx context.Context, conn net.Conn) {
+	meta := tcp.GetContextMeta(ctx)
+	c, err := w.h.NewConn(conn, func(path string) (string, bool) {
+		switch path {
+		case "source.address":
+			return meta.D.A.R.Address, true
+		case "destination.address":
+			return meta.D.A.L.Address, true
+		case "upstream.address":
+			return meta.U.A.R.Address, meta.U.A.R.Address != ""
+		case "connection.requested_server_name":
+			return meta.ServerName.Load(), true
+		case "route_name":
+			return meta.RouteName.Load(), true
+		default:
+			return "", false
+		}
+	})
+	if err != nil {
+		meta.Terminate(api.AccessEntry_Session_REJECTED)
+		zlg.Error(err, "Closing connection")
+		conn.Close()
+		return
+	}
+	defer c.Close()
+	w.target.HandleConn(ctx, c)
+}
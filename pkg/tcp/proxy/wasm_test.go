@@ -0,0 +1,122 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+	proxyPkg "github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestProxyWasmNetworkFilter(t *testing.T) {
+	back := newLocalListener(t)
+	defer back.Close()
+	echoOnce(t, back)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the filter logs through the logger of the context it was loaded with.
+	logs := &logBuffer{}
+	wasmCtx := zlg.Set(ctx, zap.New(zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		zapcore.AddSync(logs),
+		zap.DebugLevel,
+	)))
+	const hostPort = "127.0.0.1:0"
+	p := &Proxy{
+		configMap: make(configMap),
+		ctx:       ctx,
+		opts: &proxyPkg.Options{
+			AllowedPorts: []int{0},
+			Wasm:         proxyPkg.Wasm{Dir: "../../../tools/modules"},
+		},
+	}
+	r := &api.Route{
+		Name: "network",
+		Bind: &api.Bind{To: &api.Bind_HostPort{HostPort: hostPort}},
+		Condition: &api.RequestMatch{
+			Match: &api.RequestMatch_Fixed{Fixed: &empty.Empty{}},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.Addr().String()}, Weight: 1},
+		},
+		Middlewares: &api.Middleware_List{
+			List: []*api.Middleware{
+				{Match: &api.Middleware_Wasm_{Wasm: &api.Middleware_Wasm{
+					Name:    "network",
+					Module:  "network.wasm",
+					Version: api.Middleware_V1,
+					Config: &api.Middleware_Wasm_Config{
+						Instance: &api.Middleware_Wasm_Setting{},
+					},
+				}}},
+			},
+		},
+	}
+	p.Route(r, wasmMiddlewares(wasmCtx, p.opts, r)...)
+	if err := p.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	c, err := net.Dial("tcp", p.lns[hostPort].Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := "hello"
+	io.WriteString(c, msg)
+	b := make([]byte, len(msg))
+	if _, err := io.ReadFull(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != msg {
+		t.Errorf("expected %q got %q", msg, b)
+	}
+	c.Close()
+
+	// close callbacks run after the proxy notices the client is gone.
+	expect := []string{
+		"new connection!",
+		"downstream data received",
+		"upstream data received",
+		"downstream connection close!",
+		"connection complete!",
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(logs.String(), "connection complete!") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	got := logs.String()
+	for _, s := range expect {
+		if !strings.Contains(got, s) {
+			t.Errorf("expected filter to log %q", s)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package tmpl expands templates over attributes of HTTP requests, like
+// "{scheme}://{host}{uri}", used in header values and redirect targets.
+package tmpl
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gernest/tt/api"
+	"github.com/gorilla/mux"
+)
+
+// RequestIDHeader is the header carrying the id of a request.
+const RequestIDHeader = "X-Request-Id"
+
+// variables are functions returning values of template variables.
+var variables = map[string]func(route *api.Route, r *http.Request) string{
+	"client_ip": func(_ *api.Route, r *http.Request) string {
+		return ClientIP(r)
+	},
+	"route": func(route *api.Route, _ *http.Request) string {
+		return route.GetName()
+	},
+	"service": func(route *api.Route, _ *http.Request) string {
+		return route.GetService()
+	},
+	"host": func(_ *api.Route, r *http.Request) string {
+		return Host(r)
+	},
+	"method": func(_ *api.Route, r *http.Request) string {
+		return r.Method
+	},
+	"scheme": func(_ *api.Route, r *http.Request) string {
+		return Scheme(r)
+	},
+	"path": func(_ *api.Route, r *http.Request) string {
+		return r.URL.EscapedPath()
+	},
+	"query": func(_ *api.Route, r *http.Request) string {
+		return r.URL.RawQuery
+	},
+	"uri": func(_ *api.Route, r *http.Request) string {
+		return r.URL.RequestURI()
+	},
+	"request_id": func(_ *api.Route, r *http.Request) string {
+		return RequestID(r)
+	},
+	"time": func(_ *api.Route, _ *http.Request) string {
+		return time.Now().UTC().Format(time.RFC3339)
+	},
+	"time_unix": func(_ *api.Route, _ *http.Request) string {
+		return strconv.FormatInt(time.Now().Unix(), 10)
+	},
+}
+
+// Template is a parsed template.
+type Template struct {
+	parts []part
+}
+
+// part is either literal text or a variable.
+type part struct {
+	text string
+	fn   func(route *api.Route, r *http.Request) string
+	// decoded is true for variables whose values are not escaped, like the
+	// variables of path rules.
+	decoded bool
+}
+
+// Parse parses s, variables are names in braces like {client_ip} and {{ is a
+// literal brace. Variables of path rules are var.name, like {var.path}.
+func Parse(s string) (*Template, error) {
+	t := &Template{}
+	var text strings.Builder
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '{')
+		if i == -1 {
+			text.WriteString(s)
+			break
+		}
+		text.WriteString(s[:i])
+		s = s[i+1:]
+		if strings.HasPrefix(s, "{") {
+			text.WriteByte('{')
+			s = s[1:]
+			continue
+		}
+		j := strings.IndexByte(s, '}')
+		if j == -1 {
+			return nil, fmt.Errorf("unclosed variable in template")
+		}
+		name := s[:j]
+		fn, ok := variables[name]
+		v, decoded := strings.CutPrefix(name, "var.")
+		decoded = decoded && v != ""
+		if decoded {
+			fn, ok = routeVar(v), true
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown template variable %q", name)
+		}
+		if text.Len() > 0 {
+			t.parts = append(t.parts, part{text: text.String()})
+			text.Reset()
+		}
+		t.parts = append(t.parts, part{fn: fn, decoded: decoded})
+		s = s[j+1:]
+	}
+	if text.Len() > 0 {
+		t.parts = append(t.parts, part{text: text.String()})
+	}
+	return t, nil
+}
+
+// Execute returns the template expanded for request r of route.
+func (t *Template) Execute(route *api.Route, r *http.Request) string {
+	return t.execute(route, r, false)
+}
+
+// ExecutePath returns the template expanded for request r of route as an
+// escaped path. Values of path rule variables are escaped segment by segment,
+// so an encoded value like %2e%2e stays literal text instead of becoming a dot
+// segment.
+func (t *Template) ExecutePath(route *api.Route, r *http.Request) string {
+	return t.execute(route, r, true)
+}
+
+func (t *Template) execute(route *api.Route, r *http.Request, escape bool) string {
+	if len(t.parts) == 1 && t.parts[0].fn == nil {
+		return t.parts[0].text
+	}
+	var b strings.Builder
+	for _, p := range t.parts {
+		switch {
+		case p.fn == nil:
+			b.WriteString(p.text)
+		case escape && p.decoded:
+			b.WriteString(escapeSegments(p.fn(route, r)))
+		default:
+			b.WriteString(p.fn(route, r))
+		}
+	}
+	return b.String()
+}
+
+// escapeSegments escapes each segment of path p.
+func escapeSegments(p string) string {
+	s := strings.Split(p, "/")
+	for i, v := range s {
+		s[i] = url.PathEscape(v)
+	}
+	return strings.Join(s, "/")
+}
+
+// routeVar returns the function returning the value of variable name of the
+// rule matching requests.
+func routeVar(name string) func(route *api.Route, r *http.Request) string {
+	return func(_ *api.Route, r *http.Request) string {
+		return mux.Vars(r)[name]
+	}
+}
+
+// ClientIP returns the address of the client that sent r.
+func ClientIP(r *http.Request) string {
+	if ip, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return ip
+	}
+	return r.RemoteAddr
+}
+
+// Host returns the host r was sent to without port.
+func Host(r *http.Request) string {
+	if h, _, err := net.SplitHostPort(r.Host); err == nil {
+		return strings.ToLower(h)
+	}
+	return strings.ToLower(r.Host)
+}
+
+// Scheme returns the scheme r was received with.
+func Scheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// RequestID returns the id of r, requests without one are assigned a random
+// id so it is passed on to upstreams.
+func RequestID(r *http.Request) string {
+	if id := r.Header.Get(RequestIDHeader); id != "" {
+		return id
+	}
+	var b [16]byte
+	rand.Read(b[:])
+	id := hex.EncodeToString(b[:])
+	r.Header.Set(RequestIDHeader, id)
+	return id
+}
@@ -0,0 +1,74 @@
+package tmpl
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/gorilla/mux"
+)
+
+func TestTemplate(t *testing.T) {
+	route := &api.Route{Name: "app", Service: "web"}
+	sample := []struct {
+		template string
+		expect   string
+		ok       bool
+	}{
+		{"plain", "plain", true},
+		{"{client_ip}", "192.0.2.1", true},
+		{"{route}/{service}", "app/web", true},
+		{"{scheme}://{host}{uri}", "https://example.com/a%20b?q=1", true},
+		{"{method} {path} {query}", "GET /a%20b q=1", true},
+		{"{{route}", "{route}", true},
+		{"{request_id}", "abc", true},
+		{"{unknown}", "", false},
+		{"{route", "", false},
+	}
+	for _, s := range sample {
+		x, err := Parse(s.template)
+		if !s.ok {
+			if err == nil {
+				t.Errorf("%q: expected an error", s.template)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", s.template, err)
+			continue
+		}
+		r := httptest.NewRequest("GET", "https://Example.com:8443/a%20b?q=1", nil)
+		r.TLS = &tls.ConnectionState{}
+		r.Header.Set(RequestIDHeader, "abc")
+		if got := x.Execute(route, r); got != s.expect {
+			t.Errorf("%q: expected %q got %q", s.template, s.expect, got)
+		}
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	id := RequestID(r)
+	if len(id) != 32 || r.Header.Get(RequestIDHeader) != id {
+		t.Fatalf("expected generated id to be set got %q %q", id, r.Header.Get(RequestIDHeader))
+	}
+	if RequestID(r) != id {
+		t.Error("expected the same id for the same request")
+	}
+}
+
+func TestExecutePath(t *testing.T) {
+	x, err := Parse("/v2/{var.path}{query}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest("GET", "/?a=b", nil)
+	r = mux.SetURLVars(r, map[string]string{"path": "a b/%2e%2e/c?"})
+	if got, want := x.ExecutePath(&api.Route{}, r), "/v2/a%20b/%252e%252e/c%3Fa=b"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+	if got, want := x.Execute(&api.Route{}, r), "/v2/a b/%2e%2e/c?a=b"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package upgrade
+
+import (
+	"net"
+	"os"
+)
+
+func sendFiles(conn *net.UnixConn, files map[string][]*os.File) error {
+	return ErrNotSupported
+}
+
+func recvFiles(conn *net.UnixConn) (map[string][]*os.File, error) {
+	return nil, ErrNotSupported
+}
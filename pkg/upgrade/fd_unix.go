@@ -0,0 +1,102 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package upgrade
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+)
+
+// sendFiles sends files over conn in a single message. The message is the json
+// encoded host:port of each file prefixed with its length, with the file
+// descriptors attached in the same order.
+func sendFiles(conn *net.UnixConn, files map[string][]*os.File) error {
+	var names []string
+	var fds []int
+	for k, fs := range files {
+		for _, f := range fs {
+			names = append(names, k)
+			fds = append(fds, int(f.Fd()))
+		}
+	}
+	if len(fds) > maxFiles {
+		return fmt.Errorf("upgrade: too many listeners %d, max is %d", len(fds), maxFiles)
+	}
+	payload, err := json.Marshal(names)
+	if err != nil {
+		return err
+	}
+	b := make([]byte, 4+len(payload))
+	binary.BigEndian.PutUint32(b, uint32(len(payload)))
+	copy(b[4:], payload)
+	var oob []byte
+	if len(fds) > 0 {
+		oob = syscall.UnixRights(fds...)
+	}
+	_, _, err = conn.WriteMsgUnix(b, oob, nil)
+	return err
+}
+
+func recvFiles(conn *net.UnixConn) (map[string][]*os.File, error) {
+	b := make([]byte, 64<<10)
+	oob := make([]byte, syscall.CmsgSpace(maxFiles*4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(b, oob)
+	if err != nil {
+		return nil, err
+	}
+	var fds []int
+	msgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, err
+	}
+	for i := range msgs {
+		x, err := syscall.ParseUnixRights(&msgs[i])
+		if err != nil {
+			return nil, err
+		}
+		fds = append(fds, x...)
+	}
+	closeFDs := func() {
+		for _, fd := range fds {
+			syscall.Close(fd)
+		}
+	}
+	if n < 4 {
+		if _, err := io.ReadFull(conn, b[n:4]); err != nil {
+			closeFDs()
+			return nil, err
+		}
+		n = 4
+	}
+	size := int(binary.BigEndian.Uint32(b))
+	if size > len(b)-4 {
+		closeFDs()
+		return nil, fmt.Errorf("upgrade: message too large %d", size)
+	}
+	if n < 4+size {
+		if _, err := io.ReadFull(conn, b[n:4+size]); err != nil {
+			closeFDs()
+			return nil, err
+		}
+	}
+	var names []string
+	if err := json.Unmarshal(b[4:4+size], &names); err != nil {
+		closeFDs()
+		return nil, err
+	}
+	if len(names) != len(fds) {
+		closeFDs()
+		return nil, fmt.Errorf("upgrade: expected %d sockets got %d", len(names), len(fds))
+	}
+	m := make(map[string][]*os.File)
+	for i, name := range names {
+		m[name] = append(m[name], os.NewFile(uintptr(fds[i]), name))
+	}
+	return m, nil
+}
@@ -0,0 +1,170 @@
+// Package upgrade implements handing over listening sockets from a running tt
+// process to a newly started one.
+//
+// The running process listens on a unix socket in the work directory. The new
+// process connects to it and receives duplicates of all listening sockets. The
+// old process then stops accepting connections, releases resources that can't
+// be shared like the raft storage and tells the new process to take over while
+// it drains existing connections.
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/zlg"
+	"go.uber.org/zap"
+)
+
+// SocketName is the name of the unix socket in the work directory used for
+// upgrades.
+const SocketName = "upgrade.sock"
+
+const (
+	ack     byte = 'A'
+	release byte = 'R'
+)
+
+// maxFiles is the maximum number of sockets that can be passed in a single
+// message.
+const maxFiles = 250
+
+// ErrNotSupported is returned on platforms that can't pass file descriptors
+// between processes.
+var ErrNotSupported = errors.New("upgrade: passing sockets is not supported on this platform")
+
+// Path returns path to the upgrade socket in workDir.
+func Path(workDir string) string {
+	return filepath.Join(workDir, SocketName)
+}
+
+// Server hands over listeners of the running process to a new process.
+type Server struct {
+	ln *net.UnixListener
+}
+
+// Listen starts listening for upgrade requests on path.
+func Listen(path string) (*Server, error) {
+	// Only one process can use the work directory at a time so any existing
+	// socket file was left behind by a process that didn't exit cleanly.
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	ln, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return &Server{ln: ln}, nil
+}
+
+// Accept waits for a new process to request listeners and sends them. The
+// returned Handoff must be released once the new process can take over.
+func (s *Server) Accept(ctx context.Context) (*Handoff, error) {
+	go func() {
+		<-ctx.Done()
+		s.ln.Close()
+	}()
+	for {
+		conn, err := s.ln.AcceptUnix()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		if err := handoff(conn); err != nil {
+			zlg.Error(err, "Failed to hand over listeners")
+			conn.Close()
+			continue
+		}
+		return &Handoff{conn: conn}, nil
+	}
+}
+
+func handoff(conn *net.UnixConn) error {
+	files, err := proxy.ListenerFiles()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, fs := range files {
+			for _, f := range fs {
+				f.Close()
+			}
+		}
+	}()
+	if err := sendFiles(conn, files); err != nil {
+		return err
+	}
+	b := make([]byte, 1)
+	if _, err := io.ReadFull(conn, b); err != nil {
+		return err
+	}
+	if b[0] != ack {
+		return fmt.Errorf("upgrade: unexpected reply %q", b[0])
+	}
+	zlg.Info("Handed over listeners", zap.Int("count", len(files)))
+	return nil
+}
+
+// Close stops listening for upgrade requests.
+func (s *Server) Close() error {
+	return s.ln.Close()
+}
+
+// Handoff is an upgrade in progress.
+type Handoff struct {
+	conn *net.UnixConn
+}
+
+// Release tells the new process that it can take over.
+func (h *Handoff) Release() error {
+	defer h.conn.Close()
+	_, err := h.conn.Write([]byte{release})
+	return err
+}
+
+// Request connects to the process serving upgrades on path and receives its
+// listeners, which are then available to proxy.ListenBind. It returns after
+// the old process has released its resources.
+func Request(ctx context.Context, path string) error {
+	var d net.Dialer
+	c, err := d.DialContext(ctx, "unix", path)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	conn := c.(*net.UnixConn)
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	files, err := recvFiles(conn)
+	if err != nil {
+		return err
+	}
+	if err := proxy.Inherit(files); err != nil {
+		return err
+	}
+	zlg.Info("Received listeners", zap.Int("count", len(files)))
+	if _, err := conn.Write([]byte{ack}); err != nil {
+		return err
+	}
+	b := make([]byte, 1)
+	if _, err := io.ReadFull(conn, b); err != nil {
+		return err
+	}
+	if b[0] != release {
+		return fmt.Errorf("upgrade: unexpected reply %q", b[0])
+	}
+	return nil
+}
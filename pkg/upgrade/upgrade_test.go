@@ -0,0 +1,64 @@
+package upgrade
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/pkg/proxy"
+)
+
+func TestHandoff(t *testing.T) {
+	const hostPort = "127.0.0.1:0"
+	ln, err := proxy.ListenBind(net.Listen, hostPort, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	conn, err := proxy.ListenPacketBind(hostPort, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	path := filepath.Join(t.TempDir(), SocketName)
+	s, err := Listen(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		h, err := s.Accept(ctx)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		h.Release()
+	}()
+	if err := Request(ctx, path); err != nil {
+		t.Fatal(err)
+	}
+	inherited, err := proxy.ListenBind(func(network, address string) (net.Listener, error) {
+		t.Error("expected inherited listener")
+		return nil, net.ErrClosed
+	}, hostPort, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inherited.Close()
+	if got, want := inherited.Addr().String(), ln.Addr().String(); got != want {
+		t.Errorf("expected %s got %s", want, got)
+	}
+	inheritedConn, err := proxy.ListenPacketBind(hostPort, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inheritedConn.Close()
+	if got, want := inheritedConn.LocalAddr().String(), conn.LocalAddr().String(); got != want {
+		t.Errorf("expected udp %s got %s", want, got)
+	}
+}
@@ -0,0 +1,281 @@
+package xhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/proxy"
+	"go.uber.org/zap"
+)
+
+// certReloadDelay is how long certificate changes settle before they are
+// reloaded. Certificate and key files are usually written one after the other.
+const certReloadDelay = time.Second
+
+var errNoCertificate = errors.New("xhttp: no certificate")
+
+// certEntry is a certificate served for names.
+type certEntry struct {
+	names []string
+	cert  *api.TLS_Certificate
+}
+
+// certTable maps lower case host names to certificates. The empty name has
+// all certificates, it is used for clients asking for unknown names.
+type certTable map[string][]*tls.Certificate
+
+// certStore selects certificates of a TLS listener by server name.
+type certStore struct {
+	mu      sync.Mutex
+	entries []certEntry
+	table   atomic.Value
+}
+
+// certEntries returns certificates of routes bound to a listener.
+func certEntries(routes []*api.Route) (e []certEntry) {
+	for _, r := range routes {
+		for _, c := range r.GetBind().GetTls().GetCertificates() {
+			e = append(e, certEntry{names: r.HostNames, cert: c})
+		}
+	}
+	return
+}
+
+func (s *certStore) set(e []certEntry) {
+	s.mu.Lock()
+	s.entries = e
+	s.mu.Unlock()
+}
+
+// uses returns true if the store has a certificate read from key or from a
+// file in dir.
+func (s *certStore) uses(key, dir string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		switch {
+		case key != "" && e.cert.StoreKey == key:
+			return true
+		case dir != "" && e.cert.StoreKey == "" &&
+			(filepath.Dir(e.cert.CertFile) == dir || filepath.Dir(e.cert.KeyFile) == dir):
+			return true
+		}
+	}
+	return false
+}
+
+// load reads all certificates. Certificates that were loaded before are kept
+// when reading fails.
+func (s *certStore) load(store proxy.Store) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := make(certTable)
+	for _, e := range s.entries {
+		c, err := loadCertificate(e.cert, store)
+		if err != nil {
+			return err
+		}
+		names := e.names
+		if len(names) == 0 {
+			names = c.Leaf.DNSNames
+		}
+		for _, n := range names {
+			n = strings.ToLower(n)
+			t[n] = append(t[n], c)
+		}
+		t[""] = append(t[""], c)
+	}
+	s.table.Store(t)
+	return nil
+}
+
+func loadCertificate(c *api.TLS_Certificate, store proxy.Store) (*tls.Certificate, error) {
+	var cert tls.Certificate
+	if c.StoreKey != "" {
+		if store == nil {
+			return nil, fmt.Errorf("certificate %q: no replicated store", c.StoreKey)
+		}
+		b, err := store.Get(c.StoreKey)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %q: %v", c.StoreKey, err)
+		}
+		cert, err = tls.X509KeyPair(b, b)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %q: %v", c.StoreKey, err)
+		}
+	} else {
+		var err error
+		cert, err = tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	cert.Leaf = leaf
+	return &cert, nil
+}
+
+// GetCertificate returns the certificate for the server name of hello. Exact
+// names are preferred over wildcards. When there are several certificates for
+// a name, the first one supported by the client is used.
+func (s *certStore) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	t, _ := s.table.Load().(certTable)
+	name := strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))
+	certs := t[name]
+	if len(certs) == 0 {
+		if i := strings.IndexByte(name, '.'); i > 0 {
+			certs = t["*"+name[i:]]
+		}
+	}
+	if len(certs) == 0 {
+		certs = t[""]
+	}
+	if len(certs) == 0 {
+		return nil, errNoCertificate
+	}
+	for _, c := range certs {
+		if hello.SupportsCertificate(c) == nil {
+			return c, nil
+		}
+	}
+	return certs[0], nil
+}
+
+// watchCerts reloads certificates of TLS listeners when their files or store
+// keys change.
+func (p *Proxy) watchCerts(ctx context.Context) {
+	keys := make(chan string, 64)
+	// dropped is signaled when keys was full, all certificates are reloaded then.
+	dropped := make(chan struct{}, 1)
+	if p.opts.Store != nil {
+		p.opts.Store.Watch(func(key string) {
+			select {
+			case keys <- key:
+			default:
+				select {
+				case dropped <- struct{}{}:
+				default:
+				}
+			}
+		})
+	}
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	if p.certWatch != nil {
+		events = p.certWatch.Events
+		errs = p.certWatch.Errors
+	}
+	timer := time.NewTimer(certReloadDelay)
+	timer.Stop()
+	changed := make(map[*certStore]struct{})
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case key := <-keys:
+			if p.certsUsing(changed, key, "") {
+				timer.Reset(certReloadDelay)
+			}
+		case <-dropped:
+			if p.allCerts(changed) {
+				timer.Reset(certReloadDelay)
+			}
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if p.certsUsing(changed, "", filepath.Dir(e.Name)) {
+				timer.Reset(certReloadDelay)
+			}
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			p.zlg.Error("Certificate watch error", zap.Error(err))
+		case <-timer.C:
+			p.reloadCerts(changed)
+			changed = make(map[*certStore]struct{})
+		}
+	}
+}
+
+// certsUsing adds stores of listeners using key or a file in dir to m.
+func (p *Proxy) certsUsing(m map[*certStore]struct{}, key, dir string) (ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ls := range p.context {
+		if ls.certs != nil && ls.certs.uses(key, dir) {
+			m[ls.certs] = struct{}{}
+			ok = true
+		}
+	}
+	for _, ls := range p.quic {
+		if ls.certs.uses(key, dir) {
+			m[ls.certs] = struct{}{}
+			ok = true
+		}
+	}
+	return
+}
+
+// allCerts adds stores of all listeners with certificates to m.
+func (p *Proxy) allCerts(m map[*certStore]struct{}) (ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ls := range p.context {
+		if ls.certs != nil {
+			m[ls.certs] = struct{}{}
+			ok = true
+		}
+	}
+	for _, ls := range p.quic {
+		m[ls.certs] = struct{}{}
+		ok = true
+	}
+	return
+}
+
+func (p *Proxy) reloadCerts(m map[*certStore]struct{}) {
+	for s := range m {
+		if err := s.load(p.opts.Store); err != nil {
+			p.zlg.Error("Failed to reload certificates", zap.Error(err))
+			continue
+		}
+		p.zlg.Info("Reloaded certificates")
+	}
+}
+
+// watchCertFiles watches directories of certificate files of s.
+func (p *Proxy) watchCertFiles(s *certStore) {
+	if p.certWatch == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if e.cert.StoreKey != "" {
+			continue
+		}
+		for _, f := range []string{e.cert.CertFile, e.cert.KeyFile} {
+			if err := p.certWatch.Add(filepath.Dir(f)); err != nil {
+				p.zlg.Error("Failed to watch certificate", zap.String("file", f), zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package xhttp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gernest/tt/api"
+)
+
+// testCert returns a PEM encoded self signed certificate followed by its key.
+func testCert(t *testing.T, key crypto.Signer, names ...string) []byte {
+	t.Helper()
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: names[0]},
+		DNSNames:     names,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(b, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: k})...)
+}
+
+func ecdsaKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func rsaKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+// writeCert writes a certificate and key to the same file in dir.
+func writeCert(t *testing.T, dir, name string, b []byte) *api.TLS_Certificate {
+	t.Helper()
+	f := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(f, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return &api.TLS_Certificate{CertFile: f, KeyFile: f}
+}
+
+type testStore map[string][]byte
+
+func (s testStore) Get(key string) ([]byte, error) {
+	v, ok := s[key]
+	if !ok {
+		return nil, errors.New("key not found")
+	}
+	return v, nil
+}
+
+func (testStore) Watch(func(key string)) {}
+
+func TestCertStore(t *testing.T) {
+	dir := t.TempDir()
+	store := testStore{
+		"certs/b": testCert(t, ecdsaKey(t), "b.example.com"),
+	}
+	s := &certStore{}
+	s.set([]certEntry{
+		{
+			names: []string{"a.example.com"},
+			cert:  writeCert(t, dir, "a-ecdsa.pem", testCert(t, ecdsaKey(t), "a.example.com")),
+		},
+		{
+			names: []string{"a.example.com"},
+			cert:  writeCert(t, dir, "a-rsa.pem", testCert(t, rsaKey(t), "a.example.com")),
+		},
+		{
+			cert: writeCert(t, dir, "wild.pem", testCert(t, ecdsaKey(t), "*.wild.example.com")),
+		},
+		{
+			cert: &api.TLS_Certificate{StoreKey: "certs/b"},
+		},
+	})
+	if err := s.load(store); err != nil {
+		t.Fatal(err)
+	}
+	ecdsaOnly := []tls.SignatureScheme{tls.ECDSAWithP256AndSHA256}
+	rsaOnly := []tls.SignatureScheme{tls.PSSWithSHA256}
+	sample := []struct {
+		name    string
+		schemes []tls.SignatureScheme
+		dns     string
+	}{
+		{"a.example.com", ecdsaOnly, "a.example.com"},
+		{"A.example.com.", rsaOnly, "a.example.com"},
+		{"x.wild.example.com", ecdsaOnly, "*.wild.example.com"},
+		{"b.example.com", ecdsaOnly, "b.example.com"},
+	}
+	for _, v := range sample {
+		c, err := s.GetCertificate(&tls.ClientHelloInfo{
+			ServerName:        v.name,
+			SignatureSchemes:  v.schemes,
+			SupportedVersions: []uint16{tls.VersionTLS13},
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", v.name, err)
+		}
+		if got := c.Leaf.DNSNames[0]; got != v.dns {
+			t.Errorf("%s: expected %q got %q", v.name, v.dns, got)
+		}
+		_, isRSA := c.PrivateKey.(*rsa.PrivateKey)
+		if want := v.schemes[0] == tls.PSSWithSHA256; isRSA != want {
+			t.Errorf("%s: expected rsa=%v got rsa=%v", v.name, want, isRSA)
+		}
+	}
+
+	// reloading picks changes in the store
+	store["certs/b"] = testCert(t, ecdsaKey(t), "b.example.com", "c.example.com")
+	if err := s.load(store); err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "c.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Leaf.DNSNames) != 2 {
+		t.Errorf("expected reloaded certificate got %v", c.Leaf.DNSNames)
+	}
+
+	// failed reloads keep serving loaded certificates
+	delete(store, "certs/b")
+	if err := s.load(store); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "c.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	addr, stop := testProxy(t, &api.Route{
+		Name:      "tls",
+		Protocol:  api.Protocol_HTTP,
+		HostNames: []string{"tls.example.com"},
+		Bind: &api.Bind{
+			Tls: &api.TLS{
+				Certificates: []*api.TLS_Certificate{
+					writeCert(t, dir, "tls.pem", testCert(t, ecdsaKey(t), "tls.example.com")),
+				},
+			},
+		},
+		Rule: &api.Rule{
+			Match: &api.Rule_Http{
+				Http: &api.Rule_HTTP{
+					Match: &api.Rule_HTTP_Path_{
+						Path: &api.Rule_HTTP_Path{
+							Type:  api.Rule_HTTP_Path_Prefix,
+							Value: "/",
+						},
+					},
+				},
+			},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: "http://127.0.0.1:1"}},
+		},
+	})
+	defer stop()
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		ServerName:         "tls.example.com",
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	peer := conn.ConnectionState().PeerCertificates
+	if len(peer) == 0 || peer[0].DNSNames[0] != "tls.example.com" {
+		t.Errorf("unexpected certificate %v", peer)
+	}
+}
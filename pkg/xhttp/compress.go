@@ -0,0 +1,431 @@
+package xhttp
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/justinas/alice"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	defaultCompressMinSize = 1024
+	defaultMaxDecodedSize  = 10 << 20
+)
+
+var defaultEncodings = []api.Middleware_Compress_Encoding{
+	api.Middleware_Compress_BROTLI,
+	api.Middleware_Compress_ZSTD,
+	api.Middleware_Compress_GZIP,
+}
+
+var defaultCompressTypes = []string{
+	"text/*",
+	"application/json",
+	"application/*+json",
+	"application/javascript",
+	"application/xml",
+	"application/*+xml",
+	"image/svg+xml",
+}
+
+// encoder is implemented by the gzip, brotli and zstd writers.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+type codec struct {
+	// name is the content coding as in RFC 9110 section 8.4.1.
+	name     string
+	encoders sync.Pool
+	decode   func(r io.Reader) (io.Reader, func(), error)
+}
+
+var codecs = map[api.Middleware_Compress_Encoding]*codec{
+	api.Middleware_Compress_GZIP: {
+		name: "gzip",
+		encoders: sync.Pool{New: func() any {
+			return gzip.NewWriter(nil)
+		}},
+		decode: func(r io.Reader) (io.Reader, func(), error) {
+			gr, err := gzip.NewReader(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			return gr, func() { gr.Close() }, nil
+		},
+	},
+	api.Middleware_Compress_BROTLI: {
+		name: "br",
+		encoders: sync.Pool{New: func() any {
+			return brotli.NewWriterLevel(nil, brotli.DefaultCompression)
+		}},
+		decode: func(r io.Reader) (io.Reader, func(), error) {
+			return brotli.NewReader(r), func() {}, nil
+		},
+	},
+	api.Middleware_Compress_ZSTD: {
+		name: "zstd",
+		encoders: sync.Pool{New: func() any {
+			// options are valid, the error is always nil.
+			e, _ := zstd.NewWriter(nil,
+				zstd.WithEncoderConcurrency(1),
+				zstd.WithWindowSize(1<<20),
+			)
+			return e
+		}},
+		decode: func(r io.Reader) (io.Reader, func(), error) {
+			d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				return nil, nil, err
+			}
+			return d, d.Close, nil
+		},
+	},
+}
+
+type compressor struct {
+	encodings  []api.Middleware_Compress_Encoding
+	minSize    int
+	types      []string
+	decompress bool
+	maxDecoded int64
+}
+
+// Compress returns a middleware compressing responses with the encoding
+// negotiated from Accept-Encoding.
+func Compress(o *api.Middleware_Compress) alice.Constructor {
+	c := &compressor{
+		encodings:  o.Encodings,
+		minSize:    int(o.MinSize),
+		types:      o.ContentTypes,
+		decompress: o.DecompressRequests,
+		maxDecoded: int64(o.MaxDecodedSize),
+	}
+	if len(c.encodings) == 0 {
+		c.encodings = defaultEncodings
+	}
+	if c.minSize == 0 {
+		c.minSize = defaultCompressMinSize
+	}
+	if len(c.types) == 0 {
+		c.types = defaultCompressTypes
+	}
+	if c.maxDecoded == 0 {
+		c.maxDecoded = defaultMaxDecodedSize
+	}
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if c.decompress {
+				if !c.decodeRequest(w, r) {
+					http.Error(w, "invalid request body encoding", http.StatusBadRequest)
+					return
+				}
+			}
+			if r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+				// upgraded connections are hijacked, they are passed the original
+				// response writer.
+				h.ServeHTTP(w, r)
+				return
+			}
+			cw := &compressWriter{
+				ResponseWriter: w,
+				c:              c,
+				codec:          c.negotiate(r.Header.Values("Accept-Encoding")),
+			}
+			h.ServeHTTP(cw, r)
+			cw.finish()
+			if cw.enc != nil {
+				if m := meta.GetMetics(r.Context()); m != nil && m.Response != nil {
+					m.Response.ContentEncoding = cw.codec.name
+					m.Response.UncompressedSize = cw.size
+				}
+			}
+		})
+	}
+}
+
+// negotiate returns the codec of the accepted encoding with the highest
+// quality, ties are broken by the order of configured encodings. It returns
+// nil when none of the encodings is accepted.
+func (c *compressor) negotiate(accept []string) *codec {
+	q := map[string]float64{}
+	for _, line := range accept {
+		for _, part := range strings.Split(line, ",") {
+			name, params, _ := strings.Cut(part, ";")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			if name == "x-gzip" {
+				name = "gzip"
+			}
+			v := 1.0
+			for _, p := range strings.Split(params, ";") {
+				k, x, _ := strings.Cut(strings.TrimSpace(p), "=")
+				if strings.EqualFold(k, "q") {
+					if f, err := strconv.ParseFloat(x, 64); err == nil {
+						v = f
+					} else {
+						v = 0
+					}
+				}
+			}
+			q[name] = v
+		}
+	}
+	var best *codec
+	var bestQ float64
+	for _, e := range c.encodings {
+		x, ok := codecs[e]
+		if !ok {
+			continue
+		}
+		v, ok := q[x.name]
+		if !ok {
+			v = q["*"]
+		}
+		if v > bestQ {
+			best, bestQ = x, v
+		}
+	}
+	return best
+}
+
+func (c *compressor) allowed(contentType string) bool {
+	mt, _, _ := strings.Cut(contentType, ";")
+	mt = strings.ToLower(strings.TrimSpace(mt))
+	if mt == "" {
+		return false
+	}
+	for _, p := range c.types {
+		if ok, _ := path.Match(p, mt); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeRequest replaces the body of r encoded with one of the configured
+// encodings with the decoded body. It returns false when the body can't be
+// decoded. Reading more than maxDecoded bytes of the decoded body fails with
+// *http.MaxBytesError.
+func (c *compressor) decodeRequest(w http.ResponseWriter, r *http.Request) bool {
+	ce := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	if ce == "" || r.Body == nil || r.Body == http.NoBody {
+		return true
+	}
+	if ce == "x-gzip" {
+		ce = "gzip"
+	}
+	for _, e := range c.encodings {
+		x, ok := codecs[e]
+		if !ok || x.name != ce {
+			continue
+		}
+		d, done, err := x.decode(r.Body)
+		if err != nil {
+			r.Body.Close()
+			return false
+		}
+		r.Body = &decodedBody{
+			ReadCloser: http.MaxBytesReader(w, io.NopCloser(d), c.maxDecoded),
+			done:       done,
+			body:       r.Body,
+		}
+		r.Header.Del("Content-Encoding")
+		r.Header.Del("Content-Length")
+		r.ContentLength = -1
+		return true
+	}
+	return true
+}
+
+type decodedBody struct {
+	io.ReadCloser
+	done func()
+	body io.ReadCloser
+}
+
+func (d *decodedBody) Close() error {
+	d.done()
+	return d.body.Close()
+}
+
+// compressWriter holds back the response header until the first min size
+// bytes were written, the response was flushed or the handler returned, then
+// decides whether the response is compressed.
+type compressWriter struct {
+	http.ResponseWriter
+	c *compressor
+	// codec is the negotiated encoding, nil when the client accepts none.
+	codec *codec
+	// enc is set when the response is compressed.
+	enc encoder
+	// status is the status code written by the handler, 0 before the header
+	// was written.
+	status int
+	// started is true after the header was written to ResponseWriter.
+	started bool
+	buf     []byte
+	// size is the number of bytes written by the handler.
+	size int64
+}
+
+func (cw *compressWriter) WriteHeader(code int) {
+	if cw.status != 0 {
+		return
+	}
+	if code < 200 {
+		cw.ResponseWriter.WriteHeader(code)
+		return
+	}
+	cw.status = code
+	if !cw.eligible() {
+		cw.started = true
+		cw.ResponseWriter.WriteHeader(code)
+	}
+}
+
+// eligible returns false for responses that are never compressed.
+func (cw *compressWriter) eligible() bool {
+	h := cw.Header()
+	switch {
+	case cw.status == http.StatusNoContent,
+		cw.status == http.StatusNotModified,
+		cw.status == http.StatusPartialContent,
+		h.Get("Content-Range") != "",
+		h.Get("Content-Encoding") != "" && !strings.EqualFold(h.Get("Content-Encoding"), "identity"),
+		strings.Contains(strings.ToLower(strings.Join(h.Values("Cache-Control"), ",")), "no-transform"):
+		return false
+	}
+	if v := h.Get("Content-Length"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n < cw.c.minSize {
+			return false
+		}
+	}
+	if ct := h.Get("Content-Type"); ct != "" && !cw.c.allowed(ct) {
+		return false
+	}
+	return true
+}
+
+func (cw *compressWriter) Write(b []byte) (int, error) {
+	if cw.status == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	cw.size += int64(len(b))
+	if !cw.started {
+		cw.buf = append(cw.buf, b...)
+		if len(cw.buf) >= cw.c.minSize {
+			if err := cw.start(false); err != nil {
+				return 0, err
+			}
+		}
+		return len(b), nil
+	}
+	if cw.enc != nil {
+		if _, err := cw.enc.Write(b); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// start writes the header and the buffered body. Flushed responses are
+// compressed regardless of their size because more data is expected.
+func (cw *compressWriter) start(flush bool) error {
+	cw.started = true
+	h := cw.Header()
+	if h.Get("Content-Type") == "" && len(cw.buf) > 0 {
+		// the content type would be sniffed from the compressed body otherwise.
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+	if cw.c.allowed(h.Get("Content-Type")) {
+		addVary(h, "Accept-Encoding")
+		if cw.codec != nil && (flush || len(cw.buf) >= cw.c.minSize) {
+			h.Del("Content-Length")
+			h.Del("Accept-Ranges")
+			h.Set("Content-Encoding", cw.codec.name)
+			if etag := h.Get("Etag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				h.Set("Etag", "W/"+etag)
+			}
+			cw.enc = cw.codec.encoders.Get().(encoder)
+			cw.enc.Reset(cw.ResponseWriter)
+		}
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if cw.enc != nil {
+		_, err = cw.enc.Write(buf)
+	} else {
+		_, err = cw.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+func (cw *compressWriter) Flush() {
+	if cw.status == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if !cw.started {
+		if cw.start(true) != nil {
+			return
+		}
+	}
+	if cw.enc != nil {
+		if cw.enc.Flush() != nil {
+			return
+		}
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// finish writes the buffered response and the end of the compressed stream.
+func (cw *compressWriter) finish() {
+	if cw.status == 0 {
+		cw.WriteHeader(http.StatusOK)
+	}
+	if !cw.started {
+		cw.start(false)
+	}
+	if cw.enc != nil {
+		cw.enc.Close()
+		cw.enc.Reset(nil)
+		cw.codec.encoders.Put(cw.enc)
+	}
+}
+
+func addVary(h http.Header, name string) {
+	for _, v := range h.Values("Vary") {
+		for _, x := range strings.Split(v, ",") {
+			x = strings.TrimSpace(x)
+			if x == "*" || strings.EqualFold(x, name) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", name)
+}
@@ -0,0 +1,250 @@
+package xhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/meta"
+	"github.com/gernest/tt/pkg/reverse"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestNegotiate(t *testing.T) {
+	c := &compressor{encodings: defaultEncodings}
+	sample := []struct {
+		accept string
+		expect string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"x-gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"gzip;q=1.0, br;q=0.5", "gzip"},
+		{"br;q=0, zstd", "zstd"},
+		{"*", "br"},
+		{"br;q=0, *;q=0.5", "zstd"},
+		{"GZIP;Q=0.8, zstd;q=0.8", "zstd"},
+	}
+	for _, s := range sample {
+		var got string
+		if x := c.negotiate([]string{s.accept}); x != nil {
+			got = x.name
+		}
+		if got != s.expect {
+			t.Errorf("%q: expected %q got %q", s.accept, s.expect, got)
+		}
+	}
+}
+
+func serveCompressed(o *api.Middleware_Compress, r *http.Request, h http.HandlerFunc) (*httptest.ResponseRecorder, *api.AccessEntry) {
+	e := &api.AccessEntry{
+		Response:     &api.AccessEntry_Response{},
+		ReverseProxy: &api.AccessEntry_ReverseProxy{},
+	}
+	w := httptest.NewRecorder()
+	Compress(o)(h).ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), e)))
+	return w, e
+}
+
+func decode(t *testing.T, encoding string, b []byte) string {
+	t.Helper()
+	var r io.Reader
+	switch encoding {
+	case "gzip":
+		g, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = g
+	case "br":
+		r = brotli.NewReader(bytes.NewReader(b))
+	case "zstd":
+		d, err := zstd.NewReader(bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer d.Close()
+		r = d
+	default:
+		return string(b)
+	}
+	o, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(o)
+}
+
+func TestCompress(t *testing.T) {
+	body := strings.Repeat("hello world ", 200)
+	sample := []struct {
+		desc        string
+		accept      string
+		header      http.Header
+		body        string
+		encoding    string
+		contentType string
+	}{
+		{"gzip", "gzip", http.Header{"Content-Type": {"text/plain"}}, body, "gzip", "text/plain"},
+		{"brotli", "br, gzip", http.Header{"Content-Type": {"application/json; charset=utf-8"}}, body, "br", "application/json; charset=utf-8"},
+		{"zstd", "zstd", http.Header{"Content-Type": {"application/ld+json"}}, body, "zstd", "application/ld+json"},
+		{"sniffed", "gzip", http.Header{}, body, "gzip", "text/plain; charset=utf-8"},
+		{"small", "gzip", http.Header{"Content-Type": {"text/plain"}}, "hello", "", "text/plain"},
+		{"not accepted", "", http.Header{"Content-Type": {"text/plain"}}, body, "", "text/plain"},
+		{"content type", "gzip", http.Header{"Content-Type": {"image/png"}}, body, "", "image/png"},
+		{"encoded", "gzip", http.Header{"Content-Type": {"text/plain"}, "Content-Encoding": {"custom"}}, body, "custom", "text/plain"},
+		{"no-transform", "gzip", http.Header{"Content-Type": {"text/plain"}, "Cache-Control": {"no-transform"}}, body, "", "text/plain"},
+	}
+	for _, s := range sample {
+		t.Run(s.desc, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", s.accept)
+			w, e := serveCompressed(&api.Middleware_Compress{}, r, func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range s.header {
+					w.Header()[k] = v
+				}
+				w.Header().Set("Etag", `"v1"`)
+				n := len(s.body) / 2
+				w.Write([]byte(s.body[:n]))
+				w.Write([]byte(s.body[n:]))
+			})
+			if got := w.Header().Get("Content-Encoding"); got != s.encoding {
+				t.Fatalf("expected encoding %q got %q", s.encoding, got)
+			}
+			if got := w.Header().Get("Content-Type"); got != s.contentType {
+				t.Errorf("expected content type %q got %q", s.contentType, got)
+			}
+			if s.encoding == "custom" {
+				return
+			}
+			if got := decode(t, s.encoding, w.Body.Bytes()); got != s.body {
+				t.Errorf("expected body %q got %q", s.body, got)
+			}
+			if s.encoding == "" {
+				if e.Response.ContentEncoding != "" || w.Header().Get("Etag") != `"v1"` {
+					t.Errorf("expected uncompressed response got %v %q", e.Response, w.Header().Get("Etag"))
+				}
+				return
+			}
+			if w.Header().Get("Vary") != "Accept-Encoding" || w.Header().Get("Etag") != `W/"v1"` {
+				t.Errorf("unexpected header %v", w.Header())
+			}
+			if e.Response.ContentEncoding != s.encoding || e.Response.UncompressedSize != int64(len(s.body)) {
+				t.Errorf("unexpected access entry %v", e.Response)
+			}
+		})
+	}
+}
+
+func TestCompressContentLength(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	w, _ := serveCompressed(&api.Middleware_Compress{MinSize: 10}, r, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Length", "5")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("hello"))
+	})
+	if w.Header().Get("Content-Encoding") != "" || w.Body.String() != "hello" {
+		t.Errorf("expected small response to be sent as is got %v %q", w.Header(), w.Body)
+	}
+}
+
+func TestCompressFlush(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	Compress(&api.Middleware_Compress{})(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Write([]byte("data: 1\n\n"))
+		rw.(http.Flusher).Flush()
+		if !w.Flushed || w.Header().Get("Content-Encoding") != "gzip" {
+			t.Fatalf("expected compressed flush got %v", w.Header())
+		}
+		g, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := make([]byte, 9)
+		if _, err := io.ReadFull(g, b); err != nil || string(b) != "data: 1\n\n" {
+			t.Fatalf("expected flushed event got %q %v", b, err)
+		}
+		rw.Write([]byte("data: 2\n\n"))
+	})).ServeHTTP(w, r.WithContext(meta.SetMetric(r.Context(), &api.AccessEntry{})))
+	if got := decode(t, "gzip", w.Body.Bytes()); got != "data: 1\n\ndata: 2\n\n" {
+		t.Errorf("unexpected body %q", got)
+	}
+}
+
+func TestCompressDecompressRequests(t *testing.T) {
+	var b bytes.Buffer
+	g := gzip.NewWriter(&b)
+	g.Write([]byte("hello"))
+	g.Close()
+	o := &api.Middleware_Compress{DecompressRequests: true}
+	h := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "" || r.ContentLength != -1 {
+			t.Errorf("expected decoded request got %v %d", r.Header, r.ContentLength)
+		}
+		io.Copy(w, r.Body)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(b.Bytes()))
+	r.Header.Set("Content-Encoding", "gzip")
+	if w, _ := serveCompressed(o, r, h); w.Body.String() != "hello" {
+		t.Errorf("expected decoded body got %q", w.Body)
+	}
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("hello"))
+	r.Header.Set("Content-Encoding", "gzip")
+	if w, _ := serveCompressed(o, r, h); w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 got %d", w.Code)
+	}
+}
+
+func TestCompressMaxDecodedSize(t *testing.T) {
+	back := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// http/1 handlers can't read the body after writing the response.
+		b, _ := io.ReadAll(r.Body)
+		w.Write(b)
+	}))
+	defer back.Close()
+	route := &api.Route{
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.URL}},
+		},
+	}
+	ba, err := balance.FromRoute(route)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := reverse.New(route, ba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a small body decoding to a large one.
+	var b bytes.Buffer
+	g := gzip.NewWriter(&b)
+	g.Write(make([]byte, 2048))
+	g.Close()
+	request := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(b.Bytes()))
+		r.Header.Set("Content-Encoding", "gzip")
+		return r
+	}
+	o := &api.Middleware_Compress{DecompressRequests: true, MaxDecodedSize: 1024}
+	if w, _ := serveCompressed(o, request(), h.ServeHTTP); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413 got %d", w.Code)
+	}
+	o.MaxDecodedSize = 2048
+	if w, _ := serveCompressed(o, request(), h.ServeHTTP); w.Code != http.StatusOK || w.Body.Len() != 2048 {
+		t.Errorf("expected 200 with decoded body got %d %d", w.Code, w.Body.Len())
+	}
+}
@@ -10,13 +10,16 @@ import (
 
 	"github.com/gernest/tt/api"
 	accesslog "github.com/gernest/tt/pkg/access_log"
+	"github.com/gernest/tt/pkg/headers"
 	"github.com/gernest/tt/pkg/hrf"
 	"github.com/gernest/tt/pkg/meta"
 	"github.com/gernest/tt/pkg/reverse"
+	"github.com/gernest/tt/pkg/rewrite"
 	handlerv1 "github.com/gernest/tt/wasm/v1/handler"
 	handlerv2 "github.com/gernest/tt/wasm/v2/handler"
 	"github.com/gorilla/mux"
 	"github.com/justinas/alice"
+	"go.uber.org/zap"
 )
 
 func (p *Proxy) Handler(routes []*api.Route) (*mux.Router, error) {
@@ -30,8 +33,32 @@ func (p *Proxy) Handler(routes []*api.Route) (*mux.Router, error) {
 			h = &HHeath{
 				fn: p.Health,
 			}
+		} else if route.Protocol == api.Protocol_WEBSOCKET {
+			ba, err := p.balance(route)
+			if err != nil {
+				return nil, err
+			}
+			ws, err := reverse.NewWebSocket(route, ba)
+			if err != nil {
+				return nil, err
+			}
+			h = ws
+		} else if route.Grpc != nil {
+			ba, err := p.balance(route)
+			if err != nil {
+				return nil, err
+			}
+			g, err := reverse.NewGRPC(route, ba)
+			if err != nil {
+				return nil, err
+			}
+			h = g
 		} else if len(route.LoadBalance) > 0 {
-			rh, err := reverse.New(route)
+			ba, err := p.balance(route)
+			if err != nil {
+				return nil, err
+			}
+			rh, err := reverse.New(route, ba)
 			if err != nil {
 				return nil, err
 			}
@@ -262,17 +289,62 @@ func StripPathPrefix(mw *api.Middleware_StripPathPrefix) alice.Constructor {
 
 func (p *Proxy) buildMiddlewares(r *api.Route) (mw []alice.Constructor) {
 	for _, w := range r.GetMiddlewares().GetList() {
-		if h := p.ware(w); h != nil {
+		if h := p.ware(r, w); h != nil {
 			mw = append(mw, h)
 		}
 	}
 	return
 }
 
-func (p *Proxy) ware(mw *api.Middleware) alice.Constructor {
+func (p *Proxy) ware(r *api.Route, mw *api.Middleware) alice.Constructor {
 	if strip := mw.GetStripPathPrefix(); strip != nil {
 		return StripPathPrefix(strip)
 	}
+	if h := mw.GetHeaders(); h != nil {
+		c, err := headers.New(r, h)
+		if err != nil {
+			p.zlg.Error("Failed to build headers middleware",
+				zap.String("route", r.Name),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return c
+	}
+	if rw := mw.GetRewrite(); rw != nil {
+		c, err := rewrite.New(r, rw)
+		if err != nil {
+			p.zlg.Error("Failed to build rewrite middleware",
+				zap.String("route", r.Name),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return c
+	}
+	if rd := mw.GetRedirect(); rd != nil {
+		c, err := rewrite.NewRedirect(r, rd)
+		if err != nil {
+			p.zlg.Error("Failed to build redirect middleware",
+				zap.String("route", r.Name),
+				zap.Error(err),
+			)
+			return nil
+		}
+		return c
+	}
+	if c := mw.GetCompress(); c != nil {
+		return Compress(c)
+	}
+	if c := mw.GetCache(); c != nil {
+		if p.cache == nil {
+			p.zlg.Info("Caching responses requires the cache, skipping middleware",
+				zap.String("route", r.Name),
+			)
+			return nil
+		}
+		return p.cache.Handler(r.Name, c)
+	}
 	if ws := mw.GetWasm(); ws != nil {
 		switch ws.Version {
 		case api.Middleware_V1:
@@ -0,0 +1,108 @@
+package xhttp
+
+import (
+	"context"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/hrf"
+)
+
+// healthChecks are health checked balancers of routes of a build. Active checks
+// and resolution of discovered targets run until the next build.
+type healthChecks struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	routes map[string]*balance.Checked
+	// prev are checks of the previous build while building, health of targets
+	// of routes that are still configured is carried over.
+	prev *healthChecks
+}
+
+func newHealthChecks(ctx context.Context, prev *healthChecks) *healthChecks {
+	ctx, cancel := context.WithCancel(ctx)
+	return &healthChecks{
+		ctx:    ctx,
+		cancel: cancel,
+		routes: make(map[string]*balance.Checked),
+		prev:   prev,
+	}
+}
+
+func (h *healthChecks) add(route string, c *balance.Checked) {
+	if h.prev != nil {
+		if old, ok := h.prev.routes[route]; ok {
+			c.Inherit(old)
+		}
+	}
+	h.routes[route] = c
+	h.run(c)
+}
+
+func (h *healthChecks) run(r interface{ Run(context.Context) }) {
+	go r.Run(h.ctx)
+}
+
+func (h *healthChecks) close() {
+	if h != nil {
+		h.cancel()
+	}
+}
+
+// report returns checks of load_balance targets keyed by "route:upstream". The
+// status is warn when requests of a route are sent to unhealthy targets because
+// none is healthy.
+func (h *healthChecks) report() (hrf.Status, map[string][]hrf.Check) {
+	status := hrf.Pass
+	if h == nil || len(h.routes) == 0 {
+		return status, nil
+	}
+	checks := make(map[string][]hrf.Check)
+	for name, c := range h.routes {
+		var up int
+		var o []hrf.Check
+		for _, t := range c.Status() {
+			x := hrf.Check{
+				ComponentID:   t.URL.String(),
+				ComponentType: "component",
+				Status:        hrf.Pass,
+			}
+			switch {
+			case !t.Healthy:
+				x.Status = hrf.Fail
+				x.Output = "failing health checks"
+			case t.Ejected:
+				x.Status = hrf.Fail
+				x.Output = "ejected for failing requests"
+			default:
+				up++
+			}
+			o = append(o, x)
+		}
+		if up == 0 {
+			status = hrf.Warn
+		}
+		checks[name+":upstream"] = o
+	}
+	return status, checks
+}
+
+// balance returns the balancer of the load_balance targets of route. Health
+// checks and resolution of discovered targets of route are run until the next
+// build.
+func (p *Proxy) balance(route *api.Route) (balance.Balance, error) {
+	ba, err := balance.FromRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	if p.checks == nil {
+		return ba, nil
+	}
+	switch e := ba.(type) {
+	case *balance.Checked:
+		p.checks.add(route.Name, e)
+	case interface{ Run(context.Context) }:
+		p.checks.run(e)
+	}
+	return ba, nil
+}
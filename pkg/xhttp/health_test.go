@@ -0,0 +1,72 @@
+package xhttp
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/balance"
+	"github.com/gernest/tt/pkg/hrf"
+)
+
+func checkedApp(t *testing.T) *balance.Checked {
+	t.Helper()
+	c, err := balance.NewChecked(&api.Route{
+		Name: "app",
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: "http://127.0.0.1:9000"}},
+		},
+		HealthCheck: &api.HealthCheck{
+			Passive: &api.HealthCheck_Passive{ConsecutiveFailures: 1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestHealthChecksReport(t *testing.T) {
+	c := checkedApp(t)
+	h := newHealthChecks(context.Background(), nil)
+	defer h.close()
+	h.add("app", c)
+	if status, checks := h.report(); status != hrf.Pass || checks["app:upstream"][0].Status != hrf.Pass {
+		t.Fatalf("expected pass got %v %v", status, checks)
+	}
+	c.Report(&url.URL{Host: "127.0.0.1:9000"}, false)
+	status, checks := h.report()
+	if status != hrf.Warn {
+		t.Errorf("expected warn got %v", status)
+	}
+	x := checks["app:upstream"]
+	if len(x) != 1 || x[0].Status != hrf.Fail || x[0].ComponentID != "http://127.0.0.1:9000" {
+		t.Errorf("unexpected checks %+v", x)
+	}
+}
+
+func TestHealthChecksCarryOver(t *testing.T) {
+	old := newHealthChecks(context.Background(), nil)
+	defer old.close()
+	c := checkedApp(t)
+	old.add("app", c)
+	c.Report(&url.URL{Host: "127.0.0.1:9000"}, false)
+
+	// the next build keeps the ejected target out of rotation.
+	h := newHealthChecks(context.Background(), old)
+	defer h.close()
+	h.add("app", checkedApp(t))
+	status, checks := h.report()
+	if status != hrf.Warn || checks["app:upstream"][0].Status != hrf.Fail {
+		t.Errorf("expected health to be carried over got %v %+v", status, checks)
+	}
+
+	// routes that are not in the previous build start healthy.
+	h = newHealthChecks(context.Background(), nil)
+	defer h.close()
+	h.add("app", checkedApp(t))
+	if status, _ := h.report(); status != hrf.Pass {
+		t.Errorf("expected pass got %v", status)
+	}
+}
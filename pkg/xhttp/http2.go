@@ -0,0 +1,46 @@
+package xhttp
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/gernest/tt/api"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// http2Server returns the HTTP/2 server for listeners configured with o.
+func http2Server(o *api.HTTPOptions) *http2.Server {
+	s := &http2.Server{}
+	x := o.GetHttp2()
+	if x == nil {
+		return s
+	}
+	s.MaxConcurrentStreams = x.MaxConcurrentStreams
+	s.MaxUploadBufferPerStream = x.InitialStreamWindowSize
+	s.MaxUploadBufferPerConnection = x.InitialConnectionWindowSize
+	s.MaxReadFrameSize = x.MaxReadFrameSize
+	if x.IdleTimeout != nil {
+		s.IdleTimeout, _ = ptypes.Duration(x.IdleTimeout)
+	}
+	return s
+}
+
+// configureHTTP2 enables HTTP/2 on svr. HTTP/2 over TLS is negotiated with ALPN
+// and h2c is served when enabled by o.
+func configureHTTP2(svr *http.Server, o *api.HTTPOptions) error {
+	if o.GetDisableHttp2() {
+		// a non nil map disables HTTP/2 over TLS
+		svr.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+		return nil
+	}
+	h2 := http2Server(o)
+	if err := http2.ConfigureServer(svr, h2); err != nil {
+		return err
+	}
+	if o.GetH2C() {
+		svr.Handler = h2c.NewHandler(svr.Handler, h2)
+	}
+	return nil
+}
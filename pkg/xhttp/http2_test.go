@@ -0,0 +1,162 @@
+package xhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/gernest/tt/pkg/proxy"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func testProxy(t *testing.T, routes ...*api.Route) (string, func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	const hostPort = "127.0.0.1:0"
+	for _, r := range routes {
+		if r.Bind == nil {
+			r.Bind = &api.Bind{}
+		}
+		r.Bind.To = &api.Bind_HostPort{HostPort: hostPort}
+	}
+	p := &Proxy{ctx: ctx}
+	err := p.Boot(ctx, &proxy.Options{
+		Routes: api.Config{Routes: routes},
+	})
+	if err != nil {
+		cancel()
+		t.Fatal(err)
+	}
+	return p.context[hostPort].Listener.Addr().String(), func() {
+		p.Close()
+		cancel()
+	}
+}
+
+// protoHandler writes the protocol of requests.
+var protoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(r.Proto))
+})
+
+func h2cClient() *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		},
+	}
+}
+
+func get(t *testing.T, c *http.Client, url string) (*http.Response, string) {
+	t.Helper()
+	res, err := c.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, string(b)
+}
+
+func TestH2C(t *testing.T) {
+	back := httptest.NewServer(h2c.NewHandler(protoHandler, &http2.Server{}))
+	defer back.Close()
+
+	addr, stop := testProxy(t, &api.Route{
+		Name:     "h2c",
+		Protocol: api.Protocol_HTTP,
+		Bind: &api.Bind{
+			Http: &api.HTTPOptions{
+				H2C: true,
+				Http2: &api.HTTP2Settings{
+					MaxConcurrentStreams: 10,
+				},
+			},
+		},
+		Rule: &api.Rule{
+			Match: &api.Rule_Http{
+				Http: &api.Rule_HTTP{
+					Match: &api.Rule_HTTP_Path_{
+						Path: &api.Rule_HTTP_Path{
+							Type:  api.Rule_HTTP_Path_Prefix,
+							Value: "/",
+						},
+					},
+				},
+			},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.URL}},
+		},
+		HttpUpstream: &api.HTTPUpstream{Protocol: api.HTTPUpstream_H2C},
+	})
+	defer stop()
+
+	t.Run("prior knowledge", func(t *testing.T) {
+		res, body := get(t, h2cClient(), "http://"+addr+"/")
+		if res.Proto != "HTTP/2.0" {
+			t.Errorf("expected HTTP/2.0 downstream got %s", res.Proto)
+		}
+		if body != "HTTP/2.0" {
+			t.Errorf("expected HTTP/2.0 upstream got %s", body)
+		}
+	})
+	t.Run("http1", func(t *testing.T) {
+		res, body := get(t, http.DefaultClient, "http://"+addr+"/")
+		if res.Proto != "HTTP/1.1" {
+			t.Errorf("expected HTTP/1.1 downstream got %s", res.Proto)
+		}
+		if body != "HTTP/2.0" {
+			t.Errorf("expected HTTP/2.0 upstream got %s", body)
+		}
+	})
+}
+
+func TestHTTP1Upstream(t *testing.T) {
+	back := httptest.NewServer(h2c.NewHandler(protoHandler, &http2.Server{}))
+	defer back.Close()
+
+	addr, stop := testProxy(t, &api.Route{
+		Name:     "http1",
+		Protocol: api.Protocol_HTTP,
+		Bind: &api.Bind{
+			Http: &api.HTTPOptions{H2C: true},
+		},
+		Rule: &api.Rule{
+			Match: &api.Rule_Http{
+				Http: &api.Rule_HTTP{
+					Match: &api.Rule_HTTP_Path_{
+						Path: &api.Rule_HTTP_Path{
+							Type:  api.Rule_HTTP_Path_Prefix,
+							Value: "/",
+						},
+					},
+				},
+			},
+		},
+		LoadBalance: []*api.WeightedAddr{
+			{Addr: &api.Address{Address: back.URL}},
+		},
+		HttpUpstream: &api.HTTPUpstream{Protocol: api.HTTPUpstream_HTTP1},
+	})
+	defer stop()
+
+	res, body := get(t, h2cClient(), "http://"+addr+"/")
+	if res.Proto != "HTTP/2.0" {
+		t.Errorf("expected HTTP/2.0 downstream got %s", res.Proto)
+	}
+	if body != "HTTP/1.1" {
+		t.Errorf("expected HTTP/1.1 upstream got %s", body)
+	}
+}
@@ -0,0 +1,203 @@
+package xhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gernest/tt/api"
+	accesslog "github.com/gernest/tt/pkg/access_log"
+	"github.com/gernest/tt/pkg/proxy"
+	"github.com/gernest/tt/pkg/zlg"
+	"github.com/gorilla/mux"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"go.uber.org/zap"
+)
+
+// altSvcMaxAge is how long clients remember HTTP/3 listeners advertised by TLS
+// listeners.
+const altSvcMaxAge = 24 * time.Hour
+
+// QUICContext is a listener serving HTTP/3 over QUIC.
+type QUICContext struct {
+	Cancel      context.CancelFunc
+	HandlerChan chan http.Handler
+	Conn        net.PacketConn
+	Server      *http3.Server
+	certs       *certStore
+	requests    inflight
+}
+
+// buildQUIC serves routes grouped by host:port over QUIC.
+func (p *Proxy) buildQUIC(ctx context.Context, m map[string][]*api.Route) error {
+	h := make(map[string]*mux.Router)
+	for k, v := range m {
+		x, err := p.Handler(v)
+		if err != nil {
+			return err
+		}
+		h[k] = x
+	}
+	if err := p.listenQUIC(m); err != nil {
+		return err
+	}
+	var ports []int
+	for k, v := range h {
+		ls := p.quic[k]
+		ls.certs.set(certEntries(m[k]))
+		if err := ls.certs.load(p.opts.Store); err != nil {
+			return err
+		}
+		p.watchCertFiles(ls.certs)
+		ports = append(ports, ls.Conn.LocalAddr().(*net.UDPAddr).Port)
+		if ls.Cancel != nil {
+			ls.HandlerChan <- v
+			continue
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		ls.Cancel = cancel
+		p.serveQUIC(ctx, ls, v)
+	}
+	p.altSvc.Store(altSvc(ports))
+	return nil
+}
+
+func (p *Proxy) serveQUIC(ctx context.Context, ls *QUICContext, base *mux.Router) {
+	p.zlg.Info("Starting http3 server", zap.String("addr", ls.Conn.LocalAddr().String()))
+	svr := &http3.Server{
+		TLSConfig: http3.ConfigureTLSConfig(&tls.Config{
+			GetCertificate: ls.certs.GetCertificate,
+		}),
+		Handler: ls.track(NewDynamic(ctx, ls.HandlerChan, base)),
+		ConnContext: func(ctx context.Context, _ quic.Connection) context.Context {
+			ctx = zlg.Set(ctx, p.zlg)
+			return accesslog.Set(ctx, p.accessLogger)
+		},
+	}
+	ls.Server = svr
+	go svr.Serve(ls.Conn)
+}
+
+func (p *Proxy) listenQUIC(ls map[string][]*api.Route) error {
+	for l := range ls {
+		if _, ok := p.quic[l]; ok {
+			continue
+		}
+		if network, _ := proxy.SplitNetwork(l); network != "tcp" {
+			return fmt.Errorf("xhttp: can not serve quic on %s", l)
+		}
+		var b *api.Bind
+		for _, r := range ls[l] {
+			if r.Bind != nil {
+				b = r.Bind
+			}
+		}
+		conn, err := proxy.ListenPacketBind(l, b)
+		if err != nil {
+			return err
+		}
+		p.quic[l] = &QUICContext{
+			HandlerChan: make(chan http.Handler),
+			Conn:        conn,
+			certs:       &certStore{},
+		}
+	}
+	for k, v := range p.quic {
+		if _, ok := ls[k]; !ok {
+			v.close()
+			delete(p.quic, k)
+		}
+	}
+	return nil
+}
+
+// track counts requests served by next so that shutdown can wait for them.
+func (q *QUICContext) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q.requests.add(1)
+		defer q.requests.add(-1)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// shutdown waits for active requests to complete before closing the listener.
+// Requests that are still active when ctx is done are aborted.
+func (q *QUICContext) shutdown(ctx context.Context) error {
+	defer q.close()
+	select {
+	case <-q.requests.idle():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *QUICContext) close() {
+	if q.Cancel != nil {
+		q.Cancel()
+	}
+	if q.Server != nil {
+		q.Server.Close()
+	}
+	q.Conn.Close()
+}
+
+// inflight counts active requests.
+type inflight struct {
+	mu   sync.Mutex
+	n    int
+	done chan struct{}
+}
+
+func (f *inflight) add(delta int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n += delta
+	if f.n == 0 && f.done != nil {
+		close(f.done)
+		f.done = nil
+	}
+}
+
+// idle returns a channel that is closed when there are no active requests.
+func (f *inflight) idle() <-chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.n == 0 {
+		c := make(chan struct{})
+		close(c)
+		return c
+	}
+	if f.done == nil {
+		f.done = make(chan struct{})
+	}
+	return f.done
+}
+
+// altSvc returns the Alt-Svc header value announcing HTTP/3 on ports.
+func altSvc(ports []int) string {
+	sort.Ints(ports)
+	s := make([]string, len(ports))
+	for i, port := range ports {
+		s[i] = fmt.Sprintf(`h3=":%d"; ma=%d`, port, int(altSvcMaxAge.Seconds()))
+	}
+	return strings.Join(s, ", ")
+}
+
+// advertiseQUIC sets the Alt-Svc header of responses to announce HTTP/3
+// listeners.
+func (p *Proxy) advertiseQUIC(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v, _ := p.altSvc.Load().(string); v != "" {
+			w.Header().Set("Alt-Svc", v)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,85 @@
+package xhttp
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/quic-go/quic-go/http3"
+)
+
+func TestHTTP3(t *testing.T) {
+	back := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer back.Close()
+
+	dir := t.TempDir()
+	cert := writeCert(t, dir, "h3.pem", testCert(t, ecdsaKey(t), "h3.example.com"))
+	route := func(name string, protocol api.Protocol) *api.Route {
+		return &api.Route{
+			Name:     name,
+			Protocol: protocol,
+			Bind: &api.Bind{
+				Tls: &api.TLS{Certificates: []*api.TLS_Certificate{cert}},
+			},
+			Rule: &api.Rule{
+				Match: &api.Rule_Http{
+					Http: &api.Rule_HTTP{
+						Match: &api.Rule_HTTP_Path_{
+							Path: &api.Rule_HTTP_Path{
+								Type:  api.Rule_HTTP_Path_Prefix,
+								Value: "/",
+							},
+						},
+					},
+				},
+			},
+			Middlewares: &api.Middleware_List{
+				List: []*api.Middleware{
+					{
+						Match: &api.Middleware_StripPathPrefix_{
+							StripPathPrefix: &api.Middleware_StripPathPrefix{Prefix: "/api"},
+						},
+					},
+				},
+			},
+			LoadBalance: []*api.WeightedAddr{
+				{Addr: &api.Address{Address: back.URL}},
+			},
+		}
+	}
+	addr, stop := testProxy(t,
+		route("tls", api.Protocol_HTTP),
+		route("h3", api.Protocol_QUIC),
+	)
+	defer stop()
+	tlsConfig := &tls.Config{
+		ServerName:         "h3.example.com",
+		InsecureSkipVerify: true,
+	}
+
+	res, _ := get(t, &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, "https://"+addr+"/api/hello")
+	alt := res.Header.Get("Alt-Svc")
+	var port int
+	if _, err := fmt.Sscanf(alt, `h3=":%d"`, &port); err != nil {
+		t.Fatalf("expected h3 Alt-Svc got %q", alt)
+	}
+
+	rt := &http3.RoundTripper{TLSClientConfig: tlsConfig}
+	defer rt.Close()
+	host := addr[:strings.LastIndex(addr, ":")]
+	res, body := get(t, &http.Client{Transport: rt}, fmt.Sprintf("https://%s:%d/api/hello", host, port))
+	if res.Proto != "HTTP/3.0" {
+		t.Errorf("expected HTTP/3.0 got %s", res.Proto)
+	}
+	if body != "/hello" {
+		t.Errorf("expected /hello got %q", body)
+	}
+}
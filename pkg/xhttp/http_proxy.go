@@ -2,14 +2,20 @@ package xhttp
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/gernest/tt/api"
 	accesslog "github.com/gernest/tt/pkg/access_log"
 	"github.com/gernest/tt/pkg/hrf"
+	"github.com/gernest/tt/pkg/httpcache"
 	"github.com/gernest/tt/pkg/proxy"
+	tcpProxy "github.com/gernest/tt/pkg/tcp/proxy"
 	"github.com/gernest/tt/pkg/zlg"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
@@ -22,6 +28,9 @@ type ListenContext struct {
 	Cancel      context.CancelFunc
 	HandlerChan chan http.Handler
 	Listener    net.Listener
+	Server      *http.Server
+	// certs are certificates of listeners serving TLS.
+	certs *certStore
 }
 
 type Proxy struct {
@@ -29,9 +38,17 @@ type Proxy struct {
 	config       *api.Config
 	mu           sync.Mutex
 	context      map[string]*ListenContext
+	quic         map[string]*QUICContext
 	ctx          context.Context
 	accessLogger *accesslog.Access
 	zlg          *zap.Logger
+	certWatch    *fsnotify.Watcher
+	// altSvc is the Alt-Svc header value sent by TLS listeners.
+	altSvc atomic.Value
+	checks *healthChecks
+	// cache stores responses of routes with a cache middleware. It is nil when
+	// the cache is disabled.
+	cache *httpcache.Store
 }
 
 func (p *Proxy) configure(x *api.Config) error {
@@ -63,14 +80,14 @@ func clone(a *api.Config) *api.Config {
 
 func (p *Proxy) Post(ctx context.Context, config *api.Config) error {
 	old := clone(p.config)
-	m := make(map[string]*api.Route)
+	m := make(map[string]int)
 	for i := 0; i < len(old.Routes); i++ {
-		m[old.Routes[i].Name] = old.Routes[i]
+		m[old.Routes[i].Name] = i
 	}
 	for _, n := range config.Routes {
-		if r, ok := m[n.Name]; ok {
+		if i, ok := m[n.Name]; ok {
 			// Update existing route by replacing the old one with the new route.
-			*r = *n
+			old.Routes[i] = n
 		} else {
 			old.Routes = append(old.Routes, n)
 		}
@@ -104,37 +121,76 @@ func (p *Proxy) Boot(ctx context.Context, config *proxy.Options) error {
 	p.zlg = zlg.Logger.Named("http")
 	p.opts = config
 	p.context = make(map[string]*ListenContext)
+	p.quic = make(map[string]*QUICContext)
 	p.config = &config.Routes
-	return p.build(ctx)
+	if c := proxy.GetCache(ctx); c != nil {
+		p.cache = httpcache.New(c)
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		p.zlg.Error("Failed to watch certificates, certificate files are not reloaded", zap.Error(err))
+	} else {
+		p.certWatch = w
+	}
+	if err := p.build(ctx); err != nil {
+		return err
+	}
+	go p.watchCerts(ctx)
+	return nil
 }
 
-func (p *Proxy) build(ctx context.Context) error {
+func (p *Proxy) build(ctx context.Context) (err error) {
 	p.zlg.Info("Building routes")
+	old := p.checks
+	p.checks = newHealthChecks(ctx, old)
+	defer func() {
+		if err != nil {
+			// the old routes are still served, keep checking their targets.
+			p.checks.close()
+			p.checks = old
+			return
+		}
+		p.checks.prev = nil
+		old.close()
+	}()
 	// group routes by host:port
 	m := map[string][]*api.Route{}
+	quic := map[string][]*api.Route{}
+	binds := map[string]*api.Bind{}
 	for _, r := range p.config.Routes {
-		// only pick http services
-		if r.Protocol != api.Protocol_HTTP {
+		h := proxy.BindToHostPort(r.Bind, p.opts.Listen.HTTP.HostPort)
+		if r.Protocol == api.Protocol_QUIC {
+			quic[h] = append(quic[h], r)
+			continue
+		}
+		// only pick http and websocket services
+		if r.Protocol != api.Protocol_HTTP && r.Protocol != api.Protocol_WEBSOCKET {
 			continue
 		}
-		h := proxy.BindToHostPort(r.Bind, p.opts.Listen.HTTP.HostPort)
 		m[h] = append(m[h], r)
+		if r.Bind != nil {
+			binds[h] = r.Bind
+		}
 	}
 	h := make(map[string]*mux.Router)
-	var newListeners []string
+	newListeners := make(map[string]*api.Bind)
 	for k, v := range m {
 		x, err := p.Handler(v)
 		if err != nil {
 			return err
 		}
 		h[k] = x
-		newListeners = append(newListeners, k)
+		newListeners[k] = binds[k]
 	}
 
 	p.zlg.Info("Starting listenrets")
-	if err := p.listen(newListeners...); err != nil {
+	if err := p.listen(newListeners); err != nil {
 		return err
 	}
+	if p.accessLogger == nil {
+		// use the access log shared with the TCP proxy when there is one.
+		p.accessLogger, _ = accesslog.Lookup(ctx)
+	}
 	if p.accessLogger == nil {
 		// we only need one instance of this. No need to create a new one upon
 		// reloading of routes
@@ -146,6 +202,15 @@ func (p *Proxy) build(ctx context.Context) error {
 		)
 		go p.accessLogger.Run(ctx)
 	}
+	if err := p.buildQUIC(ctx, quic); err != nil {
+		return err
+	}
+
+	for k := range h {
+		if err := p.configureTLS(p.context[k], certEntries(m[k])); err != nil {
+			return err
+		}
+	}
 
 	// register handlers
 	for k, v := range h {
@@ -157,12 +222,35 @@ func (p *Proxy) build(ctx context.Context) error {
 		}
 		ctx, cancel := context.WithCancel(ctx)
 		ls.Cancel = cancel
-		p.bindServer(ctx, ls, v)
+		p.bindServer(ctx, ls, v, newListeners[k])
+	}
+	return nil
+}
+
+// configureTLS sets certificates of ls. TLS can only be enabled on listeners
+// that are not serving yet.
+func (p *Proxy) configureTLS(ls *ListenContext, e []certEntry) error {
+	if ls.certs == nil {
+		if len(e) == 0 {
+			return nil
+		}
+		if ls.Server != nil {
+			p.zlg.Warn("Listener is already serving cleartext, ignoring certificates",
+				zap.String("addr", ls.Listener.Addr().String()),
+			)
+			return nil
+		}
+		ls.certs = &certStore{}
+	}
+	ls.certs.set(e)
+	if err := ls.certs.load(p.opts.Store); err != nil {
+		return err
 	}
+	p.watchCertFiles(ls.certs)
 	return nil
 }
 
-func (p *Proxy) bindServer(ctx context.Context, ln *ListenContext, base *mux.Router) {
+func (p *Proxy) bindServer(ctx context.Context, ln *ListenContext, base *mux.Router, b *api.Bind) {
 	p.zlg.Info("Starting  server", zap.String("addr", ln.Listener.Addr().String()))
 	base.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
 		path, _ := route.GetPathTemplate()
@@ -175,24 +263,48 @@ func (p *Proxy) bindServer(ctx context.Context, ln *ListenContext, base *mux.Rou
 	ctx = accesslog.Set(ctx, p.accessLogger)
 	svr := &http.Server{
 		BaseContext: func(l net.Listener) context.Context { return ctx },
+		ConnContext: tcpProxy.ConnContext,
 		Handler:     NewDynamic(ctx, ln.HandlerChan, base),
 	}
-	go func() {
-		svr.Serve(ln.Listener)
-	}()
+	if ln.certs != nil {
+		svr.TLSConfig = &tls.Config{
+			GetCertificate: ln.certs.GetCertificate,
+		}
+		svr.Handler = p.advertiseQUIC(svr.Handler)
+	}
+	if err := configureHTTP2(svr, b.GetHttp()); err != nil {
+		p.zlg.Error("Failed to configure http2", zap.Error(err))
+	}
+	ln.Server = svr
+	// binds with acceptors are served by an accept loop for each socket.
+	for _, s := range proxy.Sockets(ln.Listener) {
+		go func(s net.Listener) {
+			if ln.certs != nil {
+				svr.ServeTLS(s, "", "")
+				return
+			}
+			svr.Serve(s)
+		}(s)
+	}
 }
 
-func (p *Proxy) listen(ls ...string) error {
+func (p *Proxy) listen(ls map[string]*api.Bind) error {
 	m := make(map[string]*ListenContext)
-	for _, l := range ls {
+	for l, b := range ls {
 		if _, ok := p.context[l]; ok {
 			// if we already had a listener we don't want to bind multiple listeners on
 			// the sape port
 			continue
 		}
-		ln, err := net.Listen("tcp", l)
-		if err != nil {
-			return err
+		var ln net.Listener
+		if network, name := proxy.SplitNetwork(l); network == "internal" {
+			ln = tcpProxy.ListenInternal(name)
+		} else {
+			var err error
+			ln, err = proxy.ListenBind(proxy.ListenFunc(b.GetOptions()), l, b)
+			if err != nil {
+				return err
+			}
 		}
 		m[l] = &ListenContext{
 			HandlerChan: make(chan http.Handler),
@@ -204,7 +316,7 @@ func (p *Proxy) listen(ls ...string) error {
 		p.context[k] = v
 	}
 	for k, v := range p.context {
-		if _, ok := m[k]; !ok {
+		if _, ok := ls[k]; !ok {
 			// This listener was removed we need to properly cear everything associated
 			// with this listener.
 			if v.Cancel != nil {
@@ -230,16 +342,71 @@ func (p *Proxy) Close() error {
 		v.Listener.Close()
 		delete(p.context, k)
 	}
+	for k, v := range p.quic {
+		v.close()
+		delete(p.quic, k)
+	}
+	p.checks.close()
+	if p.certWatch != nil {
+		p.certWatch.Close()
+	}
 	return nil
 }
 
+// Purge removes cached responses of routes.
+func (p *Proxy) Purge(ctx context.Context, req *api.PurgeRequest) error {
+	if p.cache == nil {
+		return errors.New("xhttp: the cache is disabled")
+	}
+	return p.cache.Purge(req.Routes, req.Urls)
+}
+
+// Shutdown gracefully shuts down all http servers, waiting for active
+// requests to complete.
+func (p *Proxy) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var wg sync.WaitGroup
+	errs := make(chan error, len(p.context)+len(p.quic))
+	for _, v := range p.context {
+		if v.Server == nil {
+			v.Listener.Close()
+			continue
+		}
+		wg.Add(1)
+		go func(svr *http.Server) {
+			defer wg.Done()
+			if err := svr.Shutdown(ctx); err != nil {
+				errs <- err
+				svr.Close()
+			}
+		}(v.Server)
+	}
+	for _, v := range p.quic {
+		// http3 servers have no graceful shutdown yet, wait for active requests
+		// before closing them.
+		wg.Add(1)
+		go func(q *QUICContext) {
+			defer wg.Done()
+			if err := q.shutdown(ctx); err != nil {
+				errs <- err
+			}
+		}(v)
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
 func (p *Proxy) Health() hrf.Health {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	status, checks := p.checks.report()
 	return hrf.Health{
-		Status:    hrf.Pass,
+		Status:    status,
 		Version:   p.opts.Info.Version,
 		ReleaseID: p.opts.Info.ReleaseID,
 		ServiceID: p.opts.Info.ID,
+		Checks:    checks,
 	}
 }
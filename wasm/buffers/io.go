@@ -9,7 +9,7 @@ import (
 )
 
 var pool = &sync.Pool{
-	New: func() interface{} { return new(IO) },
+	New: func() interface{} { return New() },
 }
 
 var _ common.IoBuffer = (*IO)(nil)
@@ -28,7 +28,7 @@ func New() *IO {
 }
 
 func (h *IO) Bytes() []byte {
-	return h.Bytes()
+	return h.buf.Bytes()
 }
 
 func (h *IO) Write(p []byte) (int, error) {
@@ -42,7 +42,9 @@ func (h *IO) Reset() {
 	h.Reader.Reset(h.buf)
 }
 
-func (h *IO) Drain(offset int) {}
+func (h *IO) Drain(offset int) {
+	h.buf.Next(offset)
+}
 
 func (*IO) Close() error { return nil }
 
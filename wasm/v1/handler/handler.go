@@ -19,6 +19,7 @@ type H struct {
 	instance    *wasm.Instance
 	log         *zap.Logger
 	base        *Wasm
+	metrics     *MetricStore
 	id          atomic.Int32
 	rootContext int32
 }
@@ -29,7 +30,7 @@ func New(
 	mw *api.Middleware_Wasm,
 ) (*H, error) {
 	file := filepath.Join(wasmModulesPath, mw.Module)
-	mwLog := zlg.Logger.Named("PROXY_WASM").With(
+	mwLog := zlg.Get(ctx).Named("PROXY_WASM").With(
 		zap.String("middleware", mw.Name),
 		zap.String("module", mw.Module),
 	)
@@ -57,8 +58,10 @@ func New(
 		mwLog.Error("Failed to start wasm instance", zap.Error(err))
 		return nil, err
 	}
+	metrics := NewMetricStore()
 	base := &Wasm{}
 	base.L = mwLog
+	base.Metrics.Store = metrics
 	bufFn, releaseBuf := safeBuffer()
 	defer releaseBuf()
 	base.NewBuffer = bufFn
@@ -67,9 +70,12 @@ func New(
 		Instance: instance,
 	}
 	export := rootABI.GetExports()
-	// create root plugin context
+	// create root plugin context, imports called by the module are resolved
+	// from the context holding the instance lock.
 	mwLog.Info("Creating root context")
+	instance.Lock(rootABI)
 	err = export.ProxyOnContextCreate(rootContext, 0)
+	instance.Unlock()
 	if err != nil {
 		mwLog.Error("Failed creating root context", zap.Error(err))
 		return nil, err
@@ -81,6 +87,7 @@ func New(
 		log:         mwLog,
 		id:          id,
 		base:        base,
+		metrics:     metrics,
 		rootContext: rootContext,
 	}, nil
 }
@@ -131,8 +138,14 @@ func (h *H) Handle(next http.Handler) http.Handler {
 	})
 }
 
+// Metrics returns metrics defined by the module.
+func (h *H) Metrics() *MetricStore {
+	return h.metrics
+}
+
 func (h *H) abi(modify ...func(*Wasm)) *proxywasm.ABIContext {
 	w := &Wasm{}
+	w.Metrics.Store = h.metrics
 	for _, fn := range modify {
 		fn(w)
 	}
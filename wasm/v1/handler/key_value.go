@@ -1,17 +1,32 @@
 package handler
 
 import (
+	"strings"
+
 	"github.com/gernest/tt/wasm/v1/imports"
 	x "mosn.io/proxy-wasm-go-host/proxywasm/v1"
 )
 
 var _ imports.KeyValue = (*KeyValue)(nil)
 
-type KeyValue struct{}
+type KeyValue struct {
+	// Lookup returns value of a property. Property paths are joined with "." eg
+	// upstream.address
+	Lookup func(path string) (string, bool)
+}
 
-func (KeyValue) GetProperty(key string) (string, x.WasmResult) {
-	return "", x.WasmResultUnimplemented
+func (k *KeyValue) GetProperty(key string) (string, x.WasmResult) {
+	if k.Lookup == nil {
+		return "", x.WasmResultUnimplemented
+	}
+	// sdks send property paths as segments separated by null bytes.
+	path := strings.Trim(strings.Replace(key, "\x00", ".", -1), ".")
+	if v, ok := k.Lookup(path); ok {
+		return v, x.WasmResultOk
+	}
+	return "", x.WasmResultNotFound
 }
+
 func (KeyValue) SetProperty(key string, value string) x.WasmResult {
 	return x.WasmResultUnimplemented
 }
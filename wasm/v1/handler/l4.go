@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"github.com/gernest/tt/wasm/buffers"
 	"github.com/gernest/tt/wasm/v1/imports"
 	"mosn.io/proxy-wasm-go-host/proxywasm/common"
 	x "mosn.io/proxy-wasm-go-host/proxywasm/v1"
@@ -8,9 +9,45 @@ import (
 
 var _ imports.L4 = (*L4)(nil)
 
-type L4 struct{}
+// L4 exposes data of a network connection to network filters.
+type L4 struct {
+	// Downstream is data received from the client that has not been passed to
+	// upstream yet.
+	Downstream *buffers.IO
+	// Upstream is data received from upstream that has not been passed to the
+	// client yet.
+	Upstream *buffers.IO
 
-func (L4) GetDownStreamData() common.IoBuffer { return nil }
-func (L4) GetUpstreamData() common.IoBuffer   { return nil }
-func (L4) ResumeDownstream() x.WasmResult     { return x.WasmResultUnimplemented }
-func (L4) ResumeUpstream() x.WasmResult       { return x.WasmResultUnimplemented }
+	OnResumeDownstream func()
+	OnResumeUpstream   func()
+}
+
+func (l *L4) GetDownStreamData() common.IoBuffer {
+	if l.Downstream == nil {
+		return nil
+	}
+	return l.Downstream
+}
+
+func (l *L4) GetUpstreamData() common.IoBuffer {
+	if l.Upstream == nil {
+		return nil
+	}
+	return l.Upstream
+}
+
+func (l *L4) ResumeDownstream() x.WasmResult {
+	if l.OnResumeDownstream == nil {
+		return x.WasmResultUnimplemented
+	}
+	l.OnResumeDownstream()
+	return x.WasmResultOk
+}
+
+func (l *L4) ResumeUpstream() x.WasmResult {
+	if l.OnResumeUpstream == nil {
+		return x.WasmResultUnimplemented
+	}
+	l.OnResumeUpstream()
+	return x.WasmResultOk
+}
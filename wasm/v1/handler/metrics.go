@@ -1,24 +1,133 @@
 package handler
 
 import (
+	"sync"
+
 	"github.com/gernest/tt/wasm/v1/imports"
 	x "mosn.io/proxy-wasm-go-host/proxywasm/v1"
 )
 
 var _ imports.Metrics = (*Metrics)(nil)
 
-type Metrics struct{}
+type Metrics struct {
+	// Store keeps metrics defined by the module. It is shared by all contexts
+	// of the same module.
+	Store *MetricStore
+}
+
+func (m *Metrics) DefineMetric(metricType x.MetricType, name string) (int32, x.WasmResult) {
+	if m.Store == nil {
+		return 0, x.WasmResultUnimplemented
+	}
+	return m.Store.define(metricType, name)
+}
+
+func (m *Metrics) IncrementMetric(metricID int32, offset int64) x.WasmResult {
+	if m.Store == nil {
+		return x.WasmResultUnimplemented
+	}
+	return m.Store.update(metricID, func(v *metric) x.WasmResult {
+		if v.typ == x.MetricTypeGauge || v.typ == x.MetricTypeCounter {
+			v.value += offset
+			return x.WasmResultOk
+		}
+		return x.WasmResultBadArgument
+	})
+}
 
-func (Metrics) DefineMetric(metricType x.MetricType, name string) (int32, x.WasmResult) {
-	return 0, x.WasmResultUnimplemented
+func (m *Metrics) RecordMetric(metricID int32, value int64) x.WasmResult {
+	if m.Store == nil {
+		return x.WasmResultUnimplemented
+	}
+	return m.Store.update(metricID, func(v *metric) x.WasmResult {
+		v.value = value
+		return x.WasmResultOk
+	})
 }
-func (Metrics) IncrementMetric(metricID int32, offset int64) x.WasmResult {
-	return x.WasmResultUnimplemented
+
+func (m *Metrics) GetMetric(metricID int32) (value int64, result x.WasmResult) {
+	if m.Store == nil {
+		return 0, x.WasmResultUnimplemented
+	}
+	result = m.Store.update(metricID, func(v *metric) x.WasmResult {
+		value = v.value
+		return x.WasmResultOk
+	})
+	return
 }
-func (Metrics) RecordMetric(metricID int32, value int64) x.WasmResult {
-	return x.WasmResultUnimplemented
+
+func (m *Metrics) RemoveMetric(metricID int32) x.WasmResult {
+	if m.Store == nil {
+		return x.WasmResultUnimplemented
+	}
+	m.Store.mu.Lock()
+	defer m.Store.mu.Unlock()
+	v, ok := m.Store.byID[metricID]
+	if !ok {
+		return x.WasmResultNotFound
+	}
+	delete(m.Store.byID, metricID)
+	delete(m.Store.byName, v.name)
+	return x.WasmResultOk
+}
+
+// MetricStore holds values of metrics defined by a module.
+type MetricStore struct {
+	mu     sync.Mutex
+	byID   map[int32]*metric
+	byName map[string]*metric
+	id     int32
 }
-func (Metrics) GetMetric(metricID int32) (int64, x.WasmResult) {
-	return 0, x.WasmResultUnimplemented
+
+type metric struct {
+	id    int32
+	typ   x.MetricType
+	name  string
+	value int64
+}
+
+// NewMetricStore returns an empty MetricStore.
+func NewMetricStore() *MetricStore {
+	return &MetricStore{
+		byID:   make(map[int32]*metric),
+		byName: make(map[string]*metric),
+	}
+}
+
+// Value returns the value of metric name.
+func (s *MetricStore) Value(name string) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.byName[name]
+	if !ok {
+		return 0, false
+	}
+	return v.value, true
+}
+
+// define returns the id of metric name, defining it when it doesn't exist.
+func (s *MetricStore) define(typ x.MetricType, name string) (int32, x.WasmResult) {
+	if typ > x.MetricTypeMax {
+		return 0, x.WasmResultBadArgument
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.byName[name]; ok {
+		return v.id, x.WasmResultOk
+	}
+	s.id++
+	v := &metric{id: s.id, typ: typ, name: name}
+	s.byID[v.id] = v
+	s.byName[name] = v
+	return v.id, x.WasmResultOk
+}
+
+func (s *MetricStore) update(id int32, fn func(*metric) x.WasmResult) x.WasmResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.byID[id]
+	if !ok {
+		return x.WasmResultNotFound
+	}
+	return fn(v)
 }
-func (Metrics) RemoveMetric(metricID int32) x.WasmResult { return x.WasmResultUnimplemented }
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/gernest/tt/wasm/buffers"
+	"go.uber.org/zap"
+	proxywasm "mosn.io/proxy-wasm-go-host/proxywasm/v1"
+)
+
+// ErrConnectionRejected is returned when a network filter doesn't continue on
+// a new connection.
+var ErrConnectionRejected = errors.New("wasm: connection rejected by network filter")
+
+// peer types passed to connection close callbacks.
+const (
+	peerUnknown int32 = iota
+	peerLocal
+	peerRemote
+)
+
+// NewConn runs the network filter on conn. Data read from the returned
+// connection is downstream data and data written to it is upstream data, both
+// pass through the filter before reaching the other end. lookup provides
+// connection properties to the filter.
+//
+// The returned connection must be closed to release the filter context.
+func (h *H) NewConn(conn net.Conn, lookup func(path string) (string, bool)) (*Conn, error) {
+	contextID := h.id.Inc()
+	c := &Conn{
+		Conn:       conn,
+		contextID:  contextID,
+		downstream: buffers.Get(),
+		upstream:   buffers.Get(),
+		log:        h.log.With(zap.Int32("tcpContextID", contextID)),
+	}
+	w := &Wasm{}
+	w.Zap.L = c.log
+	w.Plugin.Config = h.mw.GetConfig()
+	w.KeyValue.Lookup = lookup
+	w.Metrics.Store = h.metrics
+	w.L4 = L4{
+		Downstream: c.downstream,
+		Upstream:   c.upstream,
+		OnResumeDownstream: func() {
+			c.resumeDownstream = true
+		},
+		OnResumeUpstream: func() {
+			c.resumeUpstream = true
+		},
+	}
+	c.abi = &proxywasm.ABIContext{
+		Imports:  w,
+		Instance: h.instance,
+	}
+	c.exports = c.abi.GetExports()
+	var action proxywasm.Action
+	err := c.call(func() (err error) {
+		if err = c.exports.ProxyOnContextCreate(contextID, h.rootContext); err != nil {
+			return
+		}
+		action, err = c.action("proxy_on_new_connection", contextID)
+		return
+	})
+	if err != nil {
+		c.release()
+		return nil, err
+	}
+	if action != proxywasm.ActionContinue {
+		c.Close()
+		return nil, ErrConnectionRejected
+	}
+	return c, nil
+}
+
+// Conn is a connection whose data passes through a network filter.
+//
+// Data held by a paused filter is only released when the filter continues or
+// resumes from a data callback. Resuming from other callbacks, like timers or
+// http call responses, has no effect: held data is passed to the filter again
+// with the next data of the same direction and released at the end of the
+// stream.
+type Conn struct {
+	net.Conn
+	contextID int32
+	abi       *proxywasm.ABIContext
+	exports   proxywasm.Exports
+	log       *zap.Logger
+
+	// downstream and upstream hold data while the filter is paused. They are
+	// only accessed while holding the instance lock.
+	downstream, upstream             *buffers.IO
+	resumeDownstream, resumeUpstream bool
+
+	// pending is filtered downstream data that has not been read yet.
+	pending []byte
+	scratch []byte
+
+	// readErr is the error of the last read of the underlying connection, it is
+	// set by Read and used by Close.
+	readMu  sync.Mutex
+	readErr error
+
+	writeMu sync.Mutex
+	once    sync.Once
+}
+
+func (c *Conn) call(fn func() error) error {
+	c.abi.Instance.Lock(c.abi)
+	defer c.abi.Instance.Unlock()
+	return fn()
+}
+
+// action calls filter callback name returning an action. Exports of the host
+// return the action of Imports.Wait instead of the one returned by the filter.
+func (c *Conn) action(name string, args ...interface{}) (proxywasm.Action, error) {
+	res, _, err := c.abi.CallWasmFunction(name, args...)
+	if err != nil {
+		return proxywasm.ActionPause, err
+	}
+	if v, ok := res.(int32); ok {
+		return proxywasm.Action(v), nil
+	}
+	return proxywasm.ActionContinue, nil
+}
+
+// Read reads downstream data that was allowed by the filter.
+func (c *Conn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		if err := c.lastReadErr(); err != nil {
+			return 0, err
+		}
+		if cap(c.scratch) < len(p) {
+			c.scratch = make([]byte, len(p))
+		}
+		n, err := c.Conn.Read(c.scratch[:len(p)])
+		eos := err != nil
+		ferr := c.call(func() error {
+			c.downstream.Write(c.scratch[:n])
+			c.resumeDownstream = false
+			action, err := c.action("proxy_on_downstream_data", c.contextID, int32(c.downstream.Len()), boolToInt(eos))
+			if err != nil {
+				return err
+			}
+			if action == proxywasm.ActionContinue || c.resumeDownstream || eos {
+				c.pending = append(c.pending, c.downstream.Bytes()...)
+				c.downstream.Reset()
+			}
+			return nil
+		})
+		if ferr != nil {
+			c.log.Error("ProxyOnDownstreamData", zap.Error(ferr))
+			return 0, ferr
+		}
+		if err != nil {
+			c.readMu.Lock()
+			c.readErr = err
+			c.readMu.Unlock()
+		}
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *Conn) lastReadErr() error {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+	return c.readErr
+}
+
+// Write passes upstream data through the filter before writing it to the
+// client.
+func (c *Conn) Write(p []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	out, err := c.filterUpstream(p, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > 0 {
+		if _, err := c.Conn.Write(out); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (c *Conn) filterUpstream(p []byte, eos bool) (out []byte, err error) {
+	err = c.call(func() error {
+		c.upstream.Write(p)
+		c.resumeUpstream = false
+		action, err := c.action("proxy_on_upstream_data", c.contextID, int32(c.upstream.Len()), boolToInt(eos))
+		if err != nil {
+			return err
+		}
+		if action == proxywasm.ActionContinue || c.resumeUpstream || eos {
+			out = append(out, c.upstream.Bytes()...)
+			c.upstream.Reset()
+		}
+		return nil
+	})
+	if err != nil {
+		c.log.Error("ProxyOnUpstreamData", zap.Error(err))
+	}
+	return
+}
+
+// Close flushes upstream data held by the filter, runs close callbacks and
+// closes the connection.
+func (c *Conn) Close() error {
+	c.once.Do(func() {
+		c.writeMu.Lock()
+		var held bool
+		c.call(func() error {
+			held = c.upstream.Len() > 0
+			return nil
+		})
+		if held {
+			if out, err := c.filterUpstream(nil, true); err == nil && len(out) > 0 {
+				c.Conn.Write(out)
+			}
+		}
+		c.writeMu.Unlock()
+		downstreamPeer := peerLocal
+		if c.lastReadErr() == io.EOF {
+			downstreamPeer = peerRemote
+		}
+		err := c.call(func() error {
+			if err := c.exports.ProxyOnDownstreamConnectionClose(c.contextID, downstreamPeer); err != nil {
+				return err
+			}
+			if err := c.exports.ProxyOnUpstreamConnectionClose(c.contextID, peerUnknown); err != nil {
+				return err
+			}
+			if _, err := c.exports.ProxyOnDone(c.contextID); err != nil {
+				return err
+			}
+			// sdks report the end of the stream from the log callback.
+			if err := c.exports.ProxyOnLog(c.contextID); err != nil {
+				return err
+			}
+			return c.exports.ProxyOnDelete(c.contextID)
+		})
+		if err != nil {
+			c.log.Error("Failed closing network filter context", zap.Error(err))
+		}
+		c.release()
+	})
+	return c.Conn.Close()
+}
+
+func (c *Conn) release() {
+	buffers.Put(c.downstream, c.upstream)
+}
+
+func boolToInt(ok bool) int32 {
+	if ok {
+		return 1
+	}
+	return 0
+}
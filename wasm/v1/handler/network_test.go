@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gernest/tt/api"
+	"github.com/wasmerio/wasmer-go/wasmer"
+)
+
+// filterWAT is a network filter that holds data until it ends with a newline,
+// then upper cases it and releases it with proxy_resume_downstream or
+// proxy_resume_upstream. Callbacks that were called are recorded as bits of
+// the value returned by calls. Instances require WASI so it imports proc_exit.
+const filterWAT = `
+(module
+  (import "wasi_snapshot_preview1" "proc_exit" (func (param i32)))
+  (import "env" "proxy_get_buffer_bytes" (func $get (param i32 i32 i32 i32 i32) (result i32)))
+  (import "env" "proxy_set_buffer_bytes" (func $set (param i32 i32 i32 i32 i32) (result i32)))
+  (import "env" "proxy_resume_downstream" (func $resume_downstream (result i32)))
+  (import "env" "proxy_resume_upstream" (func $resume_upstream (result i32)))
+  (memory (export "memory") 2)
+  (global $heap (mut i32) (i32.const 1024))
+  (global $calls (mut i32) (i32.const 0))
+  (func (export "_start"))
+  (func (export "malloc") (param $size i32) (result i32)
+    (local $p i32)
+    (local.set $p (global.get $heap))
+    (global.set $heap (i32.add (global.get $heap) (local.get $size)))
+    (local.get $p))
+  (func $called (param $bit i32)
+    (global.set $calls (i32.or (global.get $calls) (local.get $bit))))
+  (func (export "calls") (result i32) (global.get $calls))
+  (func $line (param $typ i32) (param $size i32) (result i32)
+    (local $p i32) (local $i i32) (local $c i32)
+    (if (i32.eqz (local.get $size)) (then (return (i32.const 0))))
+    (drop (call $get (local.get $typ) (i32.const 0) (local.get $size) (i32.const 0) (i32.const 4)))
+    (local.set $p (i32.load (i32.const 0)))
+    (if (i32.ne (i32.load8_u (i32.add (local.get $p) (i32.sub (local.get $size) (i32.const 1)))) (i32.const 10))
+      (then (return (i32.const 0))))
+    (block $done
+      (loop $next
+        (br_if $done (i32.ge_u (local.get $i) (local.get $size)))
+        (local.set $c (i32.load8_u (i32.add (local.get $p) (local.get $i))))
+        (if (i32.lt_u (i32.sub (local.get $c) (i32.const 97)) (i32.const 26))
+          (then (i32.store8 (i32.add (local.get $p) (local.get $i)) (i32.sub (local.get $c) (i32.const 32)))))
+        (local.set $i (i32.add (local.get $i) (i32.const 1)))
+        (br $next)))
+    (drop (call $set (local.get $typ) (i32.const 0) (local.get $size) (local.get $p) (local.get $size)))
+    (i32.const 1))
+  (func (export "proxy_on_context_create") (param i32 i32))
+  (func (export "proxy_on_new_connection") (param i32) (result i32)
+    (call $called (i32.const 1))
+    (i32.const 0))
+  (func (export "proxy_on_downstream_data") (param i32) (param $size i32) (param i32) (result i32)
+    (call $called (i32.const 2))
+    (if (call $line (i32.const 2) (local.get $size))
+      (then (drop (call $resume_downstream))))
+    (i32.const 1))
+  (func (export "proxy_on_upstream_data") (param i32) (param $size i32) (param i32) (result i32)
+    (call $called (i32.const 4))
+    (if (call $line (i32.const 3) (local.get $size))
+      (then (drop (call $resume_upstream))))
+    (i32.const 1))
+  (func (export "proxy_on_downstream_connection_close") (param i32 i32)
+    (call $called (i32.const 8)))
+  (func (export "proxy_on_upstream_connection_close") (param i32 i32)
+    (call $called (i32.const 16)))
+  (func (export "proxy_on_done") (param i32) (result i32)
+    (call $called (i32.const 32))
+    (i32.const 1))
+  (func (export "proxy_on_log") (param i32)
+    (call $called (i32.const 64)))
+  (func (export "proxy_on_delete") (param i32)
+    (call $called (i32.const 128))))
+`
+
+func testFilter(t *testing.T) *H {
+	t.Helper()
+	b, err := wasmer.Wat2Wasm(filterWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "filter.wasm"), b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	h, err := New(context.Background(), dir, &api.Middleware_Wasm{
+		Name:    "filter",
+		Module:  "filter.wasm",
+		Version: api.Middleware_V1,
+		Config: &api.Middleware_Wasm_Config{
+			Instance: &api.Middleware_Wasm_Setting{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestConn(t *testing.T) {
+	h := testFilter(t)
+	client, server := net.Pipe()
+	defer client.Close()
+	c, err := h.NewConn(server, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		io.WriteString(client, "hel")
+		io.WriteString(client, "lo\n")
+	}()
+	b := make([]byte, 6)
+	if _, err := io.ReadFull(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "HELLO\n" {
+		t.Errorf("expected downstream data to be held and modified got %q", b)
+	}
+
+	got := make(chan string, 1)
+	go func() {
+		b := make([]byte, 6)
+		io.ReadFull(client, b)
+		got <- string(b)
+	}()
+	for _, s := range []string{"wor", "ld\n"} {
+		if _, err := io.WriteString(c, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := <-got; s != "WORLD\n" {
+		t.Errorf("expected upstream data to be held and modified got %q", s)
+	}
+
+	c.Close()
+	res, err := h.instance.GetExportsFunc("calls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls, err := res.Call()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls.(int32) != 0xff {
+		t.Errorf("expected all callbacks to be called got %08b", calls)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	h, err := New(context.Background(), "../../../tools/modules", &api.Middleware_Wasm{
+		Name:    "network",
+		Module:  "network.wasm",
+		Version: api.Middleware_V1,
+		Config: &api.Middleware_Wasm_Config{
+			Instance: &api.Middleware_Wasm_Setting{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		client, server := net.Pipe()
+		c, err := h.NewConn(server, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.Close()
+		c.Close()
+	}
+	const name = "proxy_wasm_go.connection_counter"
+	if v, ok := h.Metrics().Value(name); !ok || v != 2 {
+		t.Errorf("expected %s to be 2 got %d", name, v)
+	}
+}